@@ -2,17 +2,20 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-//go:generate go run -mod=mod ../../../hack/generate-docs ../../../docs/reference
+//go:generate env CGO_ENABLED=0 go run -mod=mod ../../../hack/generate-docs ../../../docs/reference
 
 package app
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"unicode"
 
 	_ "github.com/open-component-model/ocm/pkg/contexts/clictx/config"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs"
+	_ "github.com/open-component-model/ocm/pkg/signing/keyprovider/file"
+	_ "github.com/open-component-model/ocm/pkg/signing/keyprovider/pkcs11"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -21,10 +24,12 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/cachecmds"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/keyoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/action"
+	cfgcmds "github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/config"
 	creds "github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/credentials"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds"
 	common2 "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
+	inputplugins "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/types/plugin"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/componentarchive"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/plugins"
@@ -38,6 +43,7 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/clean"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/controller"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/create"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/delete"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/describe"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/download"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/execute"
@@ -47,6 +53,8 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/show"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/sign"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/transfer"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/untag"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/validate"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs/verify"
 	cmdutils "github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/cmds/ocm/topics/common/attributes"
@@ -77,6 +85,11 @@ import (
 	"github.com/open-component-model/ocm/pkg/version"
 )
 
+// ENV_OCM_CONFIG_SET is the environment variable used to select
+// configuration sets or profiles (comma separated), if the option
+// --config-set is not given.
+const ENV_OCM_CONFIG_SET = "OCM_CONFIG_SET"
+
 type CLIOptions struct {
 	keyoption.Option
 
@@ -134,6 +147,11 @@ For example:
     <pre>--cred :type=ociRegistry --cred :hostname=ghcr.io --cred username=mandelsoft --cred password=xyz</pre>
 </center>
 
+With the option <code>--config-set</code> configuration sets or profiles
+defined in the config file can be selected (see <CMD>ocm configfile</CMD>).
+If the option is not given, the sets or profiles are taken from the
+comma separated list given by the environment variable <code>OCM_CONFIG_SET</code>.
+
 With the option <code>-X</code> it is possible to pass global settings of the
 form
 
@@ -235,7 +253,10 @@ func newCliCommand(opts *CLIOptions, mod ...func(clictx.Context, *cobra.Command)
 	cmd.AddCommand(clean.NewCommand(opts.Context))
 	cmd.AddCommand(install.NewCommand(opts.Context))
 	cmd.AddCommand(execute.NewCommand(opts.Context))
+	cmd.AddCommand(delete.NewCommand(opts.Context))
 	cmd.AddCommand(controller.NewCommand(opts.Context))
+	cmd.AddCommand(validate.NewCommand(opts.Context))
+	cmd.AddCommand(untag.NewCommand(opts.Context))
 
 	cmd.AddCommand(cmdutils.HideCommand(componentarchive.NewCommand(opts.Context)))
 	cmd.AddCommand(cmdutils.HideCommand(resources.NewCommand(opts.Context)))
@@ -251,6 +272,7 @@ func newCliCommand(opts *CLIOptions, mod ...func(clictx.Context, *cobra.Command)
 	cmd.AddCommand(cmdutils.OverviewCommand(ocmcmds.NewCommand(opts.Context)))
 	cmd.AddCommand(cmdutils.OverviewCommand(toicmds.NewCommand(opts.Context)))
 	cmd.AddCommand(cmdutils.OverviewCommand(creds.NewCommand(opts.Context)))
+	cmd.AddCommand(cmdutils.OverviewCommand(cfgcmds.NewCommand(opts.Context)))
 
 	opts.AddFlags(cmd.Flags())
 	cmd.InitDefaultHelpCmd()
@@ -297,7 +319,7 @@ func newCliCommand(opts *CLIOptions, mod ...func(clictx.Context, *cobra.Command)
 
 func (o *CLIOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.Config, "config", "", "", "configuration file")
-	fs.StringSliceVarP(&o.ConfigSets, "config-set", "", nil, "apply configuration set")
+	fs.StringSliceVarP(&o.ConfigSets, "config-set", "", nil, "apply configuration set or profile")
 	fs.StringArrayVarP(&o.Credentials, "cred", "C", nil, "credential setting")
 	fs.StringArrayVarP(&o.Settings, "attribute", "X", nil, "attribute setting")
 	fs.BoolVarP(&o.Verbose, "verbose", "v", false, "deprecated: enable logrus verbose logging")
@@ -343,7 +365,14 @@ func (o *CLIOptions) Complete() error {
 		}
 	}
 
-	for _, n := range o.ConfigSets {
+	sets := o.ConfigSets
+	if len(sets) == 0 {
+		if env := os.Getenv(ENV_OCM_CONFIG_SET); env != "" {
+			sets = strings.Split(env, ",")
+		}
+	}
+	for _, n := range sets {
+		n = strings.TrimSpace(n)
 		err := o.Context.ConfigContext().ApplyConfigSet(n)
 		if err != nil {
 			return err
@@ -400,7 +429,11 @@ func (o *CLIOptions) Complete() error {
 		}
 		_ = ctx.ApplyConfig(spec, "cli")
 	}
-	return registration.RegisterExtensions(o.Context.OCMContext())
+	err = registration.RegisterExtensions(o.Context.OCMContext())
+	if err != nil {
+		return err
+	}
+	return inputplugins.RegisterExtensions(o.Context)
 }
 
 func prepare(s string) string {
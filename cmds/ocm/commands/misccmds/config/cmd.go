@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/config/show"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/config/validate"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+)
+
+var Names = names.Config
+
+// NewCommand creates a new command.
+func NewCommand(ctx clictx.Context) *cobra.Command {
+	cmd := utils.MassageCommand(&cobra.Command{
+		Short: "Commands acting on the ocm configuration",
+	}, Names...)
+	cmd.AddCommand(validate.NewCommand(ctx, validate.Verb))
+	cmd.AddCommand(show.NewCommand(ctx, show.Verb))
+	return cmd
+}
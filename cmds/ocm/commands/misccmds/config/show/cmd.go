@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package show
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	genericcfg "github.com/open-component-model/ocm/pkg/contexts/config/config"
+	credcfg "github.com/open-component-model/ocm/pkg/contexts/credentials/config"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	uploadcfg "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/config"
+	downloadcfg "github.com/open-component-model/ocm/pkg/contexts/ocm/download/config"
+	"github.com/open-component-model/ocm/pkg/out"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+var (
+	Names = names.Config
+	Verb  = verbs.Show
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Effective bool
+}
+
+var _ utils.OCMCommand = (*Command)(nil)
+
+// NewCommand creates a new config command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx)}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "",
+		Args:  cobra.NoArgs,
+		Short: "show the applied ocm configuration",
+		Long: `
+Show the configuration objects applied to the CLI context together with their
+source (config file, config set, command line). Nested configurations of
+generic configurations are shown separately.
+
+With option <code>--effective</code> the effective configuration is shown,
+additionally. It contains
+- the known configuration sets and profiles and whether they are active,
+- the attributes set in the CLI context (see <CMD>ocm attributes</CMD>),
+- the configured credential repositories and
+- the configured download and upload handler registrations.
+`,
+		Example: `
+$ ocm --config-set dev config show --effective
+`,
+	}
+}
+
+func (o *Command) AddFlags(set *pflag.FlagSet) {
+	set.BoolVarP(&o.Effective, "effective", "e", false, "show effective configuration")
+}
+
+func (o *Command) Complete(args []string) error {
+	return nil
+}
+
+// Element is a configuration element together with its source.
+type Element struct {
+	Source string      `json:"source,omitempty"`
+	Spec   interface{} `json:"spec"`
+}
+
+type ConfigSet struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Active      bool   `json:"active"`
+}
+
+type ConfigProfile struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Extends     []string `json:"extends,omitempty"`
+	Active      bool     `json:"active"`
+}
+
+type Configuration struct {
+	ConfigSets             []ConfigSet            `json:"configSets,omitempty"`
+	ConfigProfiles         []ConfigProfile        `json:"configProfiles,omitempty"`
+	Attributes             map[string]interface{} `json:"attributes,omitempty"`
+	CredentialRepositories []Element              `json:"credentialRepositories,omitempty"`
+	Downloaders            []Element              `json:"downloaders,omitempty"`
+	Uploaders              []Element              `json:"uploaders,omitempty"`
+	Configurations         []Element              `json:"configurations,omitempty"`
+}
+
+func (o *Command) Run() error {
+	var result Configuration
+
+	cctx := o.ConfigContext()
+	_, applied := cctx.GetAppliedConfigs(config.AllGenerations, nil)
+	for _, a := range applied {
+		cfg := a.Config()
+		if cfg.GetKind() == genericcfg.ConfigType {
+			// nested configurations are applied separately
+			continue
+		}
+		result.Configurations = append(result.Configurations, Element{a.Description(), cfg})
+		if !o.Effective {
+			continue
+		}
+		switch c := cfg.(type) {
+		case *credcfg.Config:
+			for _, r := range c.Repositories {
+				result.CredentialRepositories = append(result.CredentialRepositories, Element{a.Description(), r.Repository})
+			}
+		case *downloadcfg.Config:
+			for _, r := range c.Registrations {
+				result.Downloaders = append(result.Downloaders, Element{a.Description(), r})
+			}
+		case *uploadcfg.Config:
+			for _, r := range c.Registrations {
+				result.Uploaders = append(result.Uploaders, Element{a.Description(), r})
+			}
+		}
+	}
+
+	if o.Effective {
+		active := map[string]bool{}
+		for _, n := range cctx.GetAppliedConfigSets() {
+			active[n] = true
+		}
+		for _, n := range cctx.GetConfigSetNames() {
+			set := ConfigSet{Name: n, Active: active[n]}
+			if s := cctx.GetConfigSet(n); s != nil {
+				set.Description = s.Description
+			}
+			result.ConfigSets = append(result.ConfigSets, set)
+		}
+		for _, n := range cctx.GetConfigProfileNames() {
+			profile := ConfigProfile{Name: n, Active: active[n] && cctx.GetConfigSet(n) == nil}
+			if p := cctx.GetConfigProfile(n); p != nil {
+				profile.Description = p.Description
+				profile.Extends = p.Extends
+			}
+			result.ConfigProfiles = append(result.ConfigProfiles, profile)
+		}
+		result.Attributes = o.attributes()
+	}
+
+	data, err := runtime.DefaultYAMLEncoding.Marshal(result)
+	if err != nil {
+		return err
+	}
+	out.Outf(o, "%s", string(data))
+	return nil
+}
+
+// attributes provides the serialized values of all known attributes
+// set for the CLI context.
+func (o *Command) attributes() map[string]interface{} {
+	result := map[string]interface{}{}
+	attrs := o.OCMContext().GetAttributes()
+	for _, n := range datacontext.DefaultAttributeScheme.KnownTypeNames() {
+		v := attrs.GetAttribute(n)
+		if v == nil {
+			continue
+		}
+		var value interface{} = "<not serializable>"
+		if data, err := datacontext.DefaultAttributeScheme.Encode(n, v, runtime.DefaultJSONEncoding); err == nil && len(data) > 0 {
+			if err := json.Unmarshal(data, &value); err != nil {
+				value = string(data)
+			}
+		}
+		result[n] = value
+	}
+	return result
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package show_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+)
+
+const CONFIG = `
+type: generic.config.ocm.software/v1
+configurations:
+  - type: attributes.config.ocm.software
+    attributes:
+      ocm.software/compositionmode: true
+  - type: downloader.ocm.config.ocm.software
+    registrations:
+      - name: helm/artifact
+        artifactType: helmChart
+sets:
+  dev:
+    description: development
+    configurations:
+      - type: credentials.config.ocm.software
+        repositories:
+          - repository:
+              type: Environment
+              prefix: DEV_
+  prod:
+    description: production
+`
+
+const PROFILES = `
+type: generic.config.ocm.software/v1
+profiles:
+  base:
+    values:
+      STAGE: base
+    configurations:
+      - type: credentials.config.ocm.software
+        repositories:
+          - repository:
+              type: Environment
+              prefix: ${STAGE}_
+  stage:
+    description: staging
+    extends:
+      - base
+    values:
+      STAGE: STAGE
+`
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		MustBeSuccessful(vfs.WriteFile(env.FileSystem(), "/ocmconfig", []byte(CONFIG), 0o600))
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("shows applied configuration", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("--config", "/ocmconfig", "config", "show")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+configurations:
+- source: config entry 0--/ocmconfig
+  spec:
+    attributes:
+      ocm.software/compositionmode: true
+    type: attributes.config.ocm.software
+- source: config entry 1--/ocmconfig
+  spec:
+    registrations:
+    - artifactType: helmChart
+      name: helm/artifact
+    type: downloader.ocm.config.ocm.software
+`))
+	})
+
+	It("shows effective configuration", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("--config", "/ocmconfig", "--config-set", "dev", "show", "config", "--effective")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+attributes:
+  github.com/mandelsoft/logforward:
+    defaultLevel: Error
+  github.com/mandelsoft/ocm/signing: <not serializable>
+  github.com/mandelsoft/vfs: <not serializable>
+  ocm.software/compositionmode: true
+configSets:
+- active: true
+  description: development
+  name: dev
+- active: false
+  description: production
+  name: prod
+configurations:
+- source: config entry 0--/ocmconfig
+  spec:
+    attributes:
+      ocm.software/compositionmode: true
+    type: attributes.config.ocm.software
+- source: config entry 1--/ocmconfig
+  spec:
+    registrations:
+    - artifactType: helmChart
+      name: helm/artifact
+    type: downloader.ocm.config.ocm.software
+- source: config set dev
+  spec:
+    repositories:
+    - repository:
+        prefix: DEV_
+        type: Environment
+    type: credentials.config.ocm.software
+credentialRepositories:
+- source: config set dev
+  spec:
+    prefix: DEV_
+    type: Environment
+downloaders:
+- source: config entry 1--/ocmconfig
+  spec:
+    artifactType: helmChart
+    name: helm/artifact
+`))
+	})
+
+	It("shows profile selected by environment", func() {
+		MustBeSuccessful(vfs.WriteFile(env.FileSystem(), "/profiles", []byte(PROFILES), 0o600))
+		os.Setenv("OCM_CONFIG_SET", "stage")
+		defer os.Unsetenv("OCM_CONFIG_SET")
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("--config", "/profiles", "config", "show", "-e")).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`
+configProfiles:
+- active: false
+  name: base
+- active: true
+  description: staging
+  extends:
+  - base
+  name: stage
+`))
+		Expect(buf.String()).To(ContainSubstring(`
+credentialRepositories:
+- source: config profile stage
+  spec:
+    prefix: STAGE_
+    type: Environment
+`))
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/config/configutils"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	ocmutils "github.com/open-component-model/ocm/pkg/contexts/ocm/utils"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+	utils2 "github.com/open-component-model/ocm/pkg/utils"
+)
+
+var (
+	Names = names.Config
+	Verb  = verbs.Validate
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Files []string
+}
+
+var _ utils.OCMCommand = (*Command)(nil)
+
+// NewCommand creates a new config command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx)}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<config file>...]",
+		Short: "validate ocm config files",
+		Long: `
+Validate ocm configuration files against all known configuration types
+(see <CMD>ocm configfile</CMD>). If no file is given, the default config file
+(<code>$HOME/.ocmconfig</code>) is validated.
+
+Every configuration object, including nested configurations and the
+configurations of configuration sets, is checked for unknown configuration
+types, unknown or misspelled fields and mismatching field types. Valid
+configuration objects are applied to a scratch context to detect semantic
+errors, like unknown attribute names. The found issues are reported together
+with their position in the file.
+`,
+		Example: `
+$ ocm config validate ~/.ocmconfig
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Files = args
+	if len(o.Files) == 0 {
+		path := ocmutils.DefaultConfigFile(o.FileSystem())
+		if path == "" {
+			return errors.Newf("no config file found")
+		}
+		o.Files = []string{path}
+	}
+	return nil
+}
+
+func (o *Command) Run() error {
+	invalid := 0
+	for _, f := range o.Files {
+		data, err := utils2.ReadFile(f, o.FileSystem())
+		if err != nil {
+			return err
+		}
+		ctx := clictx.New(datacontext.MODE_DEFAULTED)
+		vfsattr.Set(ctx, o.FileSystem())
+		issues, err := configutils.Validate(ctx, data)
+		if err != nil {
+			out.Outf(o, "%s: %s\n", f, err)
+			invalid++
+			continue
+		}
+		if len(issues) == 0 {
+			out.Outf(o, "%s: valid\n", f)
+			continue
+		}
+		for _, i := range issues {
+			if i.Line > 0 {
+				out.Outf(o, "%s:%s\n", f, i)
+			} else {
+				out.Outf(o, "%s: %s\n", f, i)
+			}
+		}
+		invalid++
+	}
+	if invalid > 0 {
+		return errors.Newf("%d of %d config file(s) invalid", invalid, len(o.Files))
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate_test
+
+import (
+	"bytes"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+)
+
+const VALID = `
+type: generic.config.ocm.software/v1
+configurations:
+  - type: attributes.config.ocm.software
+    attributes:
+      ocm.software/compositionmode: true
+`
+
+const INVALID = `
+type: generic.config.ocm.software/v1
+configurations:
+  - type: attributes.config.ocm.software
+    attributes:
+      ocm.software/compositonmode: true
+sets:
+  dev:
+    configurations:
+      - type: credentials.config.ocm.software
+        consumer: []
+`
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		MustBeSuccessful(vfs.WriteFile(env.FileSystem(), "/valid", []byte(VALID), 0o600))
+		MustBeSuccessful(vfs.WriteFile(env.FileSystem(), "/invalid", []byte(INVALID), 0o600))
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("validates valid config", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("config", "validate", "/valid")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+/valid: valid
+`))
+	})
+
+	It("reports issues", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("validate", "config", "/valid", "/invalid")).To(MatchError("1 of 2 config file(s) invalid"))
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+/valid: valid
+/invalid:4:5: configurations[0]: applying config: attribute "ocm.software/compositonmode": attribute "ocm.software/compositonmode" is unknown
+/invalid:11:9: sets.dev.configurations[0]: unknown field "consumer"
+`))
+	})
+})
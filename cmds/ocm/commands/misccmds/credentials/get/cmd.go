@@ -7,6 +7,7 @@ package get
 import (
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -19,6 +20,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/credentials"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/listformat"
+	"github.com/open-component-model/ocm/pkg/out"
 )
 
 var (
@@ -69,6 +71,9 @@ The following standard identity matchers are supported:
 The used matcher is derived from the consumer attribute <code>type</code>.
 For all other consumer types a matcher matching all attributes will be used.
 The usage of a dedicated matcher can be enforced by the option <code>--matcher</code>.
+
+For credentials with a limited lifetime (for example short-lived tokens)
+the expiration time is shown, additionally.
 `,
 	}
 }
@@ -122,5 +127,8 @@ func (o *Command) Run() error {
 	}
 	sort.Slice(list, func(i, j int) bool { return strings.Compare(list[i][0], list[j][0]) < 0 })
 	output.FormatTable(o, "", append([][]string{{"ATTRIBUTE", "VALUE"}}, list...))
+	if exp := credentials.GetExpiration(creds); !exp.IsZero() {
+		out.Outf(o, "\nexpires at %s\n", exp.Format(time.RFC3339))
+	}
 	return nil
 }
@@ -6,20 +6,27 @@ package get_test
 
 import (
 	"bytes"
+	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
 	. "github.com/open-component-model/ocm/pkg/testutils"
 
+	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/config"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/environment"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/identity"
 )
 
 var _ = Describe("Test Environment", func() {
 	var env *TestEnv
 
+	expiration := time.Date(2100, 1, 1, 12, 0, 0, 0, time.UTC)
+
 	BeforeEach(func() {
 		env = NewTestEnv()
 		cctx := env.CLI.CredentialsContext()
@@ -42,6 +49,11 @@ var _ = Describe("Test Environment", func() {
 		}
 
 		cctx.SetCredentialsForConsumer(ids, creds)
+
+		ids = credentials.NewConsumerIdentity("token", identity.ID_HOSTNAME, "ghcr.io")
+		cctx.SetCredentialsForConsumer(ids, credentials.NewExpiringCredentials(common.Properties{
+			"token": "short-lived",
+		}, expiration))
 	})
 
 	AfterEach(func() {
@@ -71,6 +83,36 @@ user      testuser
 ATTRIBUTE VALUE
 password  testpass
 username  testuser
+`))
+	})
+
+	It("get oci type from environment repository", func() {
+		os.Setenv("OCM_CRED_MY__REGISTRY_IO_USERNAME", "envuser")
+		os.Setenv("OCM_CRED_MY__REGISTRY_IO_PASSWORD", "envpass")
+		defer os.Unsetenv("OCM_CRED_MY__REGISTRY_IO_USERNAME")
+		defer os.Unsetenv("OCM_CRED_MY__REGISTRY_IO_PASSWORD")
+
+		cfg := config.New()
+		MustBeSuccessful(cfg.AddRepository(environment.NewRepositorySpec("", true)))
+		MustBeSuccessful(env.ConfigContext().ApplyConfig(cfg, "test"))
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("get", "credentials", cpi.ID_TYPE+"="+identity.CONSUMER_TYPE, identity.ID_HOSTNAME+"=my-registry.io")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+ATTRIBUTE VALUE
+password  envpass
+username  envuser
+`))
+	})
+
+	It("shows expiration", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("get", "credentials", cpi.ID_TYPE+"=token", identity.ID_HOSTNAME+"=ghcr.io")).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+ATTRIBUTE VALUE
+token     short-lived
+
+expires at 2100-01-01T12:00:00Z
 `))
 	})
 })
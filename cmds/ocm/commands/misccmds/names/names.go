@@ -8,4 +8,5 @@ var (
 	Hash        = []string{"hash"}
 	RSAKeyPair  = []string{"rsakeypair", "rsa"}
 	Credentials = []string{"credentials", "creds", "cred"}
+	Config      = []string{"config", "cfg"}
 )
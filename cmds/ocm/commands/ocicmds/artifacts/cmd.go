@@ -7,10 +7,12 @@ package artifacts
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/delete"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/describe"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/download"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/get"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/transfer"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/untag"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
@@ -28,5 +30,7 @@ func NewCommand(ctx clictx.Context) *cobra.Command {
 	cmd.AddCommand(describe.NewCommand(ctx, describe.Verb))
 	cmd.AddCommand(transfer.NewCommand(ctx, transfer.Verb))
 	cmd.AddCommand(download.NewCommand(ctx, download.Verb))
+	cmd.AddCommand(delete.NewCommand(ctx, delete.Verb))
+	cmd.AddCommand(untag.NewCommand(ctx, untag.Verb))
 	return cmd
 }
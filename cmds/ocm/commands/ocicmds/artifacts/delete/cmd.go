@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/spf13/cobra"
+
+	ocicommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/handlers/artifacthdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/dryrunoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+var (
+	Names = names.Artifacts
+	Verb  = verbs.Delete
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Refs []string
+}
+
+// NewCommand creates a new delete command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New(), dryrunoption.New("only show artifacts to be deleted", false))}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] {<artifact-reference>}",
+		Short: "delete artifacts",
+		Long: `
+Delete the specified artifacts together with all their tags from their
+repositories. If only a repository is specified, all tagged artifacts of
+this repository are deleted.
+
+The deletion of artifacts is not supported by all repository types.
+For OCI registries it must be supported and allowed by the registry.
+Blobs of the deleted artifacts are not removed. OCI registries typically
+remove unreferenced blobs on their own, for Common Transport Archives
+the command <CMD>ocm clean ctf</CMD> can be used.
+
+With option <code>--dry-run</code> the artifacts to be deleted are only listed.
+`,
+		Example: `
+$ ocm delete artifact ghcr.io/mandelsoft/kubelink:v1.0.0
+$ ocm delete artifact --dry-run --repo ctf mandelsoft/kubelink
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	if len(args) == 0 && repooption.From(o).Spec == "" {
+		return fmt.Errorf("a repository or at least one argument that defines the reference is needed")
+	}
+	o.Refs = args
+	return nil
+}
+
+func (o *Command) Run() (rerr error) {
+	session := oci.NewSession(nil)
+	defer errors.PropagateError(&rerr, func() error {
+		return session.Close()
+	})
+
+	err := o.ProcessOnOptions(ocicommon.CompleteOptionsWithContext(o.Context, session))
+	if err != nil {
+		return err
+	}
+	handler := artifacthdlr.NewTypeHandler(o.Context.OCI(), session, repooption.From(o).Repository)
+	return utils.HandleOutput(newAction(o), handler, utils.StringElemSpecs(o.Refs...)...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type action struct {
+	printer common.Printer
+	dryrun  bool
+	objects []*artifacthdlr.Object
+}
+
+var _ output.Output = (*action)(nil)
+
+func newAction(o *Command) *action {
+	return &action{
+		printer: common.NewPrinter(o.Context.StdOut()),
+		dryrun:  dryrunoption.From(o).DryRun,
+	}
+}
+
+func (a *action) Add(e interface{}) error {
+	o, ok := e.(*artifacthdlr.Object)
+	if !ok {
+		return fmt.Errorf("failed to assert %T to *artifacthdlr.Object", e)
+	}
+	a.objects = append(a.objects, o)
+	return nil
+}
+
+func (a *action) Close() error {
+	return nil
+}
+
+func (a *action) Out() error {
+	sort.Slice(a.objects, func(i, j int) bool { return artifacthdlr.Compare(a.objects[i], a.objects[j]) < 0 })
+
+	errs := errors.ErrListf("deleting artifacts")
+	done := map[string]bool{}
+	for _, o := range a.objects {
+		dig := o.Artifact.Digest()
+		ref := o.Spec
+		ref.Tag = nil
+		ref.Digest = &dig
+		name := ref.String()
+		if done[name] {
+			// multiple tags for the same artifact
+			continue
+		}
+		done[name] = true
+
+		if a.dryrun {
+			a.printer.Printf("would delete %s\n", name)
+			continue
+		}
+		err := deleteArtifact(o.Namespace, dig)
+		if err != nil {
+			a.printer.Printf("failed deleting %s: %s\n", name, err)
+			errs.Add(errors.Wrapf(err, "%s", name))
+		} else {
+			a.printer.Printf("deleted %s\n", name)
+		}
+	}
+	return errs.Result()
+}
+
+func deleteArtifact(ns oci.NamespaceAccess, dig digest.Digest) error {
+	if d, ok := ns.(oci.ArtifactDeleter); ok {
+		return d.DeleteArtifact(dig)
+	}
+	return errors.ErrNotSupported("artifact deletion")
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package delete_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const (
+	ARCH     = "/tmp/ctf"
+	VERSION1 = "v1"
+	VERSION2 = "v2"
+	NS       = "mandelsoft/test"
+)
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+	var digest string
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				env.Manifest(VERSION1, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+				env.Manifest(VERSION2, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "otherdata")
+					})
+				})
+			})
+		})
+
+		repo := Must(ctf.Open(env, accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(repo, "repo")
+		art := Must(repo.LookupArtifact(NS, VERSION1))
+		defer Close(art, "artifact")
+		digest = art.Digest().String()
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	tags := func() []string {
+		repo := Must(ctf.Open(env, accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(repo, "repo")
+		ns := Must(repo.LookupNamespace(NS))
+		defer Close(ns, "namespace")
+		return Must(ns.ListTags())
+	}
+
+	It("deletes artifact", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "artifact", "--repo", ARCH, NS+":"+VERSION1)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+deleted CommonTransportFormat::` + ARCH + `//` + NS + `@` + digest + `
+`))
+		Expect(tags()).To(ConsistOf(VERSION2))
+	})
+
+	It("shows artifacts to delete in dry-run mode", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "artifact", "--dry-run", ARCH+"//"+NS+":"+VERSION1)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+would delete ` + ARCH + `//` + NS + `@` + digest + `
+`))
+		Expect(tags()).To(ConsistOf(VERSION1, VERSION2))
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -34,10 +35,13 @@ var (
 type Command struct {
 	utils.BaseCommand
 
-	TransferRepo bool
+	TransferRepo      bool
+	TransferReferrers bool
+	PlatformSpecs     []string
 
-	Refs   []string
-	Target string
+	Refs      []string
+	Target    string
+	Platforms []artdesc.Platform
 }
 
 func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
@@ -66,12 +70,23 @@ Sources may be specified as
 - dedicated artifacts with repository and version or tag
 - repository (without version), which is resolved to all available tags
 - registry, if the specified registry implementation supports a namespace/repository lister,
-  which is not the case for registries conforming to the OCI distribution specification.`,
+  which is not the case for registries conforming to the OCI distribution specification.
+
+With option <code>--referrers</code> the artifacts referring to the transferred
+artifacts (OCI 1.1 referrers, like signatures, SBOMs or attestations) are
+transferred, also.
+
+With option <code>--platform</code> the manifests of multi-platform index
+artifacts are restricted to the given platforms (<code>&lt;os>[/&lt;arch>[/&lt;variant>]]</code>).
+The option can be given multiple times. Filtered indices are rewritten and
+therefore get a new digest.`,
 		Example: `
 $ ocm oci artifact transfer ghcr.io/mandelsoft/kubelink:v1.0.0 gcr.io
 $ ocm oci artifact transfer ghcr.io/mandelsoft/kubelink gcr.io
 $ ocm oci artifact transfer ghcr.io/mandelsoft/kubelink gcr.io/my-project
 $ ocm oci artifact transfer /tmp/ctf gcr.io/my-project
+$ ocm oci artifact transfer --referrers ghcr.io/mandelsoft/kubelink:v1.0.0 gcr.io
+$ ocm oci artifact transfer --platform linux/arm64 ghcr.io/mandelsoft/kubelink:v1.0.0 gcr.io
 `,
 	}
 }
@@ -79,6 +94,8 @@ $ ocm oci artifact transfer /tmp/ctf gcr.io/my-project
 func (o *Command) AddFlags(flags *pflag.FlagSet) {
 	o.BaseCommand.AddFlags(flags)
 	flags.BoolVarP(&o.TransferRepo, "repo-name", "R", false, "transfer repository name")
+	flags.BoolVarP(&o.TransferReferrers, "referrers", "", false, "transfer referrers of artifacts")
+	flags.StringSliceVarP(&o.PlatformSpecs, "platform", "", nil, "restrict index artifacts to platform")
 }
 
 func (o *Command) Complete(args []string) error {
@@ -87,6 +104,11 @@ func (o *Command) Complete(args []string) error {
 	}
 	o.Target = args[len(args)-1]
 	o.Refs = args[:len(args)-1]
+	platforms, err := artdesc.ParsePlatforms(o.PlatformSpecs...)
+	if err != nil {
+		return err
+	}
+	o.Platforms = platforms
 	return nil
 }
 
@@ -101,6 +123,8 @@ func (o *Command) Run() error {
 	if err != nil {
 		return err
 	}
+	a.TransferReferrers = o.TransferReferrers
+	a.Platforms = o.Platforms
 
 	handler := artifacthdlr.NewTypeHandler(o.Context.OCI(), session, repooption.From(o).Repository)
 
@@ -110,10 +134,12 @@ func (o *Command) Run() error {
 /////////////////////////////////////////////////////////////////////////////
 
 type action struct {
-	Context      clictx.Context
-	Registry     oci.Repository
-	Ref          oci.RefSpec
-	TransferRepo bool
+	Context           clictx.Context
+	Registry          oci.Repository
+	Ref               oci.RefSpec
+	TransferRepo      bool
+	TransferReferrers bool
+	Platforms         []artdesc.Platform
 
 	srcs         []*artifacthdlr.Object
 	repositories map[string]map[string]digest.Digest
@@ -219,11 +245,22 @@ func (a *action) handleArtifact(src *artifacthdlr.Object) error {
 		tgt.Tag = &tag
 	}
 	out.Outf(a.Context, "copying %s to %s...\n", &src.Spec, &tgt)
-	err = transfer.TransferArtifact(src.Artifact, ns, tag)
-	if err == nil {
-		a.copied++
+	var opts []transfer.Option
+	if a.TransferReferrers {
+		opts = append(opts, transfer.WithReferrers(src.Namespace))
+	}
+	if len(a.Platforms) > 0 {
+		opts = append(opts, transfer.WithPlatforms(a.Platforms...))
 	}
-	return err
+	dig, err := transfer.TransferArtifactWithOptions(src.Artifact, ns, []string{tag}, opts...)
+	if err != nil {
+		return err
+	}
+	if dig != src.Artifact.Digest() {
+		out.Outf(a.Context, "  index rewritten for platforms (%s)\n", dig)
+	}
+	a.copied++
+	return nil
 }
 
 func (a *action) Target(obj *artifacthdlr.Object) (string, string) {
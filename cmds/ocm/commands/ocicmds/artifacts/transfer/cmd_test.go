@@ -12,8 +12,15 @@ import (
 	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
 	. "github.com/open-component-model/ocm/pkg/testutils"
 
+	"github.com/opencontainers/go-digest"
+
 	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/finalizer"
 	"github.com/open-component-model/ocm/pkg/mime"
 )
 
@@ -127,4 +134,110 @@ copied 1 from 1 artifact(s) and 1 repositories
 `))
 		Expect(env.ReadFile(OUT + "/" + ctf.ArtifactIndexFileName)).To(Equal([]byte("{\"schemaVersion\":1,\"artifacts\":[{\"repository\":\"mandelsoft/test\",\"tag\":\"v1\",\"digest\":\"sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9\"}]}")))
 	})
+
+	It("transfers referrers", func() {
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				env.Manifest(VERSION, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+			})
+		})
+
+		subject := digest.Digest("sha256:2c3e2c59e0ac9c99864bf0a9f9727c09f21a66080f9f9b03b36a2dad3cce6ff9")
+		func() {
+			var finalize finalizer.Finalizer
+			defer Defer(finalize.Finalize)
+
+			repo := Must(ctf.Open(env, accessobj.ACC_WRITABLE, ARCH, 0, env))
+			finalize.Close(repo, "source")
+			ns := Must(repo.LookupNamespace(NS))
+			finalize.Close(ns, "source namespace")
+
+			config := blobaccess.ForString(artdesc.MediaTypeEmptyJSON, "{}")
+			MustBeSuccessful(ns.AddBlob(config))
+			m := artdesc.NewManifest()
+			m.ArtifactType = "application/spdx+json"
+			m.Config = *artdesc.DefaultBlobDescriptor(config)
+			m.SetSubject(&artdesc.Descriptor{MediaType: artdesc.MediaTypeImageManifest, Digest: subject, Size: 378})
+			desc := artdesc.New()
+			MustBeSuccessful(desc.SetManifest(m))
+			art := Must(ns.NewArtifact(desc))
+			finalize.Close(art, "referrer")
+			blob := Must(ns.AddArtifact(art))
+			finalize.Close(blob, "referrer blob")
+		}()
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "artifact", "--referrers", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+copying /tmp/ctf//mandelsoft/test:v1 to directory::` + OUT + `//mandelsoft/test:v1...
+copied 1 from 1 artifact(s) and 1 repositories
+`))
+
+		repo := Must(ctf.Open(env, accessobj.ACC_READONLY, OUT, 0, env))
+		defer Close(repo, "target")
+		ns := Must(repo.LookupNamespace(NS))
+		defer Close(ns, "target namespace")
+		list := Must(ns.(oci.ReferrersLister).Referrers(subject, ""))
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].ArtifactType).To(Equal("application/spdx+json"))
+	})
+
+	It("transfers index for platforms", func() {
+		var amd64, arm64 *artdesc.Descriptor
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				amd64 = env.Manifest("", func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "amd64")
+					})
+				})
+				arm64 = env.Manifest("", func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "arm64")
+					})
+				})
+				env.Index(VERSION, func() {
+					env.Artifact(withPlatform(amd64, "linux", "amd64"))
+					env.Artifact(withPlatform(arm64, "linux", "arm64"))
+				})
+			})
+		})
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "artifact", "--platform", "linux/arm64", ARCH+"//"+NS+":"+VERSION, "directory::"+OUT)).To(Succeed())
+
+		repo := Must(ctf.Open(env, accessobj.ACC_READONLY, OUT, 0, env))
+		defer Close(repo, "target")
+		art := Must(repo.LookupArtifact(NS, VERSION))
+		defer Close(art, "target artifact")
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(
+			`
+copying /tmp/ctf//mandelsoft/test:v1 to directory::` + OUT + `//mandelsoft/test:v1...
+  index rewritten for platforms (` + art.Digest().String() + `)
+copied 1 from 1 artifact(s) and 1 repositories
+`))
+		manifests := art.IndexAccess().GetDescriptor().Manifests
+		Expect(manifests).To(HaveLen(1))
+		Expect(manifests[0].Digest).To(Equal(arm64.Digest))
+	})
 })
+
+func withPlatform(d *artdesc.Descriptor, os, arch string) *artdesc.Descriptor {
+	r := *d
+	r.Platform = &artdesc.Platform{OS: os, Architecture: arch}
+	return &r
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package untag
+
+import (
+	"github.com/spf13/cobra"
+
+	ocicommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+var (
+	Names = names.Artifacts
+	Verb  = verbs.Untag
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Refs []string
+}
+
+// NewCommand creates a new untag command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, repooption.New())}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] {<artifact-reference>}",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "remove tags from artifacts",
+		Long: `
+Remove the tags given by the artifact references from their repositories.
+Every reference must contain a tag. The tagged artifacts are kept.
+
+The removal of tags is not supported by all repository types. For OCI
+registries it is an optional feature of the distribution API, which is not
+supported by all registries. The docker daemon deletes an image together
+with its last tag.
+`,
+		Example: `
+$ ocm untag artifact ghcr.io/mandelsoft/kubelink:v1.0.0
+$ ocm untag artifact --repo ctf mandelsoft/kubelink:v1.0.0 mandelsoft/kubelink:latest
+`,
+	}
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Refs = args
+	return nil
+}
+
+func (o *Command) Run() (rerr error) {
+	session := oci.NewSession(nil)
+	defer errors.PropagateError(&rerr, func() error {
+		return session.Close()
+	})
+
+	err := o.ProcessOnOptions(ocicommon.CompleteOptionsWithContext(o.Context, session))
+	if err != nil {
+		return err
+	}
+
+	printer := common.NewPrinter(o.Context.StdOut())
+	errs := errors.ErrListf("removing tags")
+	for _, ref := range o.Refs {
+		err := o.untag(session, ref)
+		if err != nil {
+			printer.Printf("failed removing tag %s: %s\n", ref, err)
+			errs.Add(errors.Wrapf(err, "%s", ref))
+		} else {
+			printer.Printf("removed tag %s\n", ref)
+		}
+	}
+	return errs.Result()
+}
+
+func (o *Command) untag(session oci.Session, ref string) error {
+	var ns oci.NamespaceAccess
+	var tag *string
+
+	repo := repooption.From(o).Repository
+	if repo != nil {
+		art, err := oci.ParseArt(ref)
+		if err != nil {
+			return err
+		}
+		ns, err = session.LookupNamespace(repo, art.Repository)
+		if err != nil {
+			return err
+		}
+		tag = art.Tag
+	} else {
+		r, err := session.EvaluateRef(o.Context.OCIContext(), ref)
+		if err != nil {
+			return err
+		}
+		if r.Namespace == nil {
+			return errors.Newf("no namespace specified")
+		}
+		ns = r.Namespace
+		tag = r.Ref.Tag
+	}
+	if tag == nil {
+		return errors.Newf("no tag specified")
+	}
+	if r, ok := ns.(oci.TagRemover); ok {
+		return r.RemoveTags(*tag)
+	}
+	return errors.ErrNotSupported("tag removal")
+}
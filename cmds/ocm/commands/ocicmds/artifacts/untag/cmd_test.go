@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package untag_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const (
+	ARCH     = "/tmp/ctf"
+	VERSION1 = "v1"
+	VERSION2 = "v2"
+	NS       = "mandelsoft/test"
+)
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCICommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Namespace(NS, func() {
+				env.Manifest(VERSION1, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata")
+					})
+				})
+				env.Manifest(VERSION2, func() {
+					env.Config(func() {
+						env.BlobStringData(mime.MIME_JSON, "{}")
+					})
+					env.Layer(func() {
+						env.BlobStringData(mime.MIME_TEXT, "otherdata")
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	tags := func() []string {
+		repo := Must(ctf.Open(env, accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(repo, "repo")
+		ns := Must(repo.LookupNamespace(NS))
+		defer Close(ns, "namespace")
+		return Must(ns.ListTags())
+	}
+
+	It("removes tags", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("untag", "artifact", "--repo", ARCH, NS+":"+VERSION1)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+removed tag mandelsoft/test:v1
+`))
+		Expect(tags()).To(ConsistOf(VERSION2))
+	})
+
+	It("requires tags", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("oci", "artifact", "untag", ARCH+"//"+NS, ARCH+"//"+NS+":"+VERSION2)).To(MatchError(`removing tags: /tmp/ctf//mandelsoft/test: no tag specified`))
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+failed removing tag /tmp/ctf//mandelsoft/test: no tag specified
+removed tag /tmp/ctf//mandelsoft/test:v2
+`))
+		Expect(tags()).To(ConsistOf(VERSION1))
+	})
+})
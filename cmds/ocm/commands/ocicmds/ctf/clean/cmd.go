@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clean
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/dryrunoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/out"
+)
+
+var (
+	Names = names.TransportArchive
+	Verb  = verbs.Clean
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Verify bool
+	Path   string
+}
+
+// NewCommand creates a new ctf cleanup command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, dryrunoption.New("only report unreferenced blobs and inconsistencies", false))}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] <path>",
+		Args:  cobra.ExactArgs(1),
+		Short: "cleanup and check OCI/OCM transport archive",
+		Long: `
+Remove all blobs from a transport archive, which are not referenced
+by any artifact described by the archive index. This might be a directory
+or a tar/tgz file.
+
+Additionally, references to missing blobs are reported. With option
+<code>--verify</code> the digests of all blobs are recomputed to detect
+corrupted content. Artifacts referring to a kept artifact by their
+subject (for example detached signatures) are kept, too.
+If inconsistencies are found, the command fails without removing
+any blob. With option <code>--dry-run</code> the archive is not modified.
+`,
+		Example: `
+$ ocm clean ctf --dry-run --verify transport.tgz
+`,
+	}
+}
+
+func (o *Command) AddFlags(fs *pflag.FlagSet) {
+	o.BaseCommand.AddFlags(fs)
+	fs.BoolVarP(&o.Verify, "verify", "", false, "verify digests of all blobs")
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Path = args[0]
+	return nil
+}
+
+func (o *Command) Run() (rerr error) {
+	dryrun := dryrunoption.From(o).DryRun
+	mode := accessobj.ACC_WRITABLE
+	if dryrun {
+		mode = accessobj.ACC_READONLY
+	}
+	repo, err := ctf.Open(o.Context.OCIContext(), mode, o.Path, 0o700, accessio.PathFileSystem(o.Context.FileSystem()))
+	if err != nil {
+		return err
+	}
+	defer errors.PropagateError(&rerr, repo.Close)
+
+	report, err := repo.Cleanup(ctf.CleanupOptions{DryRun: dryrun, Verify: o.Verify})
+	if report == nil {
+		return err
+	}
+
+	for _, d := range report.Dangling {
+		out.Outf(o.Context, "dangling reference %s\n", d)
+	}
+	for _, c := range report.Corrupt {
+		out.Outf(o.Context, "corrupt blob %s\n", c)
+	}
+	switch {
+	case !report.IsConsistent():
+		out.Outf(o.Context, "Found %d unreferenced blob(s), nothing removed\n", len(report.Unreferenced))
+	case dryrun:
+		out.Outf(o.Context, "Would remove %d unreferenced blob(s)\n", len(report.Unreferenced))
+	default:
+		out.Outf(o.Context, "Successfully removed %d unreferenced blob(s)\n", len(report.Unreferenced))
+	}
+	if !report.IsConsistent() {
+		return errors.Newf("transport archive %q is inconsistent (%d dangling reference(s), %d corrupt blob(s))", o.Path, len(report.Dangling), len(report.Corrupt))
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clean_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const VERSION1 = "1.0.0"
+const VERSION2 = "2.0.0"
+const COMP = "test.de/x"
+const PROVIDER = "mandelsoft"
+
+const DATA = "testdata v2"
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	setup := func(format accessio.FileFormat) {
+		env.OCMCommonTransport(ARCH, format, func() {
+			env.Component(COMP, func() {
+				env.Version(VERSION1, func() {
+					env.Provider(PROVIDER)
+					env.Resource("test", VERSION1, resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata v1")
+					})
+				})
+			})
+			env.Component(COMP, func() {
+				env.Version(VERSION2, func() {
+					env.Provider(PROVIDER)
+					env.Resource("test", VERSION2, resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, DATA)
+					})
+				})
+			})
+		})
+		MustBeSuccessful(env.Execute("delete", "components", ARCH+"//"+COMP+":"+VERSION1))
+	}
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	blobs := func() int {
+		list := Must(vfs.ReadDir(env.FileSystem(), ARCH+"/blobs"))
+		return len(list)
+	}
+
+	It("reports unreferenced blobs with dry-run", func() {
+		setup(accessio.FormatDirectory)
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", "--dry-run", "--verify", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+Would remove 4 unreferenced blob(s)
+`))
+		Expect(blobs()).To(Equal(8))
+	})
+
+	It("removes unreferenced blobs", func() {
+		setup(accessio.FormatDirectory)
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+Successfully removed 4 unreferenced blob(s)
+`))
+		Expect(blobs()).To(Equal(4))
+
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("get", "components", "--repo", ARCH, COMP)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+COMPONENT VERSION PROVIDER
+test.de/x 2.0.0   mandelsoft
+`))
+	})
+
+	It("removes unreferenced blobs from tgz", func() {
+		setup(accessio.FormatTGZ)
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+Successfully removed 4 unreferenced blob(s)
+`))
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", "--dry-run", "--verify", ARCH)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+Would remove 0 unreferenced blob(s)
+`))
+	})
+
+	It("reports corrupt and dangling blobs", func() {
+		setup(accessio.FormatDirectory)
+		blob := ARCH + "/blobs/" + common.DigestToFileName(digest.FromString(DATA))
+		MustBeSuccessful(vfs.WriteFile(env.FileSystem(), blob, []byte("modified"), 0o600))
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", "--dry-run", "--verify", ARCH)).To(MatchError(ContainSubstring("inconsistent (0 dangling reference(s), 1 corrupt blob(s))")))
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+corrupt blob sha256:` + digest.FromString(DATA).Encoded() + `: digest mismatch: found ` + digest.FromString("modified").String() + `
+Found 4 unreferenced blob(s), nothing removed
+`))
+
+		MustBeSuccessful(env.FileSystem().Remove(blob))
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("clean", "ctf", "--verify", ARCH)).To(MatchError(ContainSubstring("inconsistent (1 dangling reference(s), 0 corrupt blob(s))")))
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+dangling reference component-descriptors/test.de/x:2.0.0: missing blob ` + digest.FromString(DATA).String() + `
+Found 4 unreferenced blob(s), nothing removed
+`))
+		Expect(blobs()).To(Equal(7))
+	})
+})
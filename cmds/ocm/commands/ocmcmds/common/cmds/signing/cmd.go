@@ -87,7 +87,7 @@ func (o *SignatureCommand) Run() (rerr error) {
 	repo := repooption.From(o).Repository
 	lookup := lookupoption.From(o)
 	handler := comphdlr.NewTypeHandler(o.Context.OCM(), session, repo, comphdlr.OptionsFor(o))
-	sopts := signing.NewOptions(sign, signing.Resolver(repo, lookup.Resolver))
+	sopts := signing.NewOptions(sign, signing.Resolver(repo, lookup.Resolver), signing.WithPolicyReport(signing.NewPolicyReport()))
 	err = sopts.Complete(o.Context.OCMContext())
 	if err != nil {
 		return err
@@ -158,6 +158,12 @@ func (a *action) Close() error {
 }
 
 func (a *action) Out() error {
+	if results := a.sopts.PolicyReport.Results(); len(results) > 0 {
+		a.printer.Printf("verification policies:\n")
+		for _, r := range results {
+			a.printer.Printf("  %s\n", r)
+		}
+	}
 	if a.errlist.Len() > 0 {
 		a.printer.Printf("finished with %d error(s)\n", a.errlist.Len())
 	}
@@ -48,3 +48,10 @@ var JSONOption = flagsets.NewYAMLOptionType("inputJson", "JSON formatted text")
 var FormattedJSONOption = flagsets.NewYAMLOptionType("inputFormattedJson", "JSON formatted text")
 
 var HelmRepositoryOption = flagsets.NewStringOptionType("inputHelmRepository", "helm repository base URL")
+
+var (
+	RepositoryOption = flagsets.NewStringOptionType("inputRepository", "repository URL for inputs")
+	RefOption        = flagsets.NewStringOptionType("inputRef", "git reference (branch or tag) for inputs")
+	CommitOption     = flagsets.NewStringOptionType("inputCommit", "git commit id for inputs")
+	PathSpecOption   = flagsets.NewStringOptionType("inputPathSpec", "sub directory in git repository tree for inputs")
+)
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/testutils"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/options"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs/types/git"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/utils/tarutils"
+)
+
+var _ = Describe("Input Type", func() {
+	var env *InputTest
+
+	BeforeEach(func() {
+		env = NewInputTest(git.TYPE)
+	})
+
+	It("simple decode", func() {
+		env.Set(options.RepositoryOption, "https://github.com/open-component-model/ocm")
+		env.Set(options.RefOption, "main")
+		env.Set(options.CommitOption, "abcdef")
+		env.Set(options.PathSpecOption, "docs")
+		env.Check(&git.Spec{
+			Repository: "https://github.com/open-component-model/ocm",
+			Ref:        "main",
+			Commit:     "abcdef",
+			PathSpec:   "docs",
+		})
+	})
+})
+
+var _ = Describe("Add Sources", func() {
+	const ARCH = "/tmp/ca"
+
+	var env *TestEnv
+	var dir string
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+
+		dir = Must(os.MkdirTemp("", "gittest-"))
+		repo := Must(gogit.PlainInit(dir, false))
+		MustBeSuccessful(os.MkdirAll(filepath.Join(dir, "sub"), 0o700))
+		MustBeSuccessful(os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0o600))
+		MustBeSuccessful(os.WriteFile(filepath.Join(dir, "sub", "file"), []byte("file"), 0o600))
+		wt := Must(repo.Worktree())
+		Must(wt.Add("."))
+		Must(wt.Commit("initial", &gogit.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@acme.org", When: time.Now()},
+		}))
+
+		Expect(env.Execute("create", "ca", "-ft", "directory", "test.de/x", "v1", "--provider", "mandelsoft", "--file", ARCH)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+		os.RemoveAll(dir)
+	})
+
+	It("adds git source", func() {
+		Expect(env.Execute("add", "sources", ARCH,
+			"--name", "sources",
+			"--type", "git",
+			"--version", "v1",
+			"--inputType", "git",
+			"--inputRepository", "file://"+dir,
+			"--inputPathSpec", "sub")).To(Succeed())
+
+		cd := Must(compdesc.Decode(Must(env.ReadFile(env.Join(ARCH, comparch.ComponentDescriptorFileName)))))
+		s := Must(cd.GetSourceByIdentity(metav1.NewIdentity("sources")))
+		spec := Must(env.OCMContext().AccessSpecForSpec(s.Access))
+		Expect(spec.GetType()).To(Equal(localblob.Type))
+		Expect(spec.(*localblob.AccessSpec).MediaType).To(Equal(mime.MIME_TGZ))
+
+		f := Must(env.Open(env.Join(ARCH, comparch.BlobsDirectoryName, spec.(*localblob.AccessSpec).LocalReference)))
+		defer f.Close()
+		Expect(tarutils.ListArchiveContentFromReader(f)).To(ConsistOf("file"))
+	})
+})
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess/git"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+type Spec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	// Repository is the URL of the Git repository.
+	Repository string `json:"repository"`
+	// Ref is an optional branch or tag name (or full reference name).
+	Ref string `json:"ref,omitempty"`
+	// Commit is an optional commit id. It takes precedence over Ref.
+	Commit string `json:"commit,omitempty"`
+	// PathSpec is an optional sub directory in the repository tree.
+	PathSpec string `json:"pathSpec,omitempty"`
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+
+func New(repository, ref, commit, pathSpec string) *Spec {
+	return &Spec{
+		ObjectVersionedType: runtime.NewVersionedTypedObject(TYPE),
+		Repository:          repository,
+		Ref:                 ref,
+		Commit:              commit,
+		PathSpec:            pathSpec,
+	}
+}
+
+func (s *Spec) Validate(fldPath *field.Path, ctx inputs.Context, inputFilePath string) field.ErrorList {
+	var allErrs field.ErrorList
+	path := fldPath.Child("repository")
+	if s.Repository == "" {
+		allErrs = append(allErrs, field.Required(path, "repository URL is required"))
+	} else if _, err := url.Parse(s.Repository); err != nil {
+		allErrs = append(allErrs, field.Invalid(path, s.Repository, err.Error()))
+	}
+	return allErrs
+}
+
+func (s *Spec) GetBlob(ctx inputs.Context, info inputs.InputResourceInfo) (blobaccess.BlobAccess, string, error) {
+	blob, err := git.BlobAccessForGit(s.Repository,
+		git.WithCredentialContext(ctx),
+		git.WithRef(s.Ref),
+		git.WithCommit(s.Commit),
+		git.WithPathSpec(s.PathSpec),
+	)
+	return blob, "", err
+}
+
+func (s *Spec) GetInputVersion(ctx inputs.Context) string {
+	return ""
+}
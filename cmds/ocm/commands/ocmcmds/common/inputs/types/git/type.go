@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+)
+
+const TYPE = "git"
+
+func init() {
+	inputs.DefaultInputTypeScheme.Register(inputs.NewInputType(TYPE, &Spec{}, usage, ConfigHandler()))
+}
+
+const usage = `
+The repository must denote a Git repository URL (HTTP(S) or <code>file://</code>).
+The file tree of the selected commit is provided as gzipped tar archive.
+Credentials are taken from the credential context using the consumer type
+<code>Git</code> and the repository URL as hostpath identity.
+
+This blob type specification supports the following fields:
+- **<code>repository</code>** *string*
+
+  This REQUIRED property describes the URL of the Git repository.
+
+- **<code>ref</code>** *string*
+
+  This OPTIONAL property describes a branch or tag name, or a full
+  reference name. If neither a ref nor a commit is given, the default branch
+  is used.
+
+- **<code>commit</code>** *string*
+
+  This OPTIONAL property describes the commit id to use. It takes precedence
+  over the <code>ref</code> field.
+
+- **<code>pathSpec</code>** *string*
+
+  This OPTIONAL property describes a sub directory in the repository tree.
+  If specified, only the file tree below this directory is used.
+`
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build unix
+
+package plugin_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/plugindirattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+)
+
+const CA = "/tmp/ca"
+const VERSION = "v1"
+
+var _ = Describe("Add with plugin input type", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv(TestData())
+		plugindirattr.Set(env.OCMContext(), "testdata")
+
+		Expect(env.Execute("create", "ca", "-ft", "directory", "test.de/x", VERSION, "--provider", "mandelsoft", "--file", CA)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	check := func() {
+		data := Must(env.ReadFile(env.Join(CA, comparch.ComponentDescriptorFileName)))
+		cd := Must(compdesc.Decode(data))
+		Expect(len(cd.Resources)).To(Equal(1))
+
+		r := Must(cd.GetResourceByIdentity(metav1.NewIdentity("text")))
+		Expect(r.Type).To(Equal("testContent"))
+		Expect(r.Version).To(Equal("v0.1.0"))
+		Expect(r.Relation).To(Equal(metav1.ResourceRelation("local")))
+		Expect(r.Access.GetType()).To(Equal("localBlob"))
+
+		blobs := Must(env.ReadDir(env.Join(CA, comparch.BlobsDirectoryName)))
+		Expect(len(blobs)).To(Equal(1))
+		data = Must(env.ReadFile(env.Join(CA, comparch.BlobsDirectoryName, blobs[0].Name())))
+		Expect(string(data)).To(Equal("some text\n"))
+	}
+
+	It("adds resource by options", func() {
+		Expect(env.Execute("add", "resources", CA,
+			"--type", "testContent",
+			"--name", "text",
+			"--version", "v0.1.0",
+			"--inputType", "test",
+			"--testText", "some text")).To(Succeed())
+		check()
+	})
+
+	It("adds resource by description file", func() {
+		env.WriteFile("/tmp/resources.yaml", []byte(`
+name: text
+type: testContent
+version: v0.1.0
+input:
+  type: test
+  text: some text
+`), 0o600)
+		Expect(env.Execute("add", "resources", CA, "/tmp/resources.yaml")).To(Succeed())
+		check()
+	})
+
+	It("rejects invalid specification", func() {
+		env.WriteFile("/tmp/resources.yaml", []byte(`
+name: text
+type: testContent
+version: v0.1.0
+input:
+  type: test
+`), 0o600)
+		Expect(env.Execute("add", "resources", CA, "/tmp/resources.yaml")).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Spec is the generic input specification for all input types
+// provided by plugins.
+type Spec struct {
+	runtime.UnstructuredVersionedTypedObject `json:",inline"`
+	plugin                                   plugin.Plugin
+}
+
+var _ inputs.InputSpec = (*Spec)(nil)
+
+func (s *Spec) Validate(fldPath *field.Path, ctx inputs.Context, inputFilePath string) field.ErrorList {
+	_, err := s.validate(inputFilePath)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, s.GetType(), err.Error())}
+	}
+	return nil
+}
+
+func (s *Spec) GetInputVersion(ctx inputs.Context) string {
+	return ""
+}
+
+func (s *Spec) GetBlob(ctx inputs.Context, info inputs.InputResourceInfo) (blobaccess.BlobAccess, string, error) {
+	sinfo, err := s.validate(info.InputFilePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var creds credentials.Credentials
+	if len(sinfo.ConsumerId) > 0 {
+		creds, err = credentials.CredentialsForConsumer(ctx, sinfo.ConsumerId, hostpath.IdentityMatcher(sinfo.ConsumerId.Type()))
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	var creddata json.RawMessage
+	if creds != nil {
+		creddata, err = json.Marshal(creds)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	spec, err := s.GetRaw()
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "cannot marshal input specification")
+	}
+	w := plugin.NewInputDataWriter(s.plugin, creddata, spec, baseDir(info.InputFilePath))
+	return accessobj.CachedBlobAccessForWriter(ctx, sinfo.MediaType, w), sinfo.Hint, nil
+}
+
+func (s *Spec) validate(inputFilePath string) (*plugin.InputSpecInfo, error) {
+	if s.plugin == nil {
+		return nil, errors.ErrUnknown(inputs.KIND_INPUTTYPE, s.GetType())
+	}
+	if s.plugin.GetInputTypeDescriptor(s.GetKind(), s.GetVersion()) == nil {
+		return nil, errors.ErrNotFound(inputs.KIND_INPUTTYPE, s.GetType(), plugin.KIND_PLUGIN, s.plugin.Name())
+	}
+	spec, err := s.GetRaw()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot marshal input specification")
+	}
+	return s.plugin.ValidateInputSpec(spec, baseDir(inputFilePath))
+}
+
+func baseDir(inputFilePath string) string {
+	if inputFilePath == "" {
+		return ""
+	}
+	return filepath.Dir(inputFilePath)
+}
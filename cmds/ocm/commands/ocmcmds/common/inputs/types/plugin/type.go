@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/inputs"
+	"github.com/open-component-model/ocm/pkg/cobrautils/flagsets"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/options"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/plugincacheattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+type inputType struct {
+	inputs.InputType
+	plug    plugin.Plugin
+	cliopts flagsets.ConfigOptionTypeSet
+}
+
+var _ inputs.InputType = (*inputType)(nil)
+
+func NewType(name string, p plugin.Plugin, desc *plugin.InputTypeDescriptor) inputs.InputType {
+	usage := desc.Description
+	if desc.Format != "" {
+		usage += "\n" + desc.Format
+	}
+
+	t := &inputType{
+		plug: p,
+	}
+
+	cfghdlr := flagsets.NewConfigOptionTypeSetHandler(name, t.AddConfig)
+	for _, o := range desc.CLIOptions {
+		var opt flagsets.ConfigOptionType
+		if o.Type == "" {
+			opt = options.DefaultRegistry.GetOptionType(o.Name)
+			if opt == nil {
+				p.Context().Logger(plugin.TAG).Warn("unknown option", "plugin", p.Name(), "inputtype", name, "option", o.Name)
+			}
+		} else {
+			var err error
+			opt, err = options.DefaultRegistry.CreateOptionType(o.Type, o.Name, o.Description)
+			if err != nil {
+				p.Context().Logger(plugin.TAG).Warn("invalid option", "plugin", p.Name(), "inputtype", name, "option", o.Name, "error", err.Error())
+			}
+		}
+		if opt != nil {
+			cfghdlr.AddOptionType(opt)
+		}
+	}
+	if cfghdlr.Size() > 0 {
+		t.cliopts = cfghdlr
+		t.InputType = inputs.NewInputType(name, &Spec{}, usage, cfghdlr)
+	} else {
+		t.InputType = inputs.NewInputType(name, &Spec{}, usage, nil)
+	}
+	return t
+}
+
+func (t *inputType) Decode(data []byte, unmarshaler runtime.Unmarshaler) (inputs.InputSpec, error) {
+	spec, err := t.InputType.Decode(data, unmarshaler)
+	if err != nil {
+		return nil, err
+	}
+	spec.(*Spec).plugin = t.plug
+	return spec, nil
+}
+
+func (t *inputType) AddConfig(opts flagsets.ConfigOptions, cfg flagsets.Config) error {
+	opts = opts.FilterBy(t.cliopts.HasOptionType)
+	return t.plug.ComposeInputSpec(t.GetType(), opts, cfg)
+}
+
+// RegisterExtensions provides a dedicated input type scheme for the
+// given CLI context including the input types provided by the plugins
+// found for the OCM context.
+func RegisterExtensions(ctx clictx.Context) error {
+	pi := plugincacheattr.Get(ctx.OCMContext())
+
+	var scheme inputs.InputTypeScheme
+	for _, n := range pi.PluginNames() {
+		p := pi.Get(n)
+		if !p.IsValid() {
+			continue
+		}
+		for _, t := range p.GetDescriptor().InputTypes {
+			name := t.Name
+			if t.Version != "" {
+				name = name + runtime.VersionSeparator + t.Version
+			}
+			if scheme == nil {
+				scheme = inputs.NewInputTypeScheme(nil)
+				for _, n := range inputs.DefaultInputTypeScheme.KnownTypeNames() {
+					scheme.Register(inputs.DefaultInputTypeScheme.GetInputType(n))
+				}
+			}
+			p.Context().Logger(plugin.TAG).Info("registering input type",
+				"plugin", p.Name(),
+				"type", name)
+			scheme.Register(NewType(name, p, &t))
+		}
+	}
+	if scheme != nil {
+		inputs.SetFor(ctx, scheme)
+	}
+	return nil
+}
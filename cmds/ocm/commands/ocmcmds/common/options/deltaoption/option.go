@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deltaoption
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
+	"github.com/open-component-model/ocm/pkg/cobrautils/flag"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+)
+
+func From(o options.OptionSetProvider) *Option {
+	var opt *Option
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+func New() *Option {
+	return &Option{}
+}
+
+type Option struct {
+	standard.TransferOptionsCreator
+	flag  *pflag.Flag
+	Delta bool
+}
+
+var _ transferhandler.TransferOption = (*Option)(nil)
+
+func (o *Option) AddFlags(fs *pflag.FlagSet) {
+	o.flag = flag.BoolVarPF(fs, &o.Delta, "delta", "", false, "reuse resources already stored in target with identical digest")
+}
+
+func (o *Option) Usage() string {
+	s := `
+If the option <code>--delta</code> is given, resources transported by value
+are not transferred again, if an artifact with the same digest is already
+stored in the target for the component. This covers resources of the
+target component version itself and artifacts stored with a global access
+for other versions of the component (for example OCI images uploaded to an
+OCI registry). Reused artifacts are reported in the transfer log.
+`
+	return s
+}
+
+func (o *Option) ApplyTransferOption(opts transferhandler.TransferOptions) error {
+	if (o.flag != nil && o.flag.Changed) || o.Delta {
+		return standard.Delta(o.Delta).ApplyTransferOption(opts)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package platformoption
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+)
+
+func From(o options.OptionSetProvider) *Option {
+	var opt *Option
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+func New() *Option {
+	return &Option{}
+}
+
+type Option struct {
+	standard.TransferOptionsCreator
+	Specs     []string
+	Platforms []artdesc.Platform
+}
+
+var _ transferhandler.TransferOption = (*Option)(nil)
+
+func (o *Option) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVarP(&o.Specs, "platform", "", nil, "restrict OCI index resources to platform")
+}
+
+func (o *Option) Complete() error {
+	platforms, err := artdesc.ParsePlatforms(o.Specs...)
+	if err != nil {
+		return err
+	}
+	o.Platforms = platforms
+	return nil
+}
+
+func (o *Option) Usage() string {
+	s := `
+If the option <code>--platform</code> is given, OCI index resources
+transferred by value are restricted to the manifests for the given platforms
+(<code>&lt;os>[/&lt;arch>[/&lt;variant>]]</code>). Because the index is rewritten,
+the digest of such a resource changes. The filter and the original digest are
+recorded in the label <code>` + standard.LABEL_PLATFORMS + `</code> of the resource.
+`
+	return s
+}
+
+func (o *Option) ApplyTransferOption(opts transferhandler.TransferOptions) error {
+	if len(o.Platforms) > 0 {
+		return standard.Platforms(o.Platforms...).ApplyTransferOption(opts)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resumeoption
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+)
+
+func From(o options.OptionSetProvider) *Option {
+	var opt *Option
+	o.AsOptionSet().Get(&opt)
+	return opt
+}
+
+func New() *Option {
+	return &Option{}
+}
+
+type Option struct {
+	standard.TransferOptionsCreator
+	Path    string
+	Journal *journal.Journal
+}
+
+var (
+	_ options.OptionWithCLIContextCompleter = (*Option)(nil)
+	_ transferhandler.TransferOption        = (*Option)(nil)
+)
+
+func (o *Option) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Path, "resume", "", "", "journal file used to resume an interrupted transfer")
+}
+
+func (o *Option) Configure(ctx clictx.Context) error {
+	if o.Path == "" || o.Journal != nil {
+		return nil
+	}
+	j, err := journal.Open(ctx.FileSystem(), o.Path)
+	if err != nil {
+		return err
+	}
+	o.Journal = j
+	return nil
+}
+
+func (o *Option) Usage() string {
+	s := `
+If the option <code>--resume</code> is given, the transfer records completed
+component versions and resources in the given journal file. If the journal
+already exists, the work recorded there is skipped, so that an interrupted
+transfer can be resumed by repeating the command with the same option.
+The journal records the source and target repositories, it is rejected
+for a transfer to another target or from other source repositories.
+After a fully successful transfer the journal file is removed.
+`
+	return s
+}
+
+func (o *Option) ApplyTransferOption(opts transferhandler.TransferOptions) error {
+	if o.Journal != nil {
+		return standard.Journal(o.Journal).ApplyTransferOption(opts)
+	}
+	return nil
+}
+
+// Finish removes the journal after a successful transfer.
+func (o *Option) Finish(err error) error {
+	if err != nil || o.Journal == nil {
+		return err
+	}
+	return o.Journal.Remove()
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/hashoption"
 	"github.com/open-component-model/ocm/cmds/ocm/pkg/options"
 	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keystoreattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/normalizations/jsonv1"
 	ocmsign "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
@@ -23,6 +25,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/signing"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
 	"github.com/open-component-model/ocm/pkg/signing/hasher/sha256"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
 	"github.com/open-component-model/ocm/pkg/utils"
 )
 
@@ -42,6 +45,7 @@ type Option struct {
 	keyoption.Option
 
 	rootca        []string
+	keyrefs       []string
 	local         bool
 	SignMode      bool
 	signAlgorithm string
@@ -61,6 +65,12 @@ type Option struct {
 	Hash hashoption.Option
 
 	Keyless bool
+	// KeyRefs maps signature names to key references (<store>:<alias>)
+	KeyRefs map[string]string
+	// Detached stores signatures separately from the component descriptor
+	Detached bool
+	// TSAURL is the URL of the time stamping authority used to timestamp signatures
+	TSAURL string
 }
 
 func (o *Option) AddFlags(fs *pflag.FlagSet) {
@@ -72,11 +82,14 @@ func (o *Option) AddFlags(fs *pflag.FlagSet) {
 		fs.StringVarP(&o.Issuer, "issuer", "I", "", "issuer name")
 		fs.BoolVarP(&o.Update, "update", "", o.SignMode, "update digest in component versions")
 		fs.BoolVarP(&o.Recursively, "recursive", "R", false, "recursively sign component versions")
+		fs.BoolVarP(&o.Detached, "detached", "", false, "store signature separately from component descriptor")
+		fs.StringVarP(&o.TSAURL, "tsa-url", "", "", "URL of RFC 3161 time stamping authority used to timestamp signatures")
 	} else {
 		fs.BoolVarP(&o.local, "local", "L", false, "verification based on information found in component versions, only")
 	}
 	fs.BoolVarP(&o.Verify, "verify", "V", o.SignMode, "verify existing digests")
 	fs.StringArrayVarP(&o.rootca, "ca-cert", "", o.rootca, "additional root certificates")
+	fs.StringArrayVarP(&o.keyrefs, "keyref", "", nil, "key reference ([<name>=]<store>:<alias>) of a key held by a key store")
 	fs.BoolVar(&o.Keyless, "keyless", false, "use keyless signing")
 }
 
@@ -117,6 +130,24 @@ func (o *Option) Configure(ctx clictx.Context) error {
 		return err
 	}
 
+	if len(o.keyrefs) > 0 {
+		o.KeyRefs = map[string]string{}
+		for _, r := range o.keyrefs {
+			name := o.DefaultName
+			if sep := strings.Index(r, "="); sep > 0 {
+				name = r[:sep]
+				r = r[sep+1:]
+			}
+			if name == "" {
+				return errors.Newf("key name required for key reference %q", r)
+			}
+			if _, err := keyprovider.ParseKeyRef(r); err != nil {
+				return err
+			}
+			o.KeyRefs[name] = r
+		}
+	}
+
 	if len(o.rootca) > 0 {
 		pool, err := signing.BaseRootPool()
 		if err != nil {
@@ -148,12 +179,33 @@ signature name specified with the option <code>--signature</code>.
 Alternatively a key can be specified as base64 encoded string if the argument
 start with the prefix <code>!</code> or as direct string with the prefix
 <code>=</code>.
+
+Keys held by a key store can be used with the option <code>--keyref</code>.
+It has an argument of the form <code>[&lt;name>=]&lt;store>:&lt;alias></code>.
+The key stores are configured with the config type
+<code>` + keystoreattr.ConfigType + `</code>. In signing mode the private key is
+used, otherwise the public key. This way, private keys held by a key store
+can be used without exporting them to the file system.
 `
 
 	if o.SignMode {
 		s += `
 If in signing mode a public key is specified, existing signatures for the
 given signature name will be verified, instead of recreated.
+
+With option <code>--detached</code> the signature is stored separately
+from the component descriptor, which is not updated. For OCI registries
+and transport archives it is stored as artifact referring to the component
+version. This way, released component versions can be countersigned
+without modifying them. Verification always considers embedded and
+detached signatures.
+
+With option <code>--tsa-url</code> the created signature is timestamped by
+the given RFC 3161 time stamping authority. By default, the authority
+configured with the config type <code>` + signingattr.ConfigType + `</code>
+is used. The timestamp is stored along with the signature. Verifications
+validate certificates as of the timestamped time, this way signatures stay
+verifiable after the signing certificate expired.
 `
 		s += `
 
@@ -171,6 +223,13 @@ The following hash modes are supported with option <code>--hash</code>:
 ` + listformat.FormatList(sha256.Algorithm, signing.DefaultRegistry().HasherNames()...)
 
 		signing.DefaultRegistry().HasherNames()
+	} else {
+		s += `
+Additionally, the verification policies configured with the config type
+<code>` + verificationpolicyattr.ConfigType + `</code> are enforced for
+all verified component versions matching a policy. The result of every
+evaluated policy is reported per component version.
+`
 	}
 	return s
 }
@@ -197,9 +256,17 @@ func (o *Option) ApplySigningOption(opts *ocmsign.Options) {
 		if o.Keyless {
 			opts.VerifySignature = true
 		} else {
-			opts.VerifySignature = o.Keys.GetPublicKey(o.SignatureNames[0]) != nil
+			opts.VerifySignature = o.Keys.GetPublicKey(o.SignatureNames[0]) != nil || (!o.SignMode && o.KeyRefs[o.SignatureNames[0]] != "")
 		}
 	}
-	opts.Update = o.Update
+	opts.Update = o.Update && !o.Detached
+	opts.Detached = o.Detached
+	if o.TSAURL != "" {
+		opts.TSAURL = o.TSAURL
+	}
 	opts.Keyless = o.Keyless
+	opts.EnforcePolicies = !o.SignMode
+	for n, r := range o.KeyRefs {
+		ocmsign.KeyRef(n, r).ApplySigningOption(opts)
+	}
 }
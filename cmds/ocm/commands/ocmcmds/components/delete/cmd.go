@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/handlers/comphdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/dryrunoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/versionconstraintsoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/names"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/output"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+var (
+	Names = names.Components
+	Verb  = verbs.Delete
+)
+
+type Command struct {
+	utils.BaseCommand
+
+	Refs []string
+
+	GC bool
+}
+
+// NewCommand creates a new delete command.
+func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
+	return utils.SetupCommand(&Command{BaseCommand: utils.NewBaseCommand(ctx, versionconstraintsoption.New(), repooption.New(), dryrunoption.New("only show component versions to be deleted", false))}, utils.Names(Names, names...)...)
+}
+
+func (o *Command) ForName(name string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "[<options>] {<component-reference>}",
+		Short: "delete component versions",
+		Long: `
+Delete the specified component versions from their repositories.
+If only a component is specified, all versions of the component are deleted.
+
+With option <code>--dry-run</code> the component versions to be deleted
+are only listed. With option <code>--gc</code> local blobs not referenced
+anymore are removed, too, if supported by the repository type (for example
+for Common Transport Archives). OCI registries typically
+remove unreferenced blobs on their own.
+`,
+		Example: `
+$ ocm delete componentversion --repo ctf ghcr.io/mandelsoft/kubelink:v1.0.0
+$ ocm delete componentversion --dry-run --repo OCIRegistry::ghcr.io mandelsoft/kubelink
+`,
+	}
+}
+
+func (o *Command) AddFlags(fs *pflag.FlagSet) {
+	o.BaseCommand.AddFlags(fs)
+	fs.BoolVarP(&o.GC, "gc", "", false, "remove local blobs not referenced anymore")
+}
+
+func (o *Command) Complete(args []string) error {
+	o.Refs = args
+	if len(args) == 0 && repooption.From(o).Spec == "" {
+		return fmt.Errorf("a repository or at least one argument that defines the reference is needed")
+	}
+	return nil
+}
+
+func (o *Command) Run() (rerr error) {
+	session := ocm.NewSession(nil)
+	defer errors.PropagateError(&rerr, func() error {
+		return session.Close()
+	})
+
+	err := o.ProcessOnOptions(ocmcommon.CompleteOptionsWithSession(o, session))
+	if err != nil {
+		return err
+	}
+	handler := comphdlr.NewTypeHandler(o.Context.OCM(), session, repooption.From(o).Repository, comphdlr.OptionsFor(o))
+	return utils.HandleOutput(newAction(o), handler, utils.StringElemSpecs(o.Refs...)...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type action struct {
+	printer common.Printer
+	dryrun  bool
+	gc      bool
+	objects []*comphdlr.Object
+}
+
+var _ output.Output = (*action)(nil)
+
+func newAction(o *Command) *action {
+	return &action{
+		printer: common.NewPrinter(o.Context.StdOut()),
+		dryrun:  dryrunoption.From(o).DryRun,
+		gc:      o.GC,
+	}
+}
+
+func (a *action) Add(e interface{}) error {
+	o, ok := e.(*comphdlr.Object)
+	if !ok {
+		return fmt.Errorf("failed to assert %T to *comphdlr.Object", e)
+	}
+	if o.ComponentVersion == nil {
+		return errors.ErrNotFound(ocm.KIND_COMPONENTVERSION, o.Spec.String())
+	}
+	a.objects = append(a.objects, o)
+	return nil
+}
+
+func (a *action) Close() error {
+	return nil
+}
+
+func (a *action) Out() error {
+	sort.Slice(a.objects, func(i, j int) bool { return comphdlr.Compare(a.objects[i], a.objects[j]) < 0 })
+
+	errs := errors.ErrListf("deleting component versions")
+	for _, o := range a.objects {
+		nv := common.VersionedElementKey(o.ComponentVersion)
+		if a.dryrun {
+			a.printer.Printf("would delete %s\n", nv)
+			continue
+		}
+		var err error
+		if d, ok := o.Repository.(ocm.RepositoryVersionDeleter); ok {
+			err = d.DeleteVersion(nv.GetName(), nv.GetVersion(), a.gc)
+		} else {
+			err = errors.ErrNotSupported("component version deletion", o.Repository.GetSpecification().GetKind())
+		}
+		if err != nil {
+			a.printer.Printf("failed deleting %s: %s\n", nv, err)
+			errs.Add(errors.Wrapf(err, "%s", nv))
+		} else {
+			a.printer.Printf("deleted %s\n", nv)
+		}
+	}
+	return errs.Result()
+}
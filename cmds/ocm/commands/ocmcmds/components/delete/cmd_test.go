@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package delete_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/cmds/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const ARCH = "/tmp/ctf"
+const VERSION1 = "1.0.0"
+const VERSION2 = "2.0.0"
+const COMP = "test.de/x"
+const PROVIDER = "mandelsoft"
+
+var _ = Describe("Test Environment", func() {
+	var env *TestEnv
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMP, func() {
+				env.Version(VERSION1, func() {
+					env.Provider(PROVIDER)
+					env.Resource("test", VERSION1, resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata v1")
+					})
+				})
+			})
+			env.Component(COMP, func() {
+				env.Version(VERSION2, func() {
+					env.Provider(PROVIDER)
+					env.Resource("test", VERSION2, resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "testdata v2")
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	blobs := func() int {
+		list := Must(vfs.ReadDir(env.FileSystem(), ARCH+"/blobs"))
+		return len(list)
+	}
+
+	It("shows versions to be deleted with dry-run", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "components", "--dry-run", "--repo", ARCH, COMP)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+would delete test.de/x:1.0.0
+would delete test.de/x:2.0.0
+`))
+		Expect(blobs()).To(Equal(8))
+	})
+
+	It("deletes a single version", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "components", ARCH+"//"+COMP+":"+VERSION1)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+deleted test.de/x:1.0.0
+`))
+		buf.Reset()
+		Expect(env.CatchOutput(buf).Execute("get", "components", "--repo", ARCH, COMP)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+COMPONENT VERSION PROVIDER
+test.de/x 2.0.0   mandelsoft
+`))
+		Expect(blobs()).To(Equal(8))
+	})
+
+	It("deletes a version and unreferenced blobs", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("delete", "components", "--gc", ARCH+"//"+COMP+":"+VERSION1)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+deleted test.de/x:1.0.0
+`))
+		Expect(blobs()).To(Equal(4))
+	})
+})
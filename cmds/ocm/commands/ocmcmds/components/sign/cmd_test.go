@@ -6,6 +6,7 @@ package sign_test
 
 import (
 	"bytes"
+	"encoding/pem"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -22,11 +23,15 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartifact"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keystoreattr"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	ocmsign "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
 	"github.com/open-component-model/ocm/pkg/mime"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider/file"
 )
 
 const ARCH = "/tmp/ctf"
@@ -44,6 +49,7 @@ const SIGN_ALGO = rsa.Algorithm
 
 const PUBKEY = "/tmp/pub"
 const PRIVKEY = "/tmp/priv"
+const KEYSTORE = "/tmp/keys.pem"
 
 const D_COMPONENTA = "01de99400030e8336020059a435cea4e7fe8f21aad4faf619da882134b85569d"
 const D_COMPONENTB = "5f416ec59629d6af91287e2ba13c6360339b6a0acf624af2abd2a810ce4aefce"
@@ -159,6 +165,54 @@ successfully signed github.com/mandelsoft/ref:v1 (digest SHA-256:${ref})
 			Expect(cv.GetDescriptor().Signatures[0].Digest.Value).To(Equal(D_COMPONENTB))
 		})
 
+		It("sign with key from key store", func() {
+			prepareEnv(env, ARCH, "")
+
+			block := Must(rsa.PemBlockForKey(priv))
+			block.Headers = map[string]string{file.HEADER_ALIAS: "signing"}
+			Expect(vfs.WriteFile(env.FileSystem(), KEYSTORE, pem.EncodeToMemory(block), os.ModePerm)).To(Succeed())
+			cfg := keystoreattr.NewConfig()
+			cfg.AddStore("release", keyprovider.StoreSpec{Type: file.TYPE, Path: KEYSTORE})
+			MustBeSuccessful(env.ConfigContext().ApplyConfig(cfg, "test"))
+
+			buf := bytes.NewBuffer(nil)
+			Expect(env.CatchOutput(buf).Execute("sign", "components", "-s", SIGNATURE, "--keyref", "release:signing", "--repo", ARCH, COMPONENTA+":"+VERSION)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("successfully signed github.com/mandelsoft/test:v1"))
+
+			buf.Reset()
+			Expect(env.CatchOutput(buf).Execute("verify", "components", "-s", SIGNATURE, "-k", PUBKEY, "--repo", ARCH, COMPONENTA+":"+VERSION)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("successfully verified github.com/mandelsoft/test:v1"))
+		})
+
+		It("rejects invalid key reference", func() {
+			prepareEnv(env, ARCH, "")
+
+			buf := bytes.NewBuffer(nil)
+			err := env.CatchOutput(buf).Execute("sign", "components", "-s", SIGNATURE, "--keyref", "signing", "--repo", ARCH, COMPONENTA+":"+VERSION)
+			Expect(err).To(MatchError(`key reference "signing" is invalid`))
+		})
+
+		It("sign detached", func() {
+			prepareEnv(env, ARCH, "")
+
+			buf := bytes.NewBuffer(nil)
+			Expect(env.CatchOutput(buf).Execute("sign", "components", "-s", SIGNATURE, "-K", PRIVKEY, "--detached", "--repo", ARCH, COMPONENTA+":"+VERSION)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("successfully signed github.com/mandelsoft/test:v1 (digest SHA-256:" + D_COMPONENTA))
+
+			repo := Must(ctf.Open(env, accessobj.ACC_READONLY, ARCH, 0, env))
+			defer Close(repo, "repo")
+			cv := Must(repo.LookupComponentVersion(COMPONENTA, VERSION))
+			defer Close(cv, "cv")
+			Expect(cv.GetDescriptor().Signatures).To(BeEmpty())
+			sigs := Must(ocmsign.GetDetachedSignatures(cv))
+			Expect(sigs).To(HaveLen(1))
+			Expect(sigs[0].Digest.Value).To(Equal(D_COMPONENTA))
+
+			buf.Reset()
+			Expect(env.CatchOutput(buf).Execute("verify", "components", "-s", SIGNATURE, "-k", PUBKEY, "--repo", ARCH, COMPONENTA+":"+VERSION)).To(Succeed())
+			Expect(buf.String()).To(ContainSubstring("successfully verified github.com/mandelsoft/test:v1"))
+		})
+
 		It("sign component archive with --lookup option", func() {
 			prepareEnv(env, ARCH2, ARCH)
 
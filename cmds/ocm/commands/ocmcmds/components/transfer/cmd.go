@@ -16,10 +16,13 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/formatoption"
 	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/handlers/comphdlr"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/deltaoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/lookupoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/omitaccesstypeoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/overwriteoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/platformoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/repooption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/resumeoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/rscbyvalueoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/scriptoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/skipupdateoption"
@@ -68,7 +71,10 @@ func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
 		rscbyvalueoption.New(),
 		srcbyvalueoption.New(),
 		omitaccesstypeoption.New(),
+		platformoption.New(),
 		stoponexistingoption.New(),
+		deltaoption.New(),
+		resumeoption.New(),
 		uploaderoption.New(ctx.OCMContext()),
 		scriptoption.New(),
 	)}, utils.Names(Names, names...)...)
@@ -186,6 +192,9 @@ func (a *action) Out() error {
 	if a.errors.Result() != nil {
 		return fmt.Errorf("transfer finished with %d error(s)", a.errors.Len())
 	}
+	if err := resumeoption.From(a.cmd).Finish(nil); err != nil {
+		return err
+	}
 
 	if a.cmd.BOMFile != "" {
 		bom := BOM{}
@@ -29,6 +29,7 @@ import (
 	ctfocm "github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
 	handlercfg "github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/config"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
 	"github.com/open-component-model/ocm/pkg/mime"
 	"github.com/open-component-model/ocm/pkg/utils"
 )
@@ -205,6 +206,40 @@ transferring version "github.com/mandelsoft/test2:v1"...
 `))
 	})
 
+	It("transfers ctf with journal", func() {
+		JOURNAL := "/tmp/journal.json"
+		MustBeSuccessful(env.MkdirAll("/tmp", 0o700))
+		MustBeSuccessful(env.WriteFile(JOURNAL, []byte(`{
+  "sources": [{"type":"CommonTransportFormat","filePath":"`+ARCH2+`","fileFormat":"directory","componentNameMapping":"urlPath"}],
+  "target": {"type":"CommonTransportFormat","filePath":"`+OUT+`","fileFormat":"directory","componentNameMapping":"urlPath"},
+  "componentVersions":{"github.com/mandelsoft/test2:v1":{"completed":true}}
+}`), 0o600))
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--resume", JOURNAL, "--copy-resources", "--recursive", "--lookup", ARCH, ARCH2, ARCH2, OUT)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+transferring version "github.com/mandelsoft/test2:v1"...
+  version "github.com/mandelsoft/test2:v1" already transferred according to journal -> skip transport
+1 versions transferred
+`))
+		Expect(env.FileExists(JOURNAL)).To(BeFalse())
+	})
+
+	It("rejects journal of another transfer", func() {
+		JOURNAL := "/tmp/journal.json"
+		MustBeSuccessful(env.MkdirAll("/tmp", 0o700))
+		MustBeSuccessful(env.WriteFile(JOURNAL, []byte(`{
+  "sources": [{"type":"CommonTransportFormat","filePath":"`+ARCH2+`","fileFormat":"directory","componentNameMapping":"urlPath"}],
+  "target": {"type":"CommonTransportFormat","filePath":"/tmp/other","fileFormat":"directory","componentNameMapping":"urlPath"},
+  "componentVersions":{"github.com/mandelsoft/test2:v1":{"completed":true}}
+}`), 0o600))
+
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--resume", JOURNAL, "--copy-resources", "--recursive", "--lookup", ARCH, ARCH2, ARCH2, OUT)).NotTo(Succeed())
+		Expect(buf.String()).To(ContainSubstring("was created for another target repository"))
+		Expect(env.FileExists(JOURNAL)).To(BeTrue())
+	})
+
 	It("transfers ctf to tgz with type option", func() {
 		buf := bytes.NewBuffer(nil)
 		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--copy-resources", "--type", accessio.FormatTGZ.String(), ARCH, ARCH, OUT)).To(Succeed())
@@ -306,3 +341,80 @@ transferring version "github.com/mandelsoft/test:v1"...
 		CheckComponentInArchive(env, ldesc, OUT)
 	})
 })
+
+var _ = Describe("Platform filter", func() {
+	var (
+		env   *TestEnv
+		arm64 *artdesc.Descriptor
+	)
+
+	BeforeEach(func() {
+		env = NewTestEnv()
+
+		FakeOCIRepo(env.Builder, OCIPATH, OCIHOST)
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			amd64, _ := OCIManifest1For(env.Builder, "ocm/multi", "")
+			arm64, _ = OCIManifest2For(env.Builder, "ocm/multi", "")
+			env.Namespace("ocm/multi", func() {
+				env.Index(OCIVERSION, func() {
+					env.Artifact(withPlatform(amd64, "linux", "amd64"))
+					env.Artifact(withPlatform(arm64, "linux", "arm64"))
+				})
+			})
+		})
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("multi", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartifact.New(oci.StandardOCIRef(OCIHOST+".alias", "ocm/multi", OCIVERSION)),
+						)
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("transfers filtered index", func() {
+		buf := bytes.NewBuffer(nil)
+		Expect(env.CatchOutput(buf).Execute("transfer", "components", "--copy-resources", "--platform", "linux/arm64", ARCH, ARCH, OUT)).To(Succeed())
+		Expect(buf.String()).To(StringEqualTrimmedWithContext(`
+transferring version "github.com/mandelsoft/test:v1"...
+...resource 0 multi[ociImage](ocm/multi:v2.0)...
+...adding component version...
+1 versions transferred
+`))
+
+		tgt := Must(ctfocm.Open(env.OCMContext(), accessobj.ACC_READONLY, OUT, 0, accessio.PathFileSystem(env.FileSystem())))
+		defer Close(tgt, "ctf")
+		comp := Must(tgt.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(comp, "comvers")
+		var label standard.PlatformsLabel
+		Expect(comp.GetDescriptor().Resources[0].Labels.GetValue(standard.LABEL_PLATFORMS, &label)).To(BeTrue())
+		Expect(label.Platforms).To(Equal([]string{"linux/arm64"}))
+
+		racc := Must(comp.GetResourceByIndex(0))
+		reader := Must(ocm.ResourceReader(racc))
+		defer Close(reader, "reader")
+		set := Must(artifactset.Open(accessobj.ACC_READONLY, "", 0, accessio.Reader(reader)))
+		defer Close(set, "set")
+		art := Must(set.GetArtifact(set.GetMain().String()))
+		defer Close(art, "artifact")
+		manifests := art.IndexAccess().GetDescriptor().Manifests
+		Expect(manifests).To(HaveLen(1))
+		Expect(manifests[0].Digest).To(Equal(arm64.Digest))
+	})
+})
+
+func withPlatform(d *artdesc.Descriptor, os, arch string) *artdesc.Descriptor {
+	r := *d
+	r.Platform = &artdesc.Platform{OS: os, Architecture: arch}
+	return &r
+}
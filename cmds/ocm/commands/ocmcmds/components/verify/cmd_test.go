@@ -25,6 +25,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartifact"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
@@ -155,4 +156,59 @@ applying to version "github.com/mandelsoft/ref:v1"[github.com/mandelsoft/ref:v1]
 successfully verified github.com/mandelsoft/ref:v1 (digest SHA-256:` + digest + `)
 `))
 	})
+
+	Context("verification policies", func() {
+		BeforeEach(func() {
+			session := datacontext.NewSession()
+			defer session.Close()
+
+			src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env))
+			session.AddCloser(src)
+			resolver := ocm.NewCompoundResolver(src)
+			cv := Must(resolver.LookupComponentVersion(COMPONENTB, VERSION))
+			session.AddCloser(cv)
+
+			opts := NewOptions(
+				Sign(signingattr.Get(env.OCMContext()).GetSigner(SIGN_ALGO), SIGNATURE),
+				Issuer("CN=Release-Team"),
+				Resolver(resolver),
+				PrivateKey(SIGNATURE, priv),
+				Update(), VerifyDigests(),
+			)
+			Expect(opts.Complete(DefaultContext)).To(Succeed())
+			Must(Apply(nil, nil, cv, opts))
+		})
+
+		It("reports passed policy", func() {
+			buf := bytes.NewBuffer(nil)
+			MustBeSuccessful(env.ConfigContext().ApplyConfig(verificationpolicyattr.New(verificationpolicyattr.Policy{
+				Name:       "release",
+				Components: []string{COMPONENTB},
+				Signatures: []verificationpolicyattr.SignatureRequirement{{Issuer: "CN=Release-Team"}},
+			}), "test"))
+
+			MustBeSuccessful(env.CatchOutput(buf).Execute("verify", "components", "-V", "-s", SIGNATURE, "-k", PUBKEY, "--repo", ARCH, COMPONENTB+":"+VERSION))
+			Expect(buf.String()).To(ContainSubstring(`
+successfully verified github.com/mandelsoft/ref:v1 (digest SHA-256:5f416ec59629d6af91287e2ba13c6360339b6a0acf624af2abd2a810ce4aefce)
+verification policies:
+  github.com/mandelsoft/ref:v1: policy "release" passed
+`))
+		})
+
+		It("reports failed policy", func() {
+			buf := bytes.NewBuffer(nil)
+			MustBeSuccessful(env.ConfigContext().ApplyConfig(verificationpolicyattr.New(verificationpolicyattr.Policy{
+				Name:       "release",
+				Components: []string{"github.com/mandelsoft/*"},
+				Signatures: []verificationpolicyattr.SignatureRequirement{{Issuer: "CN=Release-Team"}},
+			}), "test"))
+
+			Expect(env.CatchOutput(buf).Execute("verify", "components", "-V", "-s", SIGNATURE, "-k", PUBKEY, "--repo", ARCH, COMPONENTB+":"+VERSION)).To(HaveOccurred())
+			Expect(buf.String()).To(ContainSubstring(`
+verification policies:
+  github.com/mandelsoft/test:v1: policy "release" failed: no signature found
+finished with 1 error(s)
+`))
+		})
+	})
 })
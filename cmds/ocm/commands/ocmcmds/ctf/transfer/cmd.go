@@ -10,9 +10,12 @@ import (
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/closureoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/common/options/formatoption"
 	ocmcommon "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/deltaoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/lookupoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/omitaccesstypeoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/overwriteoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/platformoption"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/resumeoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/rscbyvalueoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/scriptoption"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/common/options/skipupdateoption"
@@ -57,7 +60,10 @@ func NewCommand(ctx clictx.Context, names ...string) *cobra.Command {
 		rscbyvalueoption.New(),
 		srcbyvalueoption.New(),
 		omitaccesstypeoption.New(),
+		platformoption.New(),
 		stoponexistingoption.New(),
+		deltaoption.New(),
+		resumeoption.New(),
 		uploaderoption.New(ctx.OCMContext()),
 		scriptoption.New(),
 	)}, utils.Names(Names, names...)...)
@@ -121,7 +127,7 @@ func (o *Command) Run() error {
 		closure: transfer.TransportClosure{},
 		errors:  errors.ErrListf("transfer errors"),
 	}
-	return a.Execute(src)
+	return resumeoption.From(o).Finish(a.Execute(src))
 }
 
 /////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"github.com/spf13/cobra"
+
+	artifacts "github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/artifacts/delete"
+	components "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/components/delete"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+)
+
+// NewCommand creates a new command.
+func NewCommand(ctx clictx.Context) *cobra.Command {
+	cmd := utils.MassageCommand(&cobra.Command{
+		Short: "Delete elements",
+	}, verbs.Delete)
+	cmd.AddCommand(components.NewCommand(ctx))
+	cmd.AddCommand(artifacts.NewCommand(ctx))
+	return cmd
+}
@@ -7,6 +7,7 @@ package show
 import (
 	"github.com/spf13/cobra"
 
+	config "github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/config/show"
 	tags "github.com/open-component-model/ocm/cmds/ocm/commands/ocicmds/tags/show"
 	versions "github.com/open-component-model/ocm/cmds/ocm/commands/ocmcmds/versions/show"
 	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
@@ -17,10 +18,11 @@ import (
 // NewCommand creates a new command.
 func NewCommand(ctx clictx.Context) *cobra.Command {
 	cmd := utils.MassageCommand(&cobra.Command{
-		Short: "Show tags or versions",
+		Short: "Show tags, versions or configuration",
 	}, verbs.Show)
 	cmd.AddCommand(versions.NewCommand(ctx))
 	cmd.AddCommand(tags.NewCommand(ctx))
+	cmd.AddCommand(config.NewCommand(ctx))
 
 	return cmd
 }
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"github.com/spf13/cobra"
+
+	config "github.com/open-component-model/ocm/cmds/ocm/commands/misccmds/config/validate"
+	"github.com/open-component-model/ocm/cmds/ocm/commands/verbs"
+	"github.com/open-component-model/ocm/cmds/ocm/pkg/utils"
+	"github.com/open-component-model/ocm/pkg/contexts/clictx"
+)
+
+// NewCommand creates a new command.
+func NewCommand(ctx clictx.Context) *cobra.Command {
+	cmd := utils.MassageCommand(&cobra.Command{
+		Short: "Validate configuration files",
+	}, verbs.Validate)
+	cmd.AddCommand(config.NewCommand(ctx))
+	return cmd
+}
@@ -19,4 +19,7 @@ const (
 	Clean     = "clean"
 	Install   = "install"
 	Execute   = "execute"
+	Delete    = "delete"
+	Validate  = "validate"
+	Untag     = "untag"
 )
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"strings"
+
+	gitfs "github.com/go-git/go-billy/v5/osfs"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess/dirtree"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess/spi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/git/identity"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/optionutils"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+const KIND_GITREF = "git reference"
+
+// BlobAccessForGit provides a blob containing the file tree of a
+// Git repository for a dedicated commit or reference. The tree
+// (or the tree below the optional path spec) is provided as (compressed)
+// tar archive.
+func BlobAccessForGit(repourl string, opts ...Option) (_ spi.BlobAccess, rerr error) {
+	eff := optionutils.EvalOptions(opts...)
+
+	if eff.PathSpec != "" {
+		if p := vfs.Clean(nil, eff.PathSpec); p == ".." || strings.HasPrefix(p, "../") || strings.HasPrefix(p, "/") {
+			return nil, errors.ErrInvalid("path spec", eff.PathSpec)
+		}
+	}
+
+	auth, err := eff.auth(repourl)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := osfs.New()
+	tmp, err := vfs.TempDir(fs, "", "git-")
+	if err != nil {
+		return nil, err
+	}
+	defer errors.PropagateError(&rerr, func() error { return fs.RemoveAll(tmp) })
+
+	tree := vfs.Join(fs, tmp, "tree")
+	repo, hash, err := clone(fs, tmp, repourl, auth, eff.Ref, eff.Commit)
+	if err != nil {
+		return nil, err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	err = wt.Checkout(&gogit.CheckoutOptions{Hash: hash, Force: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot check out %s from git repository %q", hash.String(), repourl)
+	}
+
+	// the worktree contains a .git file linking the separated repository storage.
+	if err := fs.RemoveAll(vfs.Join(fs, tree, ".git")); err != nil {
+		return nil, err
+	}
+
+	path := tree
+	if eff.PathSpec != "" {
+		path = vfs.Join(fs, tree, eff.PathSpec)
+	}
+	return dirtree.BlobAccessForDirTree(path,
+		dirtree.WithFileSystem(fs),
+		dirtree.WithCompressWithGzip(utils.AsBool(eff.CompressWithGzip, true)),
+	)
+}
+
+func BlobAccessProviderForGit(repourl string, opts ...Option) spi.BlobAccessProvider {
+	return spi.BlobAccessProviderFunction(func() (spi.BlobAccess, error) {
+		return BlobAccessForGit(repourl, opts...)
+	})
+}
+
+func (o *Options) auth(repourl string) (transport.AuthMethod, error) {
+	creds := o.Credentials
+	if creds == nil && o.CredentialContext != nil {
+		id := identity.GetConsumerId(repourl)
+		if id == nil {
+			return nil, nil
+		}
+		var err error
+		creds, err = credentials.CredentialsForConsumer(o.CredentialContext, id, identity.IdentityMatcher)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if creds == nil {
+		return nil, nil
+	}
+	pass := creds.GetProperty(identity.ATTR_PASSWORD)
+	if pass == "" {
+		pass = creds.GetProperty(identity.ATTR_TOKEN)
+	}
+	if pass == "" {
+		return nil, nil
+	}
+	user := creds.GetProperty(identity.ATTR_USERNAME)
+	if user == "" {
+		// most Git servers accept any non-empty user name for tokens
+		user = "git"
+	}
+	return &http.BasicAuth{Username: user, Password: pass}, nil
+}
+
+// clone fetches only the requested reference from the repository and
+// determines the commit to check out. Without an explicit commit only the
+// latest commit of the reference is fetched. A commit requires the history
+// of the given reference or, if no reference is given, of all branches.
+// Short names are tried as branch and as tag name.
+func clone(fs vfs.FileSystem, dir, repourl string, auth transport.AuthMethod, ref, commit string) (*gogit.Repository, plumbing.Hash, error) {
+	var candidates []plumbing.ReferenceName
+	switch {
+	case ref == "":
+		candidates = []plumbing.ReferenceName{""}
+	case strings.HasPrefix(ref, "refs/"):
+		candidates = []plumbing.ReferenceName{plumbing.ReferenceName(ref)}
+	default:
+		candidates = []plumbing.ReferenceName{plumbing.NewBranchReferenceName(ref), plumbing.NewTagReferenceName(ref)}
+	}
+
+	opts := &gogit.CloneOptions{
+		URL:          repourl,
+		Auth:         auth,
+		NoCheckout:   true,
+		SingleBranch: ref != "" || commit == "",
+		Tags:         gogit.NoTags,
+	}
+	if commit == "" {
+		opts.Depth = 1
+	}
+
+	tree := vfs.Join(fs, dir, "tree")
+	for _, c := range candidates {
+		for _, p := range []string{tree, vfs.Join(fs, dir, "repo")} {
+			if err := fs.RemoveAll(p); err != nil {
+				return nil, plumbing.ZeroHash, err
+			}
+		}
+		storage := filesystem.NewStorage(gitfs.New(vfs.Join(fs, dir, "repo")), cache.NewObjectLRUDefault())
+		opts.ReferenceName = c
+		repo, err := gogit.Clone(storage, gitfs.New(tree), opts)
+		if err != nil {
+			if errors.Is(err, gogit.NoMatchingRefSpecError{}) {
+				continue
+			}
+			return nil, plumbing.ZeroHash, errors.Wrapf(err, "cannot clone git repository %q", repourl)
+		}
+		hash, err := resolve(repo, c, commit)
+		if err != nil {
+			return nil, plumbing.ZeroHash, errors.Wrapf(err, "git repository %q", repourl)
+		}
+		return repo, hash, nil
+	}
+	return nil, plumbing.ZeroHash, errors.Wrapf(errors.ErrNotFound(KIND_GITREF, ref), "git repository %q", repourl)
+}
+
+// resolve determines the commit to check out. A commit takes precedence over
+// the cloned reference.
+func resolve(repo *gogit.Repository, ref plumbing.ReferenceName, commit string) (plumbing.Hash, error) {
+	if commit != "" {
+		h, err := repo.ResolveRevision(plumbing.Revision(commit))
+		if err != nil {
+			return plumbing.ZeroHash, errors.ErrNotFound("commit", commit)
+		}
+		return *h, nil
+	}
+	rev := plumbing.Revision(plumbing.HEAD)
+	if ref != "" {
+		rev = plumbing.Revision(ref)
+	}
+	h, err := repo.ResolveRevision(rev)
+	if err != nil && ref.IsBranch() {
+		h, err = repo.ResolveRevision(plumbing.Revision(plumbing.NewRemoteReferenceName("origin", ref.Short())))
+	}
+	if err != nil {
+		return plumbing.ZeroHash, errors.ErrNotFound(KIND_GITREF, ref.String())
+	}
+	return *h, nil
+}
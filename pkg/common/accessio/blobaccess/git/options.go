@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/optionutils"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+type Option = optionutils.Option[*Options]
+
+type Options struct {
+	// CredentialContext is used to look up the credentials for the repository,
+	// if no explicit credentials are given.
+	CredentialContext credentials.Context
+	// Credentials are explicit credentials used to access the repository.
+	Credentials credentials.Credentials
+	// Ref is the branch or tag name (or a full reference name) to check out.
+	// If neither Ref nor Commit is given, the default branch is used.
+	Ref string
+	// Commit is the commit (hash or hash prefix) to check out.
+	// It takes precedence over Ref.
+	Commit string
+	// PathSpec is an optional sub-path in the repository, which
+	// should be provided instead of the complete tree.
+	PathSpec string
+	// CompressWithGzip defines whether the resulting archive should be compressed.
+	// Default is true.
+	CompressWithGzip *bool
+}
+
+func (o *Options) ApplyTo(opts *Options) {
+	if opts == nil {
+		return
+	}
+	if o.CredentialContext != nil {
+		opts.CredentialContext = o.CredentialContext
+	}
+	if o.Credentials != nil {
+		opts.Credentials = o.Credentials
+	}
+	if o.Ref != "" {
+		opts.Ref = o.Ref
+	}
+	if o.Commit != "" {
+		opts.Commit = o.Commit
+	}
+	if o.PathSpec != "" {
+		opts.PathSpec = o.PathSpec
+	}
+	if o.CompressWithGzip != nil {
+		opts.CompressWithGzip = utils.BoolP(*o.CompressWithGzip)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type credentialContext struct {
+	credentials.Context
+}
+
+func (o credentialContext) ApplyTo(opts *Options) {
+	opts.CredentialContext = o.Context
+}
+
+func WithCredentialContext(ctx credentials.ContextProvider) Option {
+	return credentialContext{ctx.CredentialsContext()}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type creds struct {
+	credentials.Credentials
+}
+
+func (o creds) ApplyTo(opts *Options) {
+	opts.Credentials = o.Credentials
+}
+
+func WithCredentials(c credentials.Credentials) Option {
+	return creds{c}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type ref string
+
+func (o ref) ApplyTo(opts *Options) {
+	opts.Ref = string(o)
+}
+
+func WithRef(r string) Option {
+	return ref(r)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type commit string
+
+func (o commit) ApplyTo(opts *Options) {
+	opts.Commit = string(o)
+}
+
+func WithCommit(c string) Option {
+	return commit(c)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type pathSpec string
+
+func (o pathSpec) ApplyTo(opts *Options) {
+	opts.PathSpec = string(o)
+}
+
+func WithPathSpec(p string) Option {
+	return pathSpec(p)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type compressWithGzip bool
+
+func (o compressWithGzip) ApplyTo(opts *Options) {
+	opts.CompressWithGzip = utils.BoolP(o)
+}
+
+func WithCompressWithGzip(b ...bool) Option {
+	return compressWithGzip(utils.OptionalDefaultedBool(true, b...))
+}
@@ -9,37 +9,128 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/open-component-model/ocm/pkg/common/accessio/downloader"
+	"github.com/open-component-model/ocm/pkg/errors"
 )
 
-// Downloader simply uses the default HTTP client to download the contents of a URL.
+// DefaultClient is the client used for downloads if no explicit
+// client is given. In contrast to http.DefaultClient it limits the time
+// for establishing a connection and waiting for the response. The transfer
+// of the content is not limited to support large downloads.
+var DefaultClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 60 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	},
+}
+
 type Downloader struct {
-	link string
+	link   string
+	header http.Header
+	client *http.Client
 }
 
-func NewDownloader(link string) downloader.Downloader {
-	return &Downloader{
-		link: link,
+var _ downloader.Downloader = (*Downloader)(nil)
+
+// Option configures a Downloader.
+type Option func(d *Downloader)
+
+// WithHeader adds request headers.
+func WithHeader(h http.Header) Option {
+	return func(d *Downloader) {
+		for k, values := range h {
+			for _, v := range values {
+				d.header.Add(k, v)
+			}
+		}
 	}
 }
 
-func (h *Downloader) Download(w io.WriterAt) error {
+// WithBasicAuth sets basic auth credentials for the request.
+func WithBasicAuth(user, pass string) Option {
+	return func(d *Downloader) {
+		r := http.Request{Header: http.Header{}}
+		r.SetBasicAuth(user, pass)
+		d.header.Set("Authorization", r.Header.Get("Authorization"))
+	}
+}
+
+// WithToken sets a bearer token for the request.
+func WithToken(token string) Option {
+	return func(d *Downloader) {
+		d.header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// WithClient sets the http client used for the request.
+func WithClient(c *http.Client) Option {
+	return func(d *Downloader) {
+		d.client = c
+	}
+}
+
+func NewDownloader(link string, opts ...Option) *Downloader {
+	d := &Downloader{
+		link:   link,
+		header: http.Header{},
+		client: DefaultClient,
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
+}
+
+// Reader provides the content of the download link.
+// Responses other than 200 OK are reported as error.
+func (h *Downloader) Reader() (io.ReadCloser, error) {
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, h.link, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	for k, values := range h.header {
+		req.Header[k] = values
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := h.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to get link: %w", err)
+		return nil, fmt.Errorf("failed to get link: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		buf := &bytes.Buffer{}
+		_, err = io.Copy(buf, io.LimitReader(resp.Body, 2000))
+		if err != nil {
+			return nil, errors.Newf("download request %s provides %s", h.link, resp.Status)
+		}
+		return nil, errors.Newf("download request %s provides %s: %s", h.link, resp.Status, buf.String())
+	}
+	return resp.Body, nil
+}
+
+func (h *Downloader) Download(w io.WriterAt) error {
+	r, err := h.Reader()
+	if err != nil {
+		return err
 	}
-	defer resp.Body.Close()
+	defer r.Close()
 
 	var blob []byte
 	buf := bytes.NewBuffer(blob)
-	if _, err := io.Copy(buf, resp.Body); err != nil {
+	if _, err := io.Copy(buf, r); err != nil {
 		return fmt.Errorf("failed to copy response body: %w", err)
 	}
 	if _, err := w.WriteAt(buf.Bytes(), 0); err != nil {
@@ -116,6 +116,45 @@ func (a *FileSystemBlobAccess) GetBlobDataByName(name string) (blobaccess.DataAc
 	}
 }
 
+// ListBlobs returns the digests of all blobs found in the blob directory.
+func (a *FileSystemBlobAccess) ListBlobs() ([]digest.Digest, error) {
+	if a.IsClosed() {
+		return nil, accessio.ErrClosed
+	}
+	fs := a.base.GetFileSystem()
+	dir := a.base.GetInfo().GetElementDirectoryName()
+	if ok, err := vfs.DirExists(fs, dir); !ok || err != nil {
+		return nil, err
+	}
+	entries, err := vfs.ReadDir(fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	var result []digest.Digest
+	for _, e := range entries {
+		if d := common.PathToDigest(e.Name()); d.Validate() == nil {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+// RemoveBlob removes the blob with the given digest from the blob directory.
+func (a *FileSystemBlobAccess) RemoveBlob(digest digest.Digest) error {
+	if a.base.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if a.base.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	path := a.DigestPath(digest)
+	err := a.base.GetFileSystem().Remove(path)
+	if err != nil && !vfs.IsErrNotExist(err) {
+		return fmt.Errorf("unable to remove blob '%s': %w", path, err)
+	}
+	return nil
+}
+
 func (a *FileSystemBlobAccess) AddBlob(blob blobaccess.BlobAccess) error {
 	if a.base.IsClosed() {
 		return accessio.ErrClosed
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"os"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+)
+
+const PROFILES = `
+type: generic.config.ocm.software/v1
+profiles:
+  base:
+    description: base profile
+    values:
+      HOST: base.example.com
+      USER: base
+    configurations:
+      - type: Dummy
+        alice: ${USER}@${HOST}
+  dev:
+    extends:
+      - base
+    valuesFile: /values.yaml
+    values:
+      HOST: dev.example.com
+    configurations:
+      - type: Dummy
+        bob: ${OCM_TEST_PROFILE_BOB:-bob}@${HOST}
+  broken:
+    extends:
+      - base
+    configurations:
+      - type: Dummy
+        bob: ${UNDEFINED}
+  cycle1:
+    extends:
+      - cycle2
+  cycle2:
+    extends:
+      - cycle1
+`
+
+var _ = Describe("config profiles", func() {
+	var scheme config.ConfigTypeScheme
+	var cfgctx config.Context
+	var d *dummyContext
+
+	BeforeEach(func() {
+		scheme = config.NewConfigTypeScheme()
+		scheme.AddKnownTypes(config.DefaultContext().ConfigTypes())
+		RegisterAt(scheme)
+		cfgctx = config.WithConfigTypeScheme(scheme).New()
+		fs := memoryfs.New()
+		MustBeSuccessful(vfs.WriteFile(fs, "/values.yaml", []byte("USER: dev\n"), 0o600))
+		vfsattr.Set(cfgctx, fs)
+		d = newDummy(cfgctx)
+
+		_, err := cfgctx.ApplyData([]byte(PROFILES), nil, "profiles")
+		MustBeSuccessful(err)
+	})
+
+	It("applies a profile", func() {
+		Expect(cfgctx.GetConfigProfileNames()).To(Equal([]string{"base", "broken", "cycle1", "cycle2", "dev"}))
+		MustBeSuccessful(cfgctx.ApplyConfigSet("base"))
+		Expect(d.getApplied()).To(Equal([]*Config{NewConfig("base@base.example.com", "")}))
+	})
+
+	It("applies an extended profile", func() {
+		MustBeSuccessful(cfgctx.ApplyConfigSet("dev"))
+		Expect(d.getApplied()).To(Equal([]*Config{NewConfig("dev@dev.example.com", ""), NewConfig("", "bob@dev.example.com")}))
+		Expect(cfgctx.GetAppliedConfigSets()).To(Equal([]string{"dev"}))
+	})
+
+	It("prefers environment variables", func() {
+		os.Setenv("OCM_TEST_PROFILE_BOB", "env")
+		defer os.Unsetenv("OCM_TEST_PROFILE_BOB")
+		MustBeSuccessful(cfgctx.ApplyConfigSet("dev"))
+		Expect(d.getApplied()).To(Equal([]*Config{NewConfig("dev@dev.example.com", ""), NewConfig("", "env@dev.example.com")}))
+	})
+
+	It("rejects undefined variables", func() {
+		err := cfgctx.ApplyConfigSet("broken")
+		Expect(err).To(MatchError(`config profile "broken": config entry 0: bob: undefined variable(s) UNDEFINED`))
+		Expect(d.getApplied()).To(BeNil())
+	})
+
+	It("rejects cycles", func() {
+		Expect(cfgctx.ApplyConfigSet("cycle1")).To(MatchError(`config profile "cycle1": config profile "cycle2": cyclic extension of config profile "cycle1"`))
+	})
+
+	It("rejects unknown profiles", func() {
+		Expect(errors.IsErrUnknownKind(cfgctx.ApplyConfigSet("unknown"), config.KIND_CONFIGSET)).To(BeTrue())
+	})
+})
@@ -26,7 +26,8 @@ func init() {
 type Config struct {
 	runtime.ObjectVersionedType `json:",inline"`
 	cpi.ConfigurationList       `json:",inline"`
-	Sets                        map[string]cpi.ConfigSet `json:"sets,omitempty"`
+	Sets                        map[string]cpi.ConfigSet     `json:"sets,omitempty"`
+	Profiles                    map[string]cpi.ConfigProfile `json:"profiles,omitempty"`
 }
 
 // New creates a new memory ConfigSpec.
@@ -35,6 +36,7 @@ func New() *Config {
 		ObjectVersionedType: runtime.NewVersionedTypedObject(ConfigType),
 		ConfigurationList:   cpi.ConfigurationList{[]*cpi.GenericConfig{}},
 		Sets:                map[string]cpi.ConfigSet{},
+		Profiles:            map[string]cpi.ConfigProfile{},
 	}
 }
 
@@ -53,6 +55,40 @@ func (c *Config) AddConfigToSet(name string, cfg cpi.Config) error {
 	return err
 }
 
+func (c *Config) AddProfile(name, desc string, extends ...string) {
+	if c.Profiles == nil {
+		c.Profiles = map[string]cpi.ConfigProfile{}
+	}
+	p := c.Profiles[name]
+	p.Description = desc
+	p.Extends = extends
+	c.Profiles[name] = p
+}
+
+func (c *Config) SetProfileValue(name, key, value string) {
+	if c.Profiles == nil {
+		c.Profiles = map[string]cpi.ConfigProfile{}
+	}
+	p := c.Profiles[name]
+	if p.Values == nil {
+		p.Values = map[string]string{}
+	}
+	p.Values[key] = value
+	c.Profiles[name] = p
+}
+
+func (c *Config) AddConfigToProfile(name string, cfg cpi.Config) error {
+	if c.Profiles == nil {
+		c.Profiles = map[string]cpi.ConfigProfile{}
+	}
+	p := c.Profiles[name]
+	err := p.AddConfig(cfg)
+	if err == nil {
+		c.Profiles[name] = p
+	}
+	return err
+}
+
 func (c *Config) GetType() string {
 	return ConfigType
 }
@@ -63,6 +99,10 @@ func (c *Config) ApplyTo(ctx cpi.Context, target interface{}) error {
 			set := s
 			cctx.AddConfigSet(n, &set)
 		}
+		for n, p := range c.Profiles {
+			profile := p
+			cctx.AddConfigProfile(n, &profile)
+		}
 
 		list := errors.ErrListf("applying generic config list")
 		for i, cfg := range c.Configurations {
@@ -76,7 +116,8 @@ func (c *Config) ApplyTo(ctx cpi.Context, target interface{}) error {
 
 const usage = `
 The config type <code>` + ConfigType + `</code> can be used to define a list
-of arbitrary configuration specifications and named configuration sets:
+of arbitrary configuration specifications, named configuration sets
+and named configuration profiles:
 
 <pre>
     type: ` + ConfigType + `
@@ -91,10 +132,38 @@ of arbitrary configuration specifications and named configuration sets:
             - type: ...
               ...
             ...
+    profiles:
+       base:
+          description: common settings for all stages
+          values:
+            REGISTRY: ghcr.io
+          configurations:
+            - type: ...
+              host: ${REGISTRY}
+              ...
+       dev:
+          extends:
+            - base
+          valuesFile: /etc/ocm/dev-values.yaml
+          values:
+            REGISTRY: dev.registry.example.com
 </pre>
 
 Configurations are directly applied. Configuration sets are
 just stored in the configuration context and can be applied
 on-demand. On the CLI, this can be done using the main command option
 <code>--config-set &lt;name></code>.
+
+Configuration profiles are selected the same way as configuration
+sets (if there is no set with the given name). A profile may extend
+other profiles (field <code>extends</code>). Their configurations are
+applied before the ones of the extending profile.
+All string values of profile configurations may refer to variables
+using the syntax <code>${VAR}</code> or <code>${VAR:-default}</code>. Variables
+are resolved from the environment, the values file of the profile
+(field <code>valuesFile</code>, a YAML or JSON document with a map of
+values) and the inline values (field <code>values</code>), in this order
+of precedence. Values of an extending profile override the values of the
+profiles it extends. A reference to an undefined variable without
+default is an error.
 `
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	genericcfg "github.com/open-component-model/ocm/pkg/contexts/config/config"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Issue describes a problem found in a configuration document.
+type Issue struct {
+	// Line and Column describe the position of the element in the
+	// document. They are zero, if the position is unknown.
+	Line   int
+	Column int
+	// Path is the path of the configuration object in the document.
+	Path    string
+	Message string
+}
+
+func (i Issue) String() string {
+	pos := ""
+	if i.Line > 0 {
+		pos = fmt.Sprintf("%d:%d: ", i.Line, i.Column)
+	}
+	if i.Path == "" {
+		return pos + i.Message
+	}
+	return pos + i.Path + ": " + i.Message
+}
+
+// Validate validates a configuration document (YAML or JSON) against the
+// config types known by the given config context. Every configuration
+// object, including nested configurations and configuration sets of
+// generic configurations, is strictly decoded with its config type to
+// detect unknown types, unknown fields and type mismatches.
+// Configuration objects without such issues are applied to the given
+// context to detect semantic errors. Therefore, a dedicated scratch
+// context should be used. Configurations of config profiles may contain
+// unresolved variables, therefore they are neither applied nor checked
+// for type mismatches.
+// An error is returned if the document cannot be parsed at all.
+func Validate(ctx config.ContextProvider, data []byte) ([]Issue, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrapf(err, "invalid config document")
+	}
+	v := &validator{ctx: ctx.ConfigContext()}
+	if len(doc.Content) == 0 {
+		v.add(&doc, "", "empty config document")
+		return v.issues, nil
+	}
+	v.validate(doc.Content[0], "", true)
+	return v.issues, nil
+}
+
+type validator struct {
+	ctx    config.Context
+	issues []Issue
+}
+
+func (v *validator) add(n *yaml.Node, path string, msg string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{
+		Line:    n.Line,
+		Column:  n.Column,
+		Path:    path,
+		Message: fmt.Sprintf(msg, args...),
+	})
+}
+
+func (v *validator) validate(n *yaml.Node, path string, apply bool) {
+	if n.Kind != yaml.MappingNode {
+		v.add(n, path, "config object expected")
+		return
+	}
+	t := value(n, runtime.ATTR_TYPE)
+	if t == nil {
+		v.add(n, path, "config type missing")
+		return
+	}
+	var obj interface{}
+	if err := n.Decode(&obj); err != nil {
+		v.add(n, path, "%s", err.Error())
+		return
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		v.add(n, path, "%s", err.Error())
+		return
+	}
+	cfg, err := v.ctx.GetConfigForData(data, runtime.DefaultJSONEncoding)
+	if err != nil && (apply || !isTypeError(err)) {
+		v.decodeIssue(n, path, err)
+		return
+	}
+	if cfg == nil {
+		// type mismatch caused by unresolved variables
+		cfg, err = v.ctx.ConfigTypes().Decode([]byte(fmt.Sprintf(`{"type":%q}`, t.Value)), runtime.DefaultJSONEncoding)
+		if err != nil {
+			v.add(t, path, "%s", err.Error())
+			return
+		}
+	}
+	if config.IsGeneric(cfg) {
+		v.add(t, path, "unknown config type %q", t.Value)
+		return
+	}
+	if err := strictDecode(cfg, data); err != nil && (apply || !isTypeError(err)) {
+		v.decodeIssue(n, path, err)
+		return
+	}
+
+	if cfg.GetKind() == genericcfg.ConfigType {
+		// nested configurations are validated and applied separately
+		v.validateList(value(n, "configurations"), join(path, "configurations"), apply)
+		if sets := value(n, "sets"); sets != nil && sets.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(sets.Content); i += 2 {
+				v.validateList(value(sets.Content[i+1], "configurations"), join(path, "sets", sets.Content[i].Value, "configurations"), apply)
+			}
+		}
+		if profiles := value(n, "profiles"); profiles != nil && profiles.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(profiles.Content); i += 2 {
+				v.validateList(value(profiles.Content[i+1], "configurations"), join(path, "profiles", profiles.Content[i].Value, "configurations"), false)
+			}
+		}
+		return
+	}
+	if !apply {
+		return
+	}
+	desc := path
+	if desc == "" {
+		desc = "config"
+	}
+	if err := v.ctx.ApplyConfig(cfg, desc); err != nil {
+		// strip the nesting info added by the config context
+		msg := strings.TrimPrefix(err.Error(), desc+": ")
+		msg = strings.TrimPrefix(msg, "config apply errors: ")
+		v.add(n, path, "%s", strings.TrimPrefix(msg, desc+": "))
+	}
+}
+
+func (v *validator) validateList(n *yaml.Node, path string, apply bool) {
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return
+	}
+	for i, e := range n.Content {
+		v.validate(e, fmt.Sprintf("%s[%d]", path, i), apply)
+	}
+}
+
+func isTypeError(err error) bool {
+	var terr *json.UnmarshalTypeError
+	return errors.As(err, &terr)
+}
+
+var unknownField = regexp.MustCompile(`unknown field "([^"]*)"`)
+
+// decodeIssue reports a decode error at the position of the
+// affected field, if it can be determined.
+func (v *validator) decodeIssue(n *yaml.Node, path string, err error) {
+	var terr *json.UnmarshalTypeError
+	if errors.As(err, &terr) && terr.Field != "" {
+		pos := find(n, strings.Split(terr.Field, "."))
+		if pos == nil {
+			pos = n
+		}
+		v.add(pos, path, "field %q: cannot use %s as %s", terr.Field, terr.Value, terr.Type)
+		return
+	}
+	if m := unknownField.FindStringSubmatch(err.Error()); m != nil {
+		pos := findKey(n, m[1])
+		if pos == nil {
+			pos = n
+		}
+		v.add(pos, path, "unknown field %q", m[1])
+		return
+	}
+	v.add(n, path, "%s", err.Error())
+}
+
+// strictDecode decodes the data again into a new object of the type
+// of the given config rejecting unknown fields.
+func strictDecode(cfg config.Config, data []byte) error {
+	t := reflect.TypeOf(cfg)
+	if t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(reflect.New(t.Elem()).Interface())
+}
+
+// value provides the value node of the given key of a mapping node.
+func value(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// find provides the node for a field path. Path elements
+// for lists are either indices or the list elements are
+// searched for the remaining path.
+func find(n *yaml.Node, path []string) *yaml.Node {
+	if len(path) == 0 {
+		return n
+	}
+	switch n.Kind {
+	case yaml.MappingNode:
+		if f := value(n, path[0]); f != nil {
+			return find(f, path[1:])
+		}
+	case yaml.SequenceNode:
+		if i, err := strconv.Atoi(path[0]); err == nil {
+			if i >= 0 && i < len(n.Content) {
+				return find(n.Content[i], path[1:])
+			}
+			return nil
+		}
+		for _, e := range n.Content {
+			if f := find(e, path); f != nil {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// findKey provides the first key node with the given name.
+func findKey(n *yaml.Node, key string) *yaml.Node {
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == key {
+				return n.Content[i]
+			}
+		}
+		for i := 1; i < len(n.Content); i += 2 {
+			if f := findKey(n.Content[i], key); f != nil {
+				return f
+			}
+		}
+	case yaml.SequenceNode:
+		for _, e := range n.Content {
+			if f := findKey(e, key); f != nil {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+func join(path string, elems ...string) string {
+	if path == "" {
+		return strings.Join(elems, ".")
+	}
+	return path + "." + strings.Join(elems, ".")
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/config/configutils"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+)
+
+var _ = Describe("config validation", func() {
+	var ctx ocm.Context
+
+	BeforeEach(func() {
+		ctx = ocm.New(datacontext.MODE_DEFAULTED)
+	})
+
+	issues := func(doc string) []string {
+		var result []string
+		for _, i := range Must(configutils.Validate(ctx, []byte(doc))) {
+			result = append(result, i.String())
+		}
+		return result
+	}
+
+	It("accepts valid config", func() {
+		Expect(issues(`
+type: generic.config.ocm.software/v1
+configurations:
+  - type: attributes.config.ocm.software
+    attributes:
+      ocm.software/compositionmode: true
+sets:
+  dev:
+    description: development
+    configurations:
+      - type: credentials.config.ocm.software
+        consumers:
+          - identity:
+              type: OCIRegistry
+              hostname: ghcr.io
+            credentials:
+              - type: Credentials
+                properties:
+                  username: ocm
+`)).To(BeEmpty())
+	})
+
+	It("reports issues with position", func() {
+		Expect(issues(`
+type: generic.config.ocm.software/v1
+configurations:
+  - type: credentials.config.ocm.software
+    repositories:
+      - repositry:
+          type: DockerConfig
+  - type: attributes.config.ocm.software
+    attributes:
+      ocm.software/unknown: true
+  - type: unknown.config.ocm.software
+  - type: downloader.ocm.config.ocm.software
+    registrations:
+      - name: 5
+  - description: no type
+sets:
+  dev:
+    configurations:
+      - type: attributes.config.ocm.software
+        attribute: {}
+`)).To(Equal([]string{
+			`6:9: configurations[0]: unknown field "repositry"`,
+			`8:5: configurations[1]: applying config: attribute "ocm.software/unknown": attribute "ocm.software/unknown" is unknown`,
+			`11:11: configurations[2]: unknown config type "unknown.config.ocm.software"`,
+			`14:15: configurations[3]: field "registrations.0.name": cannot use number as string`,
+			`15:5: configurations[4]: config type missing`,
+			`20:9: sets.dev.configurations[0]: unknown field "attribute"`,
+		}))
+	})
+
+	It("fails for invalid document", func() {
+		_, err := configutils.Validate(ctx, []byte("type: [a"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("validates profiles without resolving variables", func() {
+		Expect(issues(`
+type: generic.config.ocm.software/v1
+profiles:
+  dev:
+    values:
+      REGISTRY: ghcr.io
+    configurations:
+      - type: downloader.ocm.config.ocm.software
+        registrations:
+          - name: ${NAME}
+            priority: ${PRIO}
+      - type: credentials.config.ocm.software
+        consumer: []
+`)).To(Equal([]string{
+			`13:9: profiles.dev.configurations[1]: unknown field "consumer"`,
+		}))
+	})
+})
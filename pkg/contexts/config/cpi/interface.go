@@ -13,6 +13,11 @@ import (
 
 const KIND_CONFIGTYPE = internal.KIND_CONFIGTYPE
 
+const (
+	KIND_CONFIGSET     = internal.KIND_CONFIGSET
+	KIND_CONFIGPROFILE = internal.KIND_CONFIGPROFILE
+)
+
 const OCM_CONFIG_TYPE_SUFFIX = internal.OCM_CONFIG_TYPE_SUFFIX
 
 const CONTEXT_TYPE = internal.CONTEXT_TYPE
@@ -26,6 +31,7 @@ type (
 	GenericConfig    = internal.GenericConfig
 
 	ConfigSet         = internal.ConfigSet
+	ConfigProfile     = internal.ConfigProfile
 	ConfigurationList = internal.ConfigurationList
 )
 
@@ -14,6 +14,11 @@ import (
 
 const KIND_CONFIGTYPE = internal.KIND_CONFIGTYPE
 
+const (
+	KIND_CONFIGSET     = internal.KIND_CONFIGSET
+	KIND_CONFIGPROFILE = internal.KIND_CONFIGPROFILE
+)
+
 const OCM_CONFIG_TYPE_SUFFIX = internal.OCM_CONFIG_TYPE_SUFFIX
 
 const CONTEXT_TYPE = internal.CONTEXT_TYPE
@@ -31,6 +36,10 @@ type (
 	GenericConfig          = internal.GenericConfig
 	ConfigSelector         = internal.ConfigSelector
 	ConfigSelectorFunction = internal.ConfigSelectorFunction
+	ConfigSet              = internal.ConfigSet
+	ConfigProfile          = internal.ConfigProfile
+	AppliedConfig          = internal.AppliedConfig
+	AppliedConfigs         = internal.AppliedConfigs
 )
 
 func DefaultContext() internal.Context {
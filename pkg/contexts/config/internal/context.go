@@ -63,8 +63,33 @@ type Context interface {
 	GetConfigForName(generation int64, name string) (int64, []Config)
 	GetConfig(generation int64, selector ConfigSelector) (int64, []Config)
 
+	// GetAppliedConfigs provides the applied configuration objects
+	// together with the description of their source.
+	GetAppliedConfigs(generation int64, selector ConfigSelector) (int64, AppliedConfigs)
+
 	AddConfigSet(name string, set *ConfigSet)
+	// ApplyConfigSet applies the config set with the given name.
+	// If there is no such set, a config profile with this name is
+	// resolved and applied.
 	ApplyConfigSet(name string) error
+	// GetConfigSet provides the config set with the given name,
+	// or nil if it is unknown.
+	GetConfigSet(name string) *ConfigSet
+	// GetConfigSetNames provides the sorted names of all known config sets.
+	GetConfigSetNames() []string
+	// GetAppliedConfigSets provides the names of the config sets
+	// applied so far in the order of their application.
+	GetAppliedConfigSets() []string
+
+	AddConfigProfile(name string, profile *ConfigProfile)
+	// GetConfigProfile provides the config profile with the given name,
+	// or nil if it is unknown.
+	GetConfigProfile(name string) *ConfigProfile
+	// GetConfigProfileNames provides the sorted names of all known config profiles.
+	GetConfigProfileNames() []string
+	// ResolveConfigProfile provides the effective config set for a
+	// config profile.
+	ResolveConfigProfile(name string) (*ConfigSet, error)
 
 	// Reset all configs applied so far, subsequent calls to ApplyTo will
 	// ony see configs allpied after the last reset.
@@ -259,18 +284,55 @@ func (c *_context) AddConfigSet(name string, set *ConfigSet) {
 }
 
 func (c *_context) ApplyConfigSet(name string) error {
+	desc := KIND_CONFIGSET + " " + name
 	set := c.configs.GetSet(name)
 	if set == nil {
-		return errors.ErrUnknown(KIND_CONFIGSET, name)
+		if c.configs.GetProfile(name) == nil {
+			return errors.ErrUnknown(KIND_CONFIGSET, name)
+		}
+		var err error
+		set, err = c.ResolveConfigProfile(name)
+		if err != nil {
+			return err
+		}
+		desc = KIND_CONFIGPROFILE + " " + name
 	}
-	desc := "config set " + name
 	list := errors.ErrListf("applying %s", desc)
 	for _, cfg := range set.Configurations {
 		list.Add(c.ApplyConfig(cfg, desc))
 	}
+	c.configs.MarkSetApplied(name)
 	return list.Result()
 }
 
+func (c *_context) GetConfigSet(name string) *ConfigSet {
+	return c.configs.GetSet(name)
+}
+
+func (c *_context) GetConfigSetNames() []string {
+	return c.configs.GetSetNames()
+}
+
+func (c *_context) GetAppliedConfigSets() []string {
+	return c.configs.GetAppliedSets()
+}
+
+func (c *_context) AddConfigProfile(name string, profile *ConfigProfile) {
+	c.configs.AddProfile(name, profile)
+}
+
+func (c *_context) GetConfigProfile(name string) *ConfigProfile {
+	return c.configs.GetProfile(name)
+}
+
+func (c *_context) GetConfigProfileNames() []string {
+	return c.configs.GetProfileNames()
+}
+
+func (c *_context) GetAppliedConfigs(gen int64, selector ConfigSelector) (int64, AppliedConfigs) {
+	return c.configs.GetConfigForSelector(c, c.selector(gen, selector))
+}
+
 func (c *_context) GetConfig(gen int64, selector ConfigSelector) (int64, []Config) {
 	gen, cfgs := c.configs.GetConfigForSelector(c, c.selector(gen, selector))
 	return gen, cfgs.Configs()
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/utils/subst"
+)
+
+const KIND_CONFIGPROFILE = "config profile"
+
+// ConfigProfile is a named configuration set, which may extend
+// other profiles. String values of its configurations may refer to
+// variables (${VAR}), which are resolved when the profile is applied.
+// Variables are taken from the environment, the values file and the
+// inline values of the profile and its base profiles (in this order of
+// precedence, values of an extending profile override the values of
+// its base profiles).
+type ConfigProfile struct {
+	Description       string            `json:"description,omitempty"`
+	Extends           []string          `json:"extends,omitempty"`
+	Values            map[string]string `json:"values,omitempty"`
+	ValuesFile        string            `json:"valuesFile,omitempty"`
+	ConfigurationList `json:",inline"`
+}
+
+// ResolveConfigProfile resolves the inheritance chain of a profile and
+// provides the resulting configuration set with all variables substituted.
+// The configurations of base profiles precede the ones of the extending
+// profile.
+func (c *_context) ResolveConfigProfile(name string) (*ConfigSet, error) {
+	var chain []*ConfigProfile
+	if err := c.linearizeProfile(name, map[string]bool{}, map[string]bool{}, &chain); err != nil {
+		return nil, err
+	}
+
+	mappings := []subst.Mapping{subst.EnvMapping()}
+	for i := len(chain) - 1; i >= 0; i-- {
+		p := chain[i]
+		if p.ValuesFile != "" {
+			values, err := subst.ParseValuesFile(p.ValuesFile, vfsattr.Get(c))
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s %q", KIND_CONFIGPROFILE, name)
+			}
+			mappings = append(mappings, values.Lookup)
+		}
+		mappings = append(mappings, subst.Values(p.Values).Lookup)
+	}
+	mapping := subst.ChainedMapping(mappings...)
+
+	set := &ConfigSet{Description: chain[len(chain)-1].Description}
+	for _, p := range chain {
+		for i, cfg := range p.Configurations {
+			data, err := runtime.DefaultJSONEncoding.Marshal(cfg)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s %q: config entry %d", KIND_CONFIGPROFILE, name, i)
+			}
+			data, err = subst.SubstituteVariables(data, mapping)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s %q: config entry %d", KIND_CONFIGPROFILE, name, i)
+			}
+			g, err := NewGenericConfig(data, runtime.DefaultJSONEncoding)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s %q: config entry %d", KIND_CONFIGPROFILE, name, i)
+			}
+			set.Configurations = append(set.Configurations, g.(*GenericConfig))
+		}
+	}
+	return set, nil
+}
+
+// linearizeProfile provides the profile chain with base profiles
+// first. Every profile is included only once.
+func (c *_context) linearizeProfile(name string, active, done map[string]bool, chain *[]*ConfigProfile) error {
+	if done[name] {
+		return nil
+	}
+	if active[name] {
+		return errors.Newf("cyclic extension of %s %q", KIND_CONFIGPROFILE, name)
+	}
+	p := c.configs.GetProfile(name)
+	if p == nil {
+		return errors.ErrUnknown(KIND_CONFIGPROFILE, name)
+	}
+	active[name] = true
+	for _, b := range p.Extends {
+		if err := c.linearizeProfile(b, active, done, chain); err != nil {
+			return errors.Wrapf(err, "%s %q", KIND_CONFIGPROFILE, name)
+		}
+	}
+	delete(active, name)
+	done[name] = true
+	*chain = append(*chain, p)
+	return nil
+}
@@ -8,6 +8,10 @@ import (
 	"sort"
 	"strings"
 	"sync"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/open-component-model/ocm/pkg/utils"
 )
 
 type AppliedConfigSelector interface {
@@ -72,6 +76,21 @@ type AppliedConfig struct {
 	description string
 }
 
+// Generation returns the generation the config has been applied with.
+func (c *AppliedConfig) Generation() int64 {
+	return c.generation
+}
+
+// Config returns the applied config object.
+func (c *AppliedConfig) Config() Config {
+	return c.config
+}
+
+// Description returns the description of the source of the config.
+func (c *AppliedConfig) Description() string {
+	return c.description
+}
+
 func (c *AppliedConfig) eval(ctx Context) Config {
 	if e, ok := c.config.(Evaluator); ok {
 		n, err := e.Evaluate(ctx)
@@ -88,13 +107,16 @@ type ConfigStore struct {
 	types      map[string]AppliedConfigs
 	configs    AppliedConfigs
 
-	sets map[string]*ConfigSet
+	sets     map[string]*ConfigSet
+	profiles map[string]*ConfigProfile
+	applied  []string
 }
 
 func NewConfigStore() *ConfigStore {
 	return &ConfigStore{
-		types: map[string]AppliedConfigs{},
-		sets:  map[string]*ConfigSet{},
+		types:    map[string]AppliedConfigs{},
+		sets:     map[string]*ConfigSet{},
+		profiles: map[string]*ConfigProfile{},
 	}
 }
 
@@ -179,3 +201,39 @@ func (c *ConfigStore) GetSet(name string) *ConfigSet {
 	defer c.lock.Unlock()
 	return c.sets[name]
 }
+
+func (c *ConfigStore) GetSetNames() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return utils.StringMapKeys(c.sets)
+}
+
+func (c *ConfigStore) AddProfile(name string, profile *ConfigProfile) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.profiles[name] = profile
+}
+
+func (c *ConfigStore) GetProfile(name string) *ConfigProfile {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.profiles[name]
+}
+
+func (c *ConfigStore) GetProfileNames() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return utils.StringMapKeys(c.profiles)
+}
+
+func (c *ConfigStore) MarkSetApplied(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.applied = append(c.applied, name)
+}
+
+func (c *ConfigStore) GetAppliedSets() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return slices.Clone(c.applied)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"net/url"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/listformat"
+)
+
+// CONSUMER_TYPE is the Git repository type.
+const CONSUMER_TYPE = "Git"
+
+// identity properties
+const (
+	ID_TYPE       = cpi.ID_TYPE
+	ID_SCHEME     = hostpath.ID_SCHEME
+	ID_HOSTNAME   = hostpath.ID_HOSTNAME
+	ID_PORT       = hostpath.ID_PORT
+	ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+)
+
+// credential properties
+const (
+	ATTR_USERNAME = cpi.ATTR_USERNAME
+	ATTR_PASSWORD = cpi.ATTR_PASSWORD
+	ATTR_TOKEN    = cpi.ATTR_TOKEN
+)
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+func init() {
+	attrs := listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		ATTR_USERNAME, "the basic auth user name",
+		ATTR_PASSWORD, "the basic auth password",
+		ATTR_TOKEN, "HTTP access token (used as basic auth password)",
+	})
+	cpi.RegisterStandardIdentity(CONSUMER_TYPE, identityMatcher,
+		`Git repository credential matcher
+
+This matcher is a hostpath matcher.`,
+		attrs)
+}
+
+// GetConsumerId provides the consumer identity for a Git repository URL.
+// Local repositories (file URLs) do not require credentials,
+// therefore no identity is provided for them.
+func GetConsumerId(repourl string) cpi.ConsumerIdentity {
+	u, err := url.Parse(repourl)
+	if err != nil || u.Scheme == "file" || u.Host == "" {
+		return nil
+	}
+	return hostpath.GetConsumerIdentity(CONSUMER_TYPE, repourl)
+}
+
+func GetCredentials(ctx cpi.ContextProvider, repourl string) (common.Properties, error) {
+	id := GetConsumerId(repourl)
+	if id == nil {
+		return nil, nil
+	}
+	creds, err := cpi.CredentialsForConsumer(ctx.CredentialsContext(), id, identityMatcher)
+	if creds == nil || err != nil {
+		return nil, err
+	}
+	return creds.Properties(), nil
+}
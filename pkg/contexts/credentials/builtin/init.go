@@ -4,4 +4,7 @@
 
 package builtin
 
-import _ "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/github"
+import (
+	_ "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/git/identity"
+	_ "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/github"
+)
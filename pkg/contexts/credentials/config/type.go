@@ -6,10 +6,13 @@ package config
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	cfgcpi "github.com/open-component-model/ocm/pkg/contexts/config/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
 	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/listformat"
 	"github.com/open-component-model/ocm/pkg/runtime"
 )
 
@@ -19,8 +22,27 @@ const (
 )
 
 func init() {
-	cfgcpi.RegisterConfigType(cfgcpi.NewConfigType[*Config](ConfigType, usage))
-	cfgcpi.RegisterConfigType(cfgcpi.NewConfigType[*Config](ConfigTypeV1, usage))
+	cfgcpi.RegisterConfigType(configType{cfgcpi.NewConfigType[*Config](ConfigType)})
+	cfgcpi.RegisterConfigType(configType{cfgcpi.NewConfigType[*Config](ConfigTypeV1)})
+}
+
+// configType provides the usage based on the credential repository
+// types registered when it is requested.
+type configType struct {
+	cfgcpi.ConfigType
+}
+
+func (configType) Usage() string {
+	var types []string
+	for _, t := range cpi.DefaultContext.RepositoryTypes().KnownTypeNames() {
+		if !strings.Contains(t, runtime.VersionSeparator) {
+			types = append(types, t)
+		}
+	}
+	sort.Strings(types)
+	return usage + `
+The following repository types are supported:
+` + listformat.FormatList("", types...)
 }
 
 // Config describes a configuration for the config context.
@@ -183,4 +205,30 @@ of arbitrary configuration specifications:
           - &lt;credential specification>
           ... credential chain
 </pre>
+
+Credentials can be taken from the process environment with a repository
+of type <code>Environment</code>. Variables of the form
+<code>OCM_CRED_&lt;HOST>_&lt;PROPERTY></code> (for example
+<code>OCM_CRED_GHCR_IO_USERNAME</code>) provide credential properties
+for host based consumer identities. Domain components are separated by
+<code>_</code>, <code>__</code> denotes a <code>-</code> and a trailing
+numeric component is used as port. The prefix can be changed with the field
+<code>prefix</code>. A repository of type <code>NetRC</code> provides the
+machine entries of a netrc file (field <code>path</code>, by default
+<code>$NETRC</code> or <code>~/.netrc</code>). With
+<code>propagateConsumerIdentity: true</code> the credentials are provided for
+all consumer types, the field <code>consumerType</code> restricts them to a
+dedicated consumer type.
+
+<pre>
+    type: ` + ConfigType + `
+    repositories:
+       - repository:
+           type: Environment
+           propagateConsumerIdentity: true
+       - repository:
+           type: NetRC
+           consumerType: OCIRegistry
+           propagateConsumerIdentity: true
+</pre>
 `
@@ -22,3 +22,5 @@ const (
 	ATTR_REGISTRY_TOKEN        = internal.ATTR_REGISTRY_TOKEN
 	ATTR_TOKEN                 = internal.ATTR_TOKEN
 )
+
+const EXPIRATION_MARGIN = internal.EXPIRATION_MARGIN
@@ -20,4 +20,8 @@ const (
 	ATTR_REGISTRY_TOKEN        = internal.ATTR_REGISTRY_TOKEN
 	ATTR_KEY                   = internal.ATTR_KEY
 	ATTR_CERTIFICATE_AUTHORITY = internal.ATTR_CERTIFICATE_AUTHORITY
+	ATTR_CERTIFICATE           = internal.ATTR_CERTIFICATE
+	ATTR_PRIVATE_KEY           = internal.ATTR_PRIVATE_KEY
 )
+
+const EXPIRATION_MARGIN = internal.EXPIRATION_MARGIN
@@ -43,6 +43,15 @@
 // settings therefore match all OCI credential requests for all repository paths
 // of a dedicated host, as long as there is no more significant setting.
 //
+// Credential sources may issue credentials with a limited lifetime (for
+// example short-lived tokens). Such credentials implement the interface
+// ExpiringCredentials. A source able to re-acquire expired credentials
+// implements RefreshableCredentialsSource. CredentialsForConsumer
+// automatically refreshes expired credentials, and long-running consumers
+// should check their credentials with IsExpired before using them and
+// request them again, if required. CachedCredentialsSource can be used by
+// credential providers to cache acquired credentials until they expire.
+//
 // The credentials context also provides a configuration objeect managed by
 // a ConfigurationContext and used to configure a credentials context. The
 // serialization form of this object can be put into a configuration object of
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hostpath
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+// CredentialsFunc provides credentials by locators of the form
+// <host>[:<port>][/<path prefix>].
+type CredentialsFunc func() map[string]cpi.Credentials
+
+// ConsumerProvider provides credentials given by locators for
+// host and path based consumer identities.
+// If no consumer type is given, the credentials are provided for any
+// requested consumer type.
+type ConsumerProvider struct {
+	consumerType string
+	credentials  CredentialsFunc
+}
+
+var _ cpi.ConsumerProvider = (*ConsumerProvider)(nil)
+
+func NewConsumerProvider(consumerType string, creds CredentialsFunc) *ConsumerProvider {
+	return &ConsumerProvider{
+		consumerType: consumerType,
+		credentials:  creds,
+	}
+}
+
+func (p *ConsumerProvider) Unregister(id cpi.ProviderIdentity) {
+}
+
+func (p *ConsumerProvider) Match(req cpi.ConsumerIdentity, cur cpi.ConsumerIdentity, m cpi.IdentityMatcher) (cpi.CredentialsSource, cpi.ConsumerIdentity) {
+	return p.get(req, cur, m)
+}
+
+func (p *ConsumerProvider) Get(req cpi.ConsumerIdentity) (cpi.CredentialsSource, bool) {
+	creds, _ := p.get(req, nil, cpi.CompleteMatch)
+	return creds, creds != nil
+}
+
+func (p *ConsumerProvider) get(req cpi.ConsumerIdentity, cur cpi.ConsumerIdentity, m cpi.IdentityMatcher) (cpi.CredentialsSource, cpi.ConsumerIdentity) {
+	var found cpi.CredentialsSource
+
+	typ := p.consumerType
+	if typ == "" {
+		typ = req[ID_TYPE]
+	}
+	for locator, creds := range p.credentials() {
+		host, port, path := utils.SplitLocator(locator)
+		id := cpi.ConsumerIdentity{}
+		id.SetNonEmptyValue(ID_TYPE, typ)
+		id.SetNonEmptyValue(ID_HOSTNAME, host)
+		id.SetNonEmptyValue(ID_PORT, port)
+		id.SetNonEmptyValue(ID_PATHPREFIX, path)
+		if m(req, cur, id) {
+			found = creds
+			cur = id
+		}
+	}
+	return found, cur
+}
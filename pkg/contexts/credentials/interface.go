@@ -6,6 +6,7 @@ package credentials
 
 import (
 	"context"
+	"time"
 
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials/internal"
@@ -52,6 +53,13 @@ type (
 	DirectCredentials      = internal.DirectCredentials
 )
 
+type (
+	ExpiringCredentials          = internal.ExpiringCredentials
+	RefreshableCredentialsSource = internal.RefreshableCredentialsSource
+	CachedCredentialsSource      = internal.CachedCredentialsSource
+	CredentialsFunc              = internal.CredentialsFunc
+)
+
 func DefaultContext() internal.Context {
 	return internal.DefaultContext
 }
@@ -84,6 +92,36 @@ func NewCredentials(props common.Properties) Credentials {
 	return internal.NewCredentials(props)
 }
 
+// NewExpiringCredentials provides credentials with a limited lifetime.
+func NewExpiringCredentials(props common.Properties, expiration time.Time) ExpiringCredentials {
+	return internal.NewExpiringCredentials(props, expiration)
+}
+
+// NewCachedCredentialsSource provides a refreshable credentials source
+// caching the credentials provided by the given function. They are
+// re-acquired if they expire within the given margin.
+func NewCachedCredentialsSource(f CredentialsFunc, margin time.Duration) *CachedCredentialsSource {
+	return internal.NewCachedCredentialsSource(f, margin)
+}
+
+// RefreshCredentials requests fresh credentials from the given source.
+// For sources not supporting a refresh, the regular credentials are provided.
+func RefreshCredentials(ctx Context, src CredentialsSource, creds ...CredentialsSource) (Credentials, error) {
+	return internal.RefreshCredentials(ctx, src, creds...)
+}
+
+// GetExpiration returns the expiration time of the given credentials.
+// The zero time is returned for credentials without limited lifetime.
+func GetExpiration(creds Credentials) time.Time {
+	return internal.GetExpiration(creds)
+}
+
+// IsExpired checks whether the given credentials expire within the
+// given margin.
+func IsExpired(creds Credentials, margin time.Duration) bool {
+	return internal.IsExpired(creds, margin)
+}
+
 func ToGenericCredentialsSpec(spec CredentialsSpec) (*GenericCredentialsSpec, error) {
 	return internal.ToGenericCredentialsSpec(spec)
 }
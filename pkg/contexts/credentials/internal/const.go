@@ -4,6 +4,10 @@
 
 package internal
 
+import (
+	"time"
+)
+
 const (
 	ID_TYPE = "type"
 
@@ -19,3 +23,7 @@ const (
 	ATTR_TOKEN                 = "token"
 	ATTR_KEY                   = "key"
 )
+
+// EXPIRATION_MARGIN is the default lead time used to re-acquire
+// expiring credentials before they become invalid.
+const EXPIRATION_MARGIN = 30 * time.Second
@@ -5,6 +5,9 @@
 package internal
 
 import (
+	"sync"
+	"time"
+
 	"github.com/modern-go/reflect2"
 
 	"github.com/open-component-model/ocm/pkg/generics"
@@ -19,7 +22,7 @@ type CredentialsSource interface {
 // credential i+1 (is present) is used to resolve credential i.
 type CredentialsChain []CredentialsSource
 
-var _ CredentialsSource = CredentialsChain{}
+var _ RefreshableCredentialsSource = CredentialsChain{}
 
 func (c CredentialsChain) Credentials(ctx Context, creds ...CredentialsSource) (Credentials, error) {
 	if len(c) == 0 || reflect2.IsNil(c[0]) {
@@ -31,3 +34,91 @@ func (c CredentialsChain) Credentials(ctx Context, creds ...CredentialsSource) (
 	}
 	return c[0].Credentials(ctx, generics.AppendedSlice(c[1:], creds...)...)
 }
+
+func (c CredentialsChain) Refresh(ctx Context, creds ...CredentialsSource) (Credentials, error) {
+	if len(c) == 0 || reflect2.IsNil(c[0]) {
+		return nil, nil
+	}
+
+	if len(creds) == 0 {
+		return RefreshCredentials(ctx, c[0], c[1:]...)
+	}
+	return RefreshCredentials(ctx, c[0], generics.AppendedSlice(c[1:], creds...)...)
+}
+
+// ExpiringCredentials are credentials with a limited lifetime,
+// for example short-lived tokens issued by a token endpoint.
+type ExpiringCredentials interface {
+	Credentials
+	// Expiration returns the point in time the credentials
+	// expire. The zero time means unlimited.
+	Expiration() time.Time
+}
+
+// RefreshableCredentialsSource is a CredentialsSource
+// able to re-acquire credentials, if formerly provided credentials
+// are expired. Credentials always provides valid (but potentially cached)
+// credentials, while Refresh enforces the re-acquisition from the
+// originating source.
+type RefreshableCredentialsSource interface {
+	CredentialsSource
+	Refresh(Context, ...CredentialsSource) (Credentials, error)
+}
+
+// RefreshCredentials requests fresh credentials from the given source.
+// For sources not supporting a refresh, the regular credentials are provided.
+func RefreshCredentials(ctx Context, src CredentialsSource, creds ...CredentialsSource) (Credentials, error) {
+	if r, ok := src.(RefreshableCredentialsSource); ok {
+		return r.Refresh(ctx, creds...)
+	}
+	return src.Credentials(ctx, creds...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// CredentialsFunc acquires fresh credentials.
+type CredentialsFunc func(Context, ...CredentialsSource) (Credentials, error)
+
+// CachedCredentialsSource caches credentials provided by a CredentialsFunc
+// until they expire.
+type CachedCredentialsSource struct {
+	lock   sync.Mutex
+	get    CredentialsFunc
+	margin time.Duration
+	creds  Credentials
+}
+
+var _ RefreshableCredentialsSource = (*CachedCredentialsSource)(nil)
+
+// NewCachedCredentialsSource provides a refreshable credentials source
+// caching the credentials provided by the given function. They are
+// re-acquired if they expire within the given margin.
+func NewCachedCredentialsSource(f CredentialsFunc, margin time.Duration) *CachedCredentialsSource {
+	return &CachedCredentialsSource{get: f, margin: margin}
+}
+
+func (c *CachedCredentialsSource) Credentials(ctx Context, creds ...CredentialsSource) (Credentials, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.creds != nil && !IsExpired(c.creds, c.margin) {
+		return c.creds, nil
+	}
+	return c.refresh(ctx, creds...)
+}
+
+func (c *CachedCredentialsSource) Refresh(ctx Context, creds ...CredentialsSource) (Credentials, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.refresh(ctx, creds...)
+}
+
+func (c *CachedCredentialsSource) refresh(ctx Context, creds ...CredentialsSource) (Credentials, error) {
+	n, err := c.get(ctx, creds...)
+	if err != nil {
+		return nil, err
+	}
+	c.creds = n
+	return n, nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internal_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+)
+
+var _ = Describe("expiring credentials", func() {
+	var ctx credentials.Context
+	var count int
+	var lifetime time.Duration
+
+	id := credentials.NewConsumerIdentity("test", "hostname", "ghcr.io")
+
+	token := func(credentials.Context, ...credentials.CredentialsSource) (credentials.Credentials, error) {
+		count++
+		return credentials.NewExpiringCredentials(common.Properties{
+			credentials.ATTR_TOKEN: fmt.Sprintf("token%d", count),
+		}, time.Now().Add(lifetime)), nil
+	}
+
+	BeforeEach(func() {
+		ctx = credentials.New()
+		count = 0
+		lifetime = time.Hour
+	})
+
+	It("provides expiration", func() {
+		exp := time.Now().Add(time.Minute)
+		creds := credentials.NewExpiringCredentials(common.Properties{credentials.ATTR_TOKEN: "token"}, exp)
+		Expect(credentials.GetExpiration(creds)).To(Equal(exp))
+		Expect(credentials.IsExpired(creds, 0)).To(BeFalse())
+		Expect(credentials.IsExpired(creds, 2*time.Minute)).To(BeTrue())
+
+		Expect(credentials.GetExpiration(credentials.NewCredentials(nil))).To(BeZero())
+		Expect(credentials.IsExpired(credentials.NewCredentials(nil), time.Hour)).To(BeFalse())
+	})
+
+	It("caches credentials until expiration", func() {
+		src := credentials.NewCachedCredentialsSource(token, time.Minute)
+
+		Expect(Must(src.Credentials(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token1"))
+		Expect(Must(src.Credentials(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token1"))
+
+		Expect(Must(src.Refresh(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token2"))
+		Expect(Must(src.Credentials(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token2"))
+	})
+
+	It("re-acquires credentials expiring within margin", func() {
+		lifetime = 30 * time.Second
+		src := credentials.NewCachedCredentialsSource(token, time.Minute)
+
+		Expect(Must(src.Credentials(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token1"))
+		Expect(Must(src.Credentials(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token2"))
+	})
+
+	It("refreshes expired consumer credentials", func() {
+		ctx.SetCredentialsForConsumer(id, &expired{})
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, id))
+		Expect(creds.GetProperty(credentials.ATTR_TOKEN)).To(Equal("refreshed"))
+	})
+
+	It("refreshes chains", func() {
+		src := credentials.CredentialsChain{credentials.NewCachedCredentialsSource(token, 0)}
+		Expect(Must(src.Credentials(ctx)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token1"))
+		Expect(Must(credentials.RefreshCredentials(ctx, src)).GetProperty(credentials.ATTR_TOKEN)).To(Equal("token2"))
+	})
+})
+
+// expired always provides expired credentials, but fresh ones on refresh.
+type expired struct{}
+
+var _ credentials.RefreshableCredentialsSource = (*expired)(nil)
+
+func (e *expired) Credentials(credentials.Context, ...credentials.CredentialsSource) (credentials.Credentials, error) {
+	return credentials.NewExpiringCredentials(common.Properties{
+		credentials.ATTR_TOKEN: "expired",
+	}, time.Now().Add(-time.Minute)), nil
+}
+
+func (e *expired) Refresh(credentials.Context, ...credentials.CredentialsSource) (credentials.Credentials, error) {
+	return credentials.NewCredentials(common.Properties{
+		credentials.ATTR_TOKEN: "refreshed",
+	}), nil
+}
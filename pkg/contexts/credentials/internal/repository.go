@@ -5,6 +5,9 @@
 package internal
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/generics"
 )
@@ -64,3 +67,35 @@ func (c DirectCredentials) Copy() DirectCredentials {
 func (c DirectCredentials) String() string {
 	return common.Properties(c).String()
 }
+
+////////////////////////////////////////////////////////////////////////////////
+
+type expiringCredentials struct {
+	DirectCredentials
+	expiration time.Time
+}
+
+var _ ExpiringCredentials = (*expiringCredentials)(nil)
+
+// NewExpiringCredentials provides credentials expiring at the given time.
+func NewExpiringCredentials(props common.Properties, expiration time.Time) ExpiringCredentials {
+	return &expiringCredentials{
+		DirectCredentials: NewCredentials(props),
+		expiration:        expiration,
+	}
+}
+
+func (c *expiringCredentials) Credentials(Context, ...CredentialsSource) (Credentials, error) {
+	return c, nil
+}
+
+func (c *expiringCredentials) Expiration() time.Time {
+	return c.expiration
+}
+
+func (c *expiringCredentials) String() string {
+	if c.expiration.IsZero() {
+		return c.DirectCredentials.String()
+	}
+	return fmt.Sprintf("%s (expires %s)", c.DirectCredentials.String(), c.expiration.Format(time.RFC3339))
+}
@@ -5,6 +5,8 @@
 package internal
 
 import (
+	"time"
+
 	"github.com/open-component-model/ocm/pkg/errors"
 )
 
@@ -22,8 +24,27 @@ func CredentialsForConsumer(ctx ContextProvider, id ConsumerIdentity, unknownAsE
 		return nil, nil
 	}
 	creds, err := src.Credentials(cctx)
+	if err == nil && IsExpired(creds, 0) {
+		creds, err = RefreshCredentials(cctx, src)
+	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "lookup credentials failed for %s", id)
 	}
 	return creds, nil
 }
+
+// GetExpiration returns the expiration time of the given credentials.
+// The zero time is returned for credentials without limited lifetime.
+func GetExpiration(creds Credentials) time.Time {
+	if e, ok := creds.(ExpiringCredentials); ok {
+		return e.Expiration()
+	}
+	return time.Time{}
+}
+
+// IsExpired checks whether the given credentials expire within the
+// given margin.
+func IsExpired(creds Credentials, margin time.Duration) bool {
+	exp := GetExpiration(creds)
+	return !exp.IsZero() && !time.Now().Add(margin).Before(exp)
+}
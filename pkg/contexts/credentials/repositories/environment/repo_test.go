@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package environment_test
+
+import (
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/config"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/environment"
+	ociidentity "github.com/open-component-model/ocm/pkg/contexts/oci/identity"
+)
+
+var _ = Describe("environment credential repository", func() {
+	var ctx credentials.Context
+
+	vars := map[string]string{
+		"OCM_CRED_GHCR_IO_USERNAME":              "ocm",
+		"OCM_CRED_GHCR_IO_PASSWORD":              "secret",
+		"OCM_CRED_MY__REGISTRY_ACME_ORG_TOKEN":   "token",
+		"OCM_CRED_LOCALHOST_5000_IDENTITY_TOKEN": "identity",
+		"OCM_CRED_INVALID":                       "invalid",
+	}
+
+	BeforeEach(func() {
+		ctx = credentials.New()
+		for k, v := range vars {
+			os.Setenv(k, v)
+		}
+	})
+
+	AfterEach(func() {
+		for k := range vars {
+			os.Unsetenv(k)
+		}
+	})
+
+	DescribeTable("parses variable names", func(name, host, prop string) {
+		h, p := environment.ParseVariableName(name)
+		Expect(h).To(Equal(host))
+		Expect(p).To(Equal(prop))
+	},
+		Entry("host", "GHCR_IO_USERNAME", "ghcr.io", cpi.ATTR_USERNAME),
+		Entry("dash", "MY__REGISTRY_ACME_ORG_TOKEN", "my-registry.acme.org", cpi.ATTR_TOKEN),
+		Entry("port", "LOCALHOST_5000_PASSWORD", "localhost:5000", cpi.ATTR_PASSWORD),
+		Entry("longest suffix", "GHCR_IO_IDENTITY_TOKEN", "ghcr.io", cpi.ATTR_IDENTITY_TOKEN),
+		Entry("unknown property", "GHCR_IO_OTHER", "", ""),
+		Entry("empty component", "GHCR__IO_USERNAME", "ghcr-io", cpi.ATTR_USERNAME),
+		Entry("missing host", "_USERNAME", "", ""),
+	)
+
+	It("deserializes repo spec", func() {
+		data := Must(json.Marshal(environment.NewRepositorySpec("", true)))
+		Expect(string(data)).To(Equal(`{"type":"Environment","propagateConsumerIdentity":true}`))
+		spec := Must(ctx.RepositorySpecForConfig(data, nil))
+		Expect(spec).To(Equal(environment.NewRepositorySpec("", true)))
+	})
+
+	It("looks up credentials by host", func() {
+		repo := Must(ctx.RepositoryForSpec(environment.NewRepositorySpec("", false)))
+
+		creds := Must(repo.LookupCredentials("ghcr.io"))
+		Expect(creds.Properties()).To(Equal(cpi.DirectCredentials{
+			cpi.ATTR_USERNAME: "ocm",
+			cpi.ATTR_PASSWORD: "secret",
+		}.Properties()))
+		creds = Must(repo.LookupCredentials("localhost:5000"))
+		Expect(creds.GetProperty(cpi.ATTR_IDENTITY_TOKEN)).To(Equal("identity"))
+		Expect(repo.ExistsCredentials("my-registry.acme.org")).To(BeTrue())
+		Expect(repo.ExistsCredentials("gcr.io")).To(BeFalse())
+	})
+
+	It("considers changed environment", func() {
+		repo := Must(ctx.RepositoryForSpec(environment.NewRepositorySpec("", false)))
+		os.Setenv("OCM_CRED_GHCR_IO_PASSWORD", "changed")
+		creds := Must(repo.LookupCredentials("ghcr.io"))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("changed"))
+	})
+
+	It("uses prefix", func() {
+		os.Setenv("CI_CRED_GCR_IO_TOKEN", "ci")
+		defer os.Unsetenv("CI_CRED_GCR_IO_TOKEN")
+
+		repo := Must(ctx.RepositoryForSpec(environment.NewRepositorySpec("CI_CRED_", false)))
+		Expect(repo.ExistsCredentials("ghcr.io")).To(BeFalse())
+		Expect(Must(repo.LookupCredentials("gcr.io")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("ci"))
+	})
+
+	It("propagates credentials configured by config", func() {
+		cfg := config.New()
+		MustBeSuccessful(cfg.AddRepository(environment.NewRepositorySpec("", true)))
+		MustBeSuccessful(ctx.ConfigContext().ApplyConfig(cfg, "test"))
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, credentials.NewConsumerIdentity(ociidentity.CONSUMER_TYPE,
+			ociidentity.ID_HOSTNAME, "ghcr.io",
+			ociidentity.ID_PATHPREFIX, "open-component-model",
+		), ociidentity.IdentityMatcher))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("secret"))
+
+		creds = Must(credentials.CredentialsForConsumer(ctx, credentials.NewConsumerIdentity(ociidentity.CONSUMER_TYPE,
+			ociidentity.ID_HOSTNAME, "localhost",
+			ociidentity.ID_PORT, "5000",
+		), ociidentity.IdentityMatcher))
+		Expect(creds.GetProperty(cpi.ATTR_IDENTITY_TOKEN)).To(Equal("identity"))
+
+		creds = Must(credentials.CredentialsForConsumer(ctx, credentials.NewConsumerIdentity(ociidentity.CONSUMER_TYPE,
+			ociidentity.ID_HOSTNAME, "gcr.io",
+		), ociidentity.IdentityMatcher))
+		Expect(creds).To(BeNil())
+	})
+
+	It("restricts propagation to consumer type", func() {
+		spec := environment.NewRepositorySpec("", true)
+		spec.ConsumerType = "Other"
+		Must(ctx.RepositoryForSpec(spec))
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, credentials.NewConsumerIdentity(ociidentity.CONSUMER_TYPE,
+			ociidentity.ID_HOSTNAME, "ghcr.io",
+		), ociidentity.IdentityMatcher))
+		Expect(creds).To(BeNil())
+	})
+})
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+const PROVIDER = "ocm.software/credentialprovider/" + Type
+
+// properties maps the variable suffixes to credential properties.
+var properties = map[string]string{
+	"USERNAME":              cpi.ATTR_USERNAME,
+	"PASSWORD":              cpi.ATTR_PASSWORD,
+	"TOKEN":                 cpi.ATTR_TOKEN,
+	"IDENTITY_TOKEN":        cpi.ATTR_IDENTITY_TOKEN,
+	"REGISTRY_TOKEN":        cpi.ATTR_REGISTRY_TOKEN,
+	"SERVER_ADDRESS":        cpi.ATTR_SERVER_ADDRESS,
+	"CERTIFICATE_AUTHORITY": cpi.ATTR_CERTIFICATE_AUTHORITY,
+	"CERTIFICATE":           cpi.ATTR_CERTIFICATE,
+	"PRIVATE_KEY":           cpi.ATTR_PRIVATE_KEY,
+	"KEY":                   cpi.ATTR_KEY,
+}
+
+// suffixes are the variable suffixes, longest first.
+var suffixes = func() []string {
+	var list []string
+	for k := range properties {
+		list = append(list, k)
+	}
+	sort.Slice(list, func(i, j int) bool { return len(list[i]) > len(list[j]) })
+	return list
+}()
+
+var port = regexp.MustCompile("^[0-9]+$")
+
+// Repository provides credentials given by environment variables.
+// The variables are evaluated for every request, this way
+// changes of the environment are considered.
+type Repository struct {
+	prefix string
+}
+
+func NewRepository(ctx cpi.Context, prefix, consumerType string, propagate bool) *Repository {
+	r := &Repository{prefix: prefix}
+	if propagate {
+		ctx.RegisterConsumerProvider(cpi.ProviderIdentity(PROVIDER+"/"+prefix), hostpath.NewConsumerProvider(consumerType, r.credentials))
+	}
+	return r
+}
+
+var _ cpi.Repository = &Repository{}
+
+func (r *Repository) ExistsCredentials(name string) (bool, error) {
+	return r.credentials()[name] != nil, nil
+}
+
+func (r *Repository) LookupCredentials(name string) (cpi.Credentials, error) {
+	c := r.credentials()[name]
+	if c == nil {
+		return nil, cpi.ErrUnknownCredentials(name)
+	}
+	return c, nil
+}
+
+func (r *Repository) WriteCredentials(name string, creds cpi.Credentials) (cpi.Credentials, error) {
+	return nil, errors.ErrNotSupported("write", "credentials", Type)
+}
+
+// credentials provides the credentials found in the environment
+// by host locators.
+func (r *Repository) credentials() map[string]cpi.Credentials {
+	props := map[string]common.Properties{}
+	for _, e := range os.Environ() {
+		i := strings.Index(e, "=")
+		if i < 0 || !strings.HasPrefix(e[:i], r.prefix) {
+			continue
+		}
+		host, prop := ParseVariableName(e[len(r.prefix):i])
+		if host == "" {
+			continue
+		}
+		if props[host] == nil {
+			props[host] = common.Properties{}
+		}
+		props[host][prop] = e[i+1:]
+	}
+	creds := map[string]cpi.Credentials{}
+	for h, p := range props {
+		creds[h] = cpi.NewCredentials(p)
+	}
+	return creds
+}
+
+// ParseVariableName parses a variable name (without prefix) of the form
+// <host>_<property>. The host name is given in upper case with _
+// separating the domain components and __ for a dash.
+// A trailing numeric component is used as port.
+// It returns the host locator and the credential property.
+// If the name cannot be parsed, an empty host is returned.
+func ParseVariableName(name string) (string, string) {
+	for _, s := range suffixes {
+		if !strings.HasSuffix(name, "_"+s) {
+			continue
+		}
+		host := strings.ToLower(strings.ReplaceAll(name[:len(name)-len(s)-1], "__", "-"))
+		comps := strings.Split(host, "_")
+		for _, c := range comps {
+			if c == "" {
+				return "", ""
+			}
+		}
+		if len(comps) > 1 && port.MatchString(comps[len(comps)-1]) {
+			return strings.Join(comps[:len(comps)-1], ".") + ":" + comps[len(comps)-1], properties[s]
+		}
+		return strings.Join(comps, "."), properties[s]
+	}
+	return "", ""
+}
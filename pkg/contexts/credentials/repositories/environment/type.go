@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package environment
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	Type   = "Environment"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+// DEFAULT_PREFIX is the default prefix of environment variables
+// providing credentials.
+const DEFAULT_PREFIX = "OCM_CRED_"
+
+func init() {
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](Type))
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](TypeV1))
+}
+
+// RepositorySpec describes an environment variable based credential repository interface.
+// Variables of the form <prefix><host>_<property> provide credential
+// properties for a host.
+type RepositorySpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	// Prefix is the prefix of the environment variables,
+	// by default DEFAULT_PREFIX is used.
+	Prefix string `json:"prefix,omitempty"`
+	// ConsumerType restricts the propagation to a consumer type.
+	ConsumerType              string `json:"consumerType,omitempty"`
+	PropagateConsumerIdentity bool   `json:"propagateConsumerIdentity,omitempty"`
+}
+
+// NewRepositorySpec creates a new environment RepositorySpec.
+func NewRepositorySpec(prefix string, propagate bool) *RepositorySpec {
+	return &RepositorySpec{
+		ObjectVersionedType:       runtime.NewVersionedTypedObject(Type),
+		Prefix:                    prefix,
+		PropagateConsumerIdentity: propagate,
+	}
+}
+
+func (a *RepositorySpec) GetType() string {
+	return Type
+}
+
+func (a *RepositorySpec) GetPrefix() string {
+	if a.Prefix == "" {
+		return DEFAULT_PREFIX
+	}
+	return a.Prefix
+}
+
+func (a *RepositorySpec) Repository(ctx cpi.Context, creds cpi.Credentials) (cpi.Repository, error) {
+	return NewRepository(ctx, a.GetPrefix(), a.ConsumerType, a.PropagateConsumerIdentity), nil
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package netrc
+
+import (
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+)
+
+const ATTR_REPOS = "github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/netrc"
+
+type Repositories struct {
+	lock  sync.Mutex
+	repos map[string]*Repository
+}
+
+func newRepositories(datacontext.Context) interface{} {
+	return &Repositories{
+		repos: map[string]*Repository{},
+	}
+}
+
+func (r *Repositories) GetRepository(ctx cpi.Context, path, consumerType string, propagate bool) (*Repository, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := path + "/" + consumerType
+	if repo := r.repos[key]; repo != nil {
+		return repo, nil
+	}
+	repo, err := NewRepository(ctx, path, consumerType, propagate)
+	if err != nil {
+		return nil, err
+	}
+	r.repos[key] = repo
+	return repo, nil
+}
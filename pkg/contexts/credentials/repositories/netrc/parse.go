@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package netrc
+
+import (
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Entry is a machine entry of a netrc file.
+type Entry struct {
+	Machine  string
+	Login    string
+	Password string
+	Account  string
+}
+
+// Parse parses the content of a netrc file. The default entry
+// is ignored, because it cannot be mapped to a host. Macro
+// definitions are skipped.
+func Parse(data string) ([]Entry, error) {
+	var entries []Entry
+	cur := -1
+
+	lines := strings.Split(data, "\n")
+	for l := 0; l < len(lines); l++ {
+		line := lines[l]
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		for i := 0; i < len(fields); i++ {
+			token := fields[i]
+			switch token {
+			case "default":
+				cur = -1
+				continue
+			case "macdef":
+				// a macro definition ends with an empty line
+				for l++; l < len(lines) && strings.TrimSpace(lines[l]) != ""; l++ {
+				}
+				i = len(fields)
+				continue
+			}
+			if i+1 >= len(fields) {
+				return nil, errors.Newf("line %d: value missing for %q", l+1, token)
+			}
+			i++
+			value := fields[i]
+			switch token {
+			case "machine":
+				entries = append(entries, Entry{Machine: value})
+				cur = len(entries) - 1
+			case "login", "password", "account":
+				if cur < 0 {
+					// settings of the default entry
+					continue
+				}
+				switch token {
+				case "login":
+					entries[cur].Login = value
+				case "password":
+					entries[cur].Password = value
+				case "account":
+					entries[cur].Account = value
+				}
+			default:
+				return nil, errors.Newf("line %d: unknown token %q", l+1, token)
+			}
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package netrc_test
+
+import (
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/netrc"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	ociidentity "github.com/open-component-model/ocm/pkg/contexts/oci/identity"
+)
+
+const NETRC = `
+# registries
+machine ghcr.io login ocm password secret
+machine localhost:5000
+  login local
+  password local-secret
+  account test
+
+macdef init
+  cd /pub
+  machine ignored
+
+default login anonymous password guest
+machine ghcr.io login other password other
+`
+
+var _ = Describe("netrc credential repository", func() {
+	var ctx credentials.Context
+
+	BeforeEach(func() {
+		ctx = credentials.New()
+		fs := memoryfs.New()
+		MustBeSuccessful(vfs.WriteFile(fs, "/netrc", []byte(NETRC), 0o600))
+		vfsattr.Set(ctx, fs)
+	})
+
+	It("parses netrc file", func() {
+		entries := Must(netrc.Parse(NETRC))
+		Expect(entries).To(Equal([]netrc.Entry{
+			{Machine: "ghcr.io", Login: "ocm", Password: "secret"},
+			{Machine: "localhost:5000", Login: "local", Password: "local-secret", Account: "test"},
+			{Machine: "ghcr.io", Login: "other", Password: "other"},
+		}))
+	})
+
+	It("rejects invalid netrc file", func() {
+		_, err := netrc.Parse("machine ghcr.io login")
+		Expect(err).To(MatchError(`line 1: value missing for "login"`))
+		_, err = netrc.Parse("machine ghcr.io user ocm")
+		Expect(err).To(MatchError(`line 1: unknown token "user"`))
+	})
+
+	It("looks up credentials by machine", func() {
+		repo := Must(ctx.RepositoryForSpec(netrc.NewRepositorySpec("/netrc", false)))
+
+		creds := Must(repo.LookupCredentials("ghcr.io"))
+		Expect(creds.Properties()).To(Equal(cpi.DirectCredentials{
+			cpi.ATTR_USERNAME: "ocm",
+			cpi.ATTR_PASSWORD: "secret",
+		}.Properties()))
+		Expect(repo.ExistsCredentials("localhost:5000")).To(BeTrue())
+		Expect(repo.ExistsCredentials("gcr.io")).To(BeFalse())
+	})
+
+	It("fails for missing file", func() {
+		_, err := ctx.RepositoryForSpec(netrc.NewRepositorySpec("/missing", false))
+		Expect(err).To(MatchError(ContainSubstring(`cannot read netrc file "/missing"`)))
+	})
+
+	It("propagates credentials", func() {
+		Must(ctx.RepositoryForSpec(netrc.NewRepositorySpec("/netrc", true)))
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, credentials.NewConsumerIdentity(ociidentity.CONSUMER_TYPE,
+			ociidentity.ID_HOSTNAME, "localhost",
+			ociidentity.ID_PORT, "5000",
+		), ociidentity.IdentityMatcher))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("local-secret"))
+
+		creds = Must(credentials.CredentialsForConsumer(ctx, credentials.NewConsumerIdentity("HelmChartRepository",
+			ociidentity.ID_HOSTNAME, "ghcr.io",
+		)))
+		Expect(creds.GetProperty(cpi.ATTR_USERNAME)).To(Equal("ocm"))
+	})
+})
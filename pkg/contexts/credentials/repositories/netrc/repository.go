@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package netrc
+
+import (
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+const PROVIDER = "ocm.software/credentialprovider/" + Type
+
+// Repository provides the credentials of the machine entries of
+// a netrc file.
+type Repository struct {
+	path  string
+	creds map[string]cpi.Credentials
+}
+
+func NewRepository(ctx cpi.Context, path, consumerType string, propagate bool) (*Repository, error) {
+	fs := vfsattr.Get(ctx)
+	resolved, err := utils.ResolvePath(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot resolve path %q", path)
+	}
+	data, err := vfs.ReadFile(fs, resolved)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read netrc file %q", path)
+	}
+	entries, err := Parse(string(data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid netrc file %q", path)
+	}
+	r := &Repository{
+		path:  resolved,
+		creds: map[string]cpi.Credentials{},
+	}
+	for _, e := range entries {
+		if _, ok := r.creds[e.Machine]; ok {
+			// the first entry for a machine is used
+			continue
+		}
+		props := common.Properties{}
+		props.SetNonEmptyValue(cpi.ATTR_USERNAME, e.Login)
+		props.SetNonEmptyValue(cpi.ATTR_PASSWORD, e.Password)
+		r.creds[e.Machine] = cpi.NewCredentials(props)
+	}
+	if propagate {
+		ctx.RegisterConsumerProvider(cpi.ProviderIdentity(PROVIDER+"/"+resolved), hostpath.NewConsumerProvider(consumerType, func() map[string]cpi.Credentials { return r.creds }))
+	}
+	return r, nil
+}
+
+var _ cpi.Repository = &Repository{}
+
+func (r *Repository) ExistsCredentials(name string) (bool, error) {
+	return r.creds[name] != nil, nil
+}
+
+func (r *Repository) LookupCredentials(name string) (cpi.Credentials, error) {
+	c := r.creds[name]
+	if c == nil {
+		return nil, cpi.ErrUnknownCredentials(name)
+	}
+	return c, nil
+}
+
+func (r *Repository) WriteCredentials(name string, creds cpi.Credentials) (cpi.Credentials, error) {
+	return nil, errors.ErrNotSupported("write", "credentials", Type)
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package netrc
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	Type   = "NetRC"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+// DEFAULT_PATH is the netrc file used if no path is given and
+// the environment variable NETRC is not set.
+const DEFAULT_PATH = "~/.netrc"
+
+func init() {
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](Type))
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](TypeV1))
+}
+
+// RepositorySpec describes a netrc file based credential repository interface.
+type RepositorySpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	// Path is the path of the netrc file. By default, the file given
+	// by the environment variable NETRC or DEFAULT_PATH is used.
+	Path string `json:"path,omitempty"`
+	// ConsumerType restricts the propagation to a consumer type.
+	ConsumerType              string `json:"consumerType,omitempty"`
+	PropagateConsumerIdentity bool   `json:"propagateConsumerIdentity,omitempty"`
+}
+
+// NewRepositorySpec creates a new netrc RepositorySpec.
+func NewRepositorySpec(path string, propagate bool) *RepositorySpec {
+	return &RepositorySpec{
+		ObjectVersionedType:       runtime.NewVersionedTypedObject(Type),
+		Path:                      path,
+		PropagateConsumerIdentity: propagate,
+	}
+}
+
+func (a *RepositorySpec) GetType() string {
+	return Type
+}
+
+func (a *RepositorySpec) GetPath() string {
+	if a.Path != "" {
+		return a.Path
+	}
+	if p := os.Getenv("NETRC"); p != "" {
+		return p
+	}
+	return DEFAULT_PATH
+}
+
+func (a *RepositorySpec) Repository(ctx cpi.Context, creds cpi.Credentials) (cpi.Repository, error) {
+	r := ctx.GetAttributes().GetOrCreateAttribute(ATTR_REPOS, newRepositories)
+	repos, ok := r.(*Repositories)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type %T to Repositories", r)
+	}
+	return repos.GetRepository(ctx, a.GetPath(), a.ConsumerType, a.PropagateConsumerIdentity)
+}
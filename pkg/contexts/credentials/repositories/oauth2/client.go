@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/oauth2/identity"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// grant types used for the token request.
+const (
+	grantClientCredentials = "client_credentials"
+	grantTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+)
+
+// tokenResponse is the relevant part of a token endpoint response.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// errorResponse is an error response of a token endpoint.
+type errorResponse struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// client requests access tokens from an OAuth2 token endpoint.
+type client struct {
+	spec  *RepositorySpec
+	creds cpi.Credentials
+	fs    vfs.FileSystem
+}
+
+func newClient(spec *RepositorySpec, creds cpi.Credentials, fs vfs.FileSystem) (*client, error) {
+	c := &client{
+		spec:  spec,
+		creds: creds,
+		fs:    fs,
+	}
+	switch spec.GetGrantType() {
+	case GRANT_CLIENT_CREDENTIALS:
+		if c.property(identity.ATTR_CLIENT_ID) == "" {
+			return nil, errors.Newf("client id required for grant type %q", spec.GetGrantType())
+		}
+	case GRANT_TOKEN_EXCHANGE:
+		if c.property(identity.ATTR_SUBJECT_TOKEN) == "" && c.property(identity.ATTR_SUBJECT_TOKEN_PATH) == "" {
+			return nil, errors.Newf("subject token required for grant type %q", spec.GetGrantType())
+		}
+	default:
+		return nil, errors.ErrNotSupported("OAuth2 grant type", spec.GrantType)
+	}
+	return c, nil
+}
+
+func (c *client) property(name string) string {
+	if c.creds == nil {
+		return ""
+	}
+	return c.creds.GetProperty(name)
+}
+
+// Token requests an access token for the given scopes.
+func (c *client) Token(scopes []string) (cpi.Credentials, error) {
+	form := url.Values{}
+	switch c.spec.GetGrantType() {
+	case GRANT_CLIENT_CREDENTIALS:
+		form.Set("grant_type", grantClientCredentials)
+	case GRANT_TOKEN_EXCHANGE:
+		token, err := c.subjectToken()
+		if err != nil {
+			return nil, err
+		}
+		form.Set("grant_type", grantTokenExchange)
+		form.Set("subject_token", token)
+		form.Set("subject_token_type", c.spec.GetSubjectTokenType())
+	}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+	if c.spec.Audience != "" {
+		form.Set("audience", c.spec.Audience)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, c.spec.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if id := c.property(identity.ATTR_CLIENT_ID); id != "" {
+		req.SetBasicAuth(url.QueryEscape(id), url.QueryEscape(c.property(identity.ATTR_CLIENT_SECRET)))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "token request failed")
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read token response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		var e errorResponse
+		if json.Unmarshal(data, &e) == nil && e.Error != "" {
+			if e.Description != "" {
+				return nil, fmt.Errorf("token request failed: %s: %s", e.Error, e.Description)
+			}
+			return nil, fmt.Errorf("token request failed: %s", e.Error)
+		}
+		return nil, fmt.Errorf("token request failed: %s", resp.Status)
+	}
+	var t tokenResponse
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, errors.Wrapf(err, "invalid token response")
+	}
+	if t.AccessToken == "" {
+		return nil, errors.Newf("token response provides no access token")
+	}
+	if t.TokenType != "" && !strings.EqualFold(t.TokenType, "bearer") {
+		return nil, errors.ErrNotSupported("token type", t.TokenType)
+	}
+
+	props := common.Properties{
+		cpi.ATTR_TOKEN: t.AccessToken,
+	}
+	if c.spec.Username != "" {
+		props[cpi.ATTR_USERNAME] = c.spec.Username
+		props[cpi.ATTR_PASSWORD] = t.AccessToken
+	}
+	if t.ExpiresIn <= 0 {
+		return cpi.NewCredentials(props), nil
+	}
+	return cpi.NewExpiringCredentials(props, time.Now().Add(time.Duration(t.ExpiresIn)*time.Second)), nil
+}
+
+// subjectToken provides the subject token for the token exchange.
+// A token file is read for every request, because the token may be
+// rotated (for example a projected service account token).
+func (c *client) subjectToken() (string, error) {
+	if t := c.property(identity.ATTR_SUBJECT_TOKEN); t != "" {
+		return t, nil
+	}
+	data, err := vfs.ReadFile(c.fs, c.property(identity.ATTR_SUBJECT_TOKEN_PATH))
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot read subject token")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/listformat"
+)
+
+const CONSUMER_TYPE = "OAuth2TokenEndpoint" + common.OCM_TYPE_GROUP_SUFFIX
+
+// used identity attributes.
+const (
+	ID_TYPE       = cpi.ID_TYPE
+	ID_SCHEME     = hostpath.ID_SCHEME
+	ID_HOSTNAME   = hostpath.ID_HOSTNAME
+	ID_PORT       = hostpath.ID_PORT
+	ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+)
+
+// used credential properties.
+const (
+	ATTR_CLIENT_ID          = "clientId"
+	ATTR_CLIENT_SECRET      = "clientSecret"
+	ATTR_SUBJECT_TOKEN      = "subjectToken"
+	ATTR_SUBJECT_TOKEN_PATH = "subjectTokenPath"
+)
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+func init() {
+	attrs := listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		ATTR_CLIENT_ID, "OAuth2 client id",
+		ATTR_CLIENT_SECRET, "OAuth2 client secret",
+		ATTR_SUBJECT_TOKEN, "subject token used for the token exchange (for example an OIDC workload token)",
+		ATTR_SUBJECT_TOKEN_PATH, "path of a file containing the subject token (alternatively)",
+	})
+
+	cpi.RegisterStandardIdentity(CONSUMER_TYPE, identityMatcher, `OAuth2 token endpoint credential matcher
+
+This matcher is a hostpath matcher for the URL of an OAuth2 token
+endpoint. The credentials are used by the OAuth2 credential repository
+to request access tokens.`,
+		attrs)
+}
+
+func GetConsumerId(tokenURL string) cpi.ConsumerIdentity {
+	return hostpath.GetConsumerIdentity(CONSUMER_TYPE, tokenURL)
+}
+
+func GetCredentials(ctx cpi.ContextProvider, tokenURL string) (cpi.Credentials, error) {
+	id := GetConsumerId(tokenURL)
+	if id == nil {
+		return nil, nil
+	}
+	return cpi.CredentialsForConsumer(ctx.CredentialsContext(), id, identityMatcher)
+}
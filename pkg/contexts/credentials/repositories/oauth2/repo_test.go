@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/oauth2"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/oauth2/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	wgetidentity "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/wget/identity"
+)
+
+// fakeTokenEndpoint is an in-process stand-in for an OAuth2 token
+// endpoint supporting the client credentials and token exchange grants.
+type fakeTokenEndpoint struct {
+	*httptest.Server
+	lock     sync.Mutex
+	expires  int
+	requests []map[string]string
+}
+
+func newFakeTokenEndpoint() *fakeTokenEndpoint {
+	e := &fakeTokenEndpoint{expires: 3600}
+	e.Server = httptest.NewServer(http.HandlerFunc(e.serve))
+	return e
+}
+
+func (e *fakeTokenEndpoint) count() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return len(e.requests)
+}
+
+func (e *fakeTokenEndpoint) fail(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func (e *fakeTokenEndpoint) serve(w http.ResponseWriter, r *http.Request) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if r.Method != http.MethodPost || r.ParseForm() != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := map[string]string{}
+	for k := range r.PostForm {
+		req[k] = r.PostForm.Get(k)
+	}
+	switch r.PostForm.Get("grant_type") {
+	case "client_credentials":
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "ocm" || pass != "secret" {
+			e.fail(w, "invalid_client")
+			return
+		}
+	case "urn:ietf:params:oauth:grant-type:token-exchange":
+		if r.PostForm.Get("subject_token") != "workload" {
+			e.fail(w, "invalid_grant")
+			return
+		}
+	default:
+		e.fail(w, "unsupported_grant_type")
+		return
+	}
+	e.requests = append(e.requests, req)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": fmt.Sprintf("token%d", len(e.requests)),
+		"token_type":   "Bearer",
+		"expires_in":   e.expires,
+	})
+}
+
+var _ = Describe("OAuth2 credential repository", func() {
+	var ctx credentials.Context
+	var server *fakeTokenEndpoint
+
+	artifacts := wgetidentity.GetConsumerId("https://artifacts.acme.org/files/app.tgz")
+
+	auth := func(props ...string) {
+		creds := cpi.DirectCredentials{}
+		for i := 0; i+1 < len(props); i += 2 {
+			creds[props[i]] = props[i+1]
+		}
+		ctx.SetCredentialsForConsumer(identity.GetConsumerId(server.URL), creds)
+	}
+
+	BeforeEach(func() {
+		ctx = credentials.New()
+		server = newFakeTokenEndpoint()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("deserializes repo spec", func() {
+		spec := oauth2.NewRepositorySpec("https://sso.acme.org/token", artifacts)
+		data := Must(json.Marshal(spec))
+		Expect(Must(ctx.RepositorySpecForConfig(data, nil))).To(Equal(spec))
+	})
+
+	It("requests tokens with client credentials", func() {
+		auth(identity.ATTR_CLIENT_ID, "ocm", identity.ATTR_CLIENT_SECRET, "secret")
+		spec := oauth2.NewRepositorySpec(server.URL)
+		spec.Scopes = []string{"read", "write"}
+		spec.Audience = "artifacts"
+		repo := Must(ctx.RepositoryForSpec(spec))
+
+		creds := Must(repo.LookupCredentials(""))
+		Expect(creds.Properties()).To(Equal(cpi.DirectCredentials{cpi.ATTR_TOKEN: "token1"}.Properties()))
+		Expect(credentials.GetExpiration(creds)).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(server.requests[0]).To(Equal(map[string]string{
+			"grant_type": "client_credentials",
+			"scope":      "read write",
+			"audience":   "artifacts",
+		}))
+	})
+
+	It("caches tokens per scope", func() {
+		auth(identity.ATTR_CLIENT_ID, "ocm", identity.ATTR_CLIENT_SECRET, "secret")
+		repo := Must(ctx.RepositoryForSpec(oauth2.NewRepositorySpec(server.URL)))
+
+		Expect(Must(repo.LookupCredentials("")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("token1"))
+		Expect(Must(repo.LookupCredentials("")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("token1"))
+		Expect(Must(repo.LookupCredentials("read")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("token2"))
+		Expect(server.requests[1]["scope"]).To(Equal("read"))
+		Expect(server.count()).To(Equal(2))
+	})
+
+	It("requests new tokens after expiration", func() {
+		server.expires = 1
+		auth(identity.ATTR_CLIENT_ID, "ocm", identity.ATTR_CLIENT_SECRET, "secret")
+		repo := Must(ctx.RepositoryForSpec(oauth2.NewRepositorySpec(server.URL)))
+
+		Expect(Must(repo.LookupCredentials("")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("token1"))
+		// tokens expiring within the expiration margin are requested again
+		Expect(Must(repo.LookupCredentials("")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("token2"))
+	})
+
+	It("exchanges tokens", func() {
+		fs := memoryfs.New()
+		MustBeSuccessful(vfs.WriteFile(fs, "/token", []byte("workload\n"), 0o600))
+		vfsattr.Set(ctx, fs)
+		auth(identity.ATTR_SUBJECT_TOKEN_PATH, "/token")
+		spec := oauth2.NewRepositorySpec(server.URL)
+		spec.GrantType = oauth2.GRANT_TOKEN_EXCHANGE
+		repo := Must(ctx.RepositoryForSpec(spec))
+
+		Expect(Must(repo.LookupCredentials("")).GetProperty(cpi.ATTR_TOKEN)).To(Equal("token1"))
+		Expect(server.requests[0]).To(Equal(map[string]string{
+			"grant_type":         "urn:ietf:params:oauth:grant-type:token-exchange",
+			"subject_token":      "workload",
+			"subject_token_type": oauth2.DEFAULT_SUBJECT_TOKEN_TYPE,
+		}))
+	})
+
+	It("reports token endpoint errors", func() {
+		auth(identity.ATTR_CLIENT_ID, "ocm", identity.ATTR_CLIENT_SECRET, "wrong")
+		repo := Must(ctx.RepositoryForSpec(oauth2.NewRepositorySpec(server.URL)))
+
+		_, err := repo.LookupCredentials("")
+		Expect(err).To(MatchError("token request failed: invalid_client"))
+	})
+
+	It("requires client credentials", func() {
+		_, err := ctx.RepositoryForSpec(oauth2.NewRepositorySpec(server.URL))
+		Expect(err).To(MatchError(`client id required for grant type "client_credentials"`))
+	})
+
+	It("propagates tokens to consumer identities", func() {
+		auth(identity.ATTR_CLIENT_ID, "ocm", identity.ATTR_CLIENT_SECRET, "secret")
+		spec := oauth2.NewRepositorySpec(server.URL, artifacts)
+		spec.Username = "oauth2"
+		Must(ctx.RepositoryForSpec(spec))
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, artifacts, wgetidentity.IdentityMatcher))
+		Expect(creds.Properties()).To(Equal(cpi.DirectCredentials{
+			cpi.ATTR_TOKEN:    "token1",
+			cpi.ATTR_USERNAME: "oauth2",
+			cpi.ATTR_PASSWORD: "token1",
+		}.Properties()))
+
+		src := Must(ctx.GetCredentialsForConsumer(artifacts, wgetidentity.IdentityMatcher))
+		creds = Must(credentials.RefreshCredentials(ctx, src))
+		Expect(creds.GetProperty(cpi.ATTR_TOKEN)).To(Equal("token2"))
+	})
+})
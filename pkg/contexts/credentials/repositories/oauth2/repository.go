@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/oauth2/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Repository provides access tokens of an OAuth2 token endpoint.
+// The credentials name is interpreted as space separated list of
+// requested scopes. The empty name requests the scopes configured
+// for the repository. Tokens are cached until they expire.
+type Repository struct {
+	lock   sync.Mutex
+	ctx    cpi.Context
+	spec   *RepositorySpec
+	client *client
+	tokens map[string]*cpi.CachedCredentialsSource
+}
+
+func NewRepository(ctx cpi.Context, spec *RepositorySpec, creds cpi.Credentials) (*Repository, error) {
+	if spec.TokenURL == "" {
+		return nil, errors.Newf("token URL required")
+	}
+	if creds == nil {
+		var err error
+		creds, err = identity.GetCredentials(ctx, spec.TokenURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get credentials for token endpoint %q", spec.TokenURL)
+		}
+	}
+	c, err := newClient(spec, creds, vfsattr.Get(ctx))
+	if err != nil {
+		return nil, err
+	}
+	r := &Repository{
+		ctx:    ctx,
+		spec:   spec,
+		client: c,
+		tokens: map[string]*cpi.CachedCredentialsSource{},
+	}
+	if spec.PropagateConsumerIdentity {
+		src := r.source("")
+		for _, id := range spec.ConsumerIdentities {
+			ctx.SetCredentialsForConsumer(id, src)
+		}
+	}
+	return r, nil
+}
+
+var _ cpi.Repository = &Repository{}
+
+func (r *Repository) ExistsCredentials(name string) (bool, error) {
+	_, err := r.LookupCredentials(name)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *Repository) LookupCredentials(name string) (cpi.Credentials, error) {
+	return r.source(name).Credentials(r.ctx)
+}
+
+func (r *Repository) WriteCredentials(name string, creds cpi.Credentials) (cpi.Credentials, error) {
+	return nil, errors.ErrNotSupported("write", "credentials", Type)
+}
+
+// source provides the token source for the scopes described by
+// the given credentials name.
+func (r *Repository) source(name string) *cpi.CachedCredentialsSource {
+	scopes := strings.Fields(name)
+	if len(scopes) == 0 {
+		scopes = r.spec.Scopes
+	}
+	key := strings.Join(scopes, " ")
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	src := r.tokens[key]
+	if src == nil {
+		src = cpi.NewCachedCredentialsSource(func(cpi.Context, ...cpi.CredentialsSource) (cpi.Credentials, error) {
+			return r.client.Token(scopes)
+		}, cpi.EXPIRATION_MARGIN)
+		r.tokens[key] = src
+	}
+	return src
+}
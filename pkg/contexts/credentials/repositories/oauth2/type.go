@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	Type   = "OAuth2"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+// supported grant types.
+const (
+	GRANT_CLIENT_CREDENTIALS = "client_credentials"
+	GRANT_TOKEN_EXCHANGE     = "token_exchange"
+)
+
+// DEFAULT_SUBJECT_TOKEN_TYPE is the default type of the subject token
+// used for the token exchange.
+const DEFAULT_SUBJECT_TOKEN_TYPE = "urn:ietf:params:oauth:token-type:jwt"
+
+func init() {
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](Type))
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](TypeV1))
+}
+
+// RepositorySpec describes a credential repository providing access tokens
+// requested from an OAuth2 token endpoint.
+// The credentials used to authenticate at the token endpoint are taken from
+// the consumer identity of type identity.CONSUMER_TYPE for the token URL.
+type RepositorySpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	// TokenURL is the URL of the token endpoint.
+	TokenURL string `json:"tokenURL"`
+	// GrantType is the OAuth2 flow used to request tokens
+	// (client_credentials (default) or token_exchange).
+	GrantType string `json:"grantType,omitempty"`
+	// Scopes are the default scopes requested for a token.
+	Scopes []string `json:"scopes,omitempty"`
+	// Audience is the optional audience requested for a token.
+	Audience string `json:"audience,omitempty"`
+	// SubjectTokenType is the type of the subject token used for the
+	// token exchange.
+	SubjectTokenType string `json:"subjectTokenType,omitempty"`
+	// Username is used to additionally provide the token as password
+	// for consumers supporting only basic authentication.
+	Username string `json:"username,omitempty"`
+	// ConsumerIdentities are the consumer identities the token should be
+	// used for.
+	ConsumerIdentities []cpi.ConsumerIdentity `json:"consumerIdentities,omitempty"`
+	// PropagateConsumerIdentity provides the token for the
+	// consumer identities.
+	PropagateConsumerIdentity bool `json:"propagateConsumerIdentity,omitempty"`
+}
+
+// NewRepositorySpec creates a new OAuth2 RepositorySpec.
+func NewRepositorySpec(url string, ids ...cpi.ConsumerIdentity) *RepositorySpec {
+	return &RepositorySpec{
+		ObjectVersionedType:       runtime.NewVersionedTypedObject(Type),
+		TokenURL:                  url,
+		ConsumerIdentities:        ids,
+		PropagateConsumerIdentity: len(ids) > 0,
+	}
+}
+
+func (a *RepositorySpec) GetType() string {
+	return Type
+}
+
+func (a *RepositorySpec) GetGrantType() string {
+	if a.GrantType == "" {
+		return GRANT_CLIENT_CREDENTIALS
+	}
+	return a.GrantType
+}
+
+func (a *RepositorySpec) GetSubjectTokenType() string {
+	if a.SubjectTokenType == "" {
+		return DEFAULT_SUBJECT_TOKEN_TYPE
+	}
+	return a.SubjectTokenType
+}
+
+func (a *RepositorySpec) Repository(ctx cpi.Context, creds cpi.Credentials) (cpi.Repository, error) {
+	r := ctx.GetAttributes().GetOrCreateAttribute(ATTR_REPOS, newRepositories)
+	repos, ok := r.(*Repositories)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type %T to Repositories", r)
+	}
+	return repos.GetRepository(ctx, a, creds)
+}
+
+func (a *RepositorySpec) key() string {
+	data, _ := json.Marshal(a)
+	return string(data)
+}
@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/vault/identity"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// errForbidden indicates a rejected vault token.
+var errForbidden = errors.New("permission denied")
+
+// secret is the relevant part of a vault response for reading a secret.
+type secret struct {
+	LeaseDuration int                    `json:"lease_duration"`
+	Data          map[string]interface{} `json:"data"`
+	Auth          *auth                  `json:"auth"`
+}
+
+type auth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int    `json:"lease_duration"`
+}
+
+// client is a minimal client for the vault HTTP API.
+// It keeps track of the token lease and logs in again, if the
+// token is expired or rejected.
+type client struct {
+	lock      sync.Mutex
+	url       string
+	namespace string
+	creds     cpi.Credentials
+	fs        vfs.FileSystem
+
+	token   string
+	expires time.Time
+}
+
+func newClient(url, namespace string, creds cpi.Credentials, fs vfs.FileSystem) (*client, error) {
+	c := &client{
+		url:       strings.TrimSuffix(url, "/"),
+		namespace: namespace,
+		creds:     creds,
+		fs:        fs,
+	}
+	if creds == nil {
+		return nil, errors.ErrNotFound(cpi.KIND_CREDENTIALS, identity.GetConsumerId(url).String())
+	}
+	if _, err := c.authMethod(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *client) authMethod() (string, error) {
+	meth := c.creds.GetProperty(identity.ATTR_AUTHMETH)
+	if meth == "" {
+		switch {
+		case c.creds.ExistsProperty(identity.ATTR_ROLEID):
+			meth = identity.AUTH_APPROLE
+		case c.creds.ExistsProperty(identity.ATTR_ROLE):
+			meth = identity.AUTH_KUBERNETES
+		default:
+			meth = identity.AUTH_TOKEN
+		}
+	}
+	switch meth {
+	case identity.AUTH_TOKEN:
+		if c.creds.GetProperty(identity.ATTR_TOKEN) == "" {
+			return "", errors.Newf("token required for vault authentication method %q", meth)
+		}
+	case identity.AUTH_APPROLE:
+		if c.creds.GetProperty(identity.ATTR_ROLEID) == "" {
+			return "", errors.Newf("role id required for vault authentication method %q", meth)
+		}
+	case identity.AUTH_KUBERNETES:
+		if c.creds.GetProperty(identity.ATTR_ROLE) == "" {
+			return "", errors.Newf("role required for vault authentication method %q", meth)
+		}
+	default:
+		return "", errors.ErrNotSupported("vault authentication method", meth)
+	}
+	return meth, nil
+}
+
+// Read reads the secret with the given path.
+// Nil is returned, if the secret does not exist.
+func (c *client) Read(path string) (*secret, error) {
+	for retry := true; ; retry = false {
+		token, err := c.getToken()
+		if err != nil {
+			return nil, err
+		}
+		var s secret
+		found, err := c.request(http.MethodGet, path, token, nil, &s)
+		if err == errForbidden && retry && c.invalidate(token) { //nolint: errorlint // local error
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read vault secret %q", path)
+		}
+		if !found {
+			return nil, nil
+		}
+		return &s, nil
+	}
+}
+
+// invalidate resets a token obtained by a login. It returns whether
+// a new login may provide a valid token.
+func (c *client) invalidate(token string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.token != token || c.creds.GetProperty(identity.ATTR_TOKEN) == token {
+		return false
+	}
+	c.token = ""
+	return true
+}
+
+func (c *client) getToken() (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.token != "" && (c.expires.IsZero() || time.Now().Before(c.expires)) {
+		return c.token, nil
+	}
+
+	meth, err := c.authMethod()
+	if err != nil {
+		return "", err
+	}
+	var body map[string]string
+	switch meth {
+	case identity.AUTH_TOKEN:
+		c.token = c.creds.GetProperty(identity.ATTR_TOKEN)
+		c.expires = time.Time{}
+		return c.token, nil
+	case identity.AUTH_APPROLE:
+		body = map[string]string{
+			"role_id":   c.creds.GetProperty(identity.ATTR_ROLEID),
+			"secret_id": c.creds.GetProperty(identity.ATTR_SECRETID),
+		}
+	case identity.AUTH_KUBERNETES:
+		jwt, err := c.serviceAccountToken()
+		if err != nil {
+			return "", err
+		}
+		body = map[string]string{
+			"role": c.creds.GetProperty(identity.ATTR_ROLE),
+			"jwt":  jwt,
+		}
+	}
+
+	authpath := c.creds.GetProperty(identity.ATTR_AUTHPATH)
+	if authpath == "" {
+		authpath = meth
+	}
+	var s secret
+	_, err = c.request(http.MethodPost, "auth/"+strings.Trim(authpath, "/")+"/login", "", body, &s)
+	if err != nil {
+		return "", errors.Wrapf(err, "vault login (%s) failed", meth)
+	}
+	if s.Auth == nil || s.Auth.ClientToken == "" {
+		return "", errors.Newf("vault login (%s) provides no token", meth)
+	}
+	c.token = s.Auth.ClientToken
+	c.expires = expiration(s.Auth.LeaseDuration)
+	return c.token, nil
+}
+
+func (c *client) serviceAccountToken() (string, error) {
+	if jwt := c.creds.GetProperty(identity.ATTR_JWT); jwt != "" {
+		return jwt, nil
+	}
+	path := c.creds.GetProperty(identity.ATTR_JWTPATH)
+	if path == "" {
+		path = identity.DEFAULT_JWTPATH
+	}
+	data, err := vfs.ReadFile(c.fs, path)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot read service account token")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (c *client) request(method, path, token string, body interface{}, result interface{}) (bool, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return false, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, c.url+"/v1/"+strings.TrimPrefix(path, "/"), reader)
+	if err != nil {
+		return false, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, errors.Wrapf(err, "vault request failed")
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, errors.Wrapf(err, "cannot read vault response")
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusForbidden:
+		return false, errForbidden
+	default:
+		return false, fmt.Errorf("vault request for %q failed: %s: %s", path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return false, errors.Wrapf(err, "invalid vault response")
+	}
+	return true, nil
+}
+
+// properties converts the data of a secret into credential properties.
+// Secrets of a KV version 2 secrets engine are unwrapped.
+func (s *secret) properties() common.Properties {
+	data := s.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	props := common.Properties{}
+	for k, v := range data {
+		switch t := v.(type) {
+		case string:
+			props[k] = t
+		case nil:
+		default:
+			d, err := json.Marshal(t)
+			if err == nil {
+				props[k] = string(d)
+			}
+		}
+	}
+	return props
+}
+
+func expiration(lease int) time.Time {
+	if lease <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(lease) * time.Second)
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+)
+
+// credentialGetter provides the actual content of a secret
+// propagated for a consumer identity.
+type credentialGetter struct {
+	repo *Repository
+	path string
+}
+
+var _ cpi.RefreshableCredentialsSource = credentialGetter{}
+
+func (c credentialGetter) Credentials(ctx cpi.Context, cs ...cpi.CredentialsSource) (cpi.Credentials, error) {
+	return c.repo.LookupCredentials(c.path)
+}
+
+func (c credentialGetter) Refresh(ctx cpi.Context, cs ...cpi.CredentialsSource) (cpi.Credentials, error) {
+	c.repo.invalidate(c.path)
+	return c.repo.LookupCredentials(c.path)
+}
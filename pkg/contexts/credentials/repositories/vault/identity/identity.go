@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/listformat"
+)
+
+const CONSUMER_TYPE = "HashiCorpVault" + common.OCM_TYPE_GROUP_SUFFIX
+
+// used identity attributes.
+const (
+	ID_TYPE       = cpi.ID_TYPE
+	ID_SCHEME     = hostpath.ID_SCHEME
+	ID_HOSTNAME   = hostpath.ID_HOSTNAME
+	ID_PORT       = hostpath.ID_PORT
+	ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+)
+
+// used credential properties.
+const (
+	ATTR_AUTHMETH = "authmeth"
+	ATTR_AUTHPATH = "authpath"
+	ATTR_TOKEN    = cpi.ATTR_TOKEN
+	ATTR_ROLEID   = "roleid"
+	ATTR_SECRETID = "secretid"
+	ATTR_ROLE     = "role"
+	ATTR_JWT      = "jwt"
+	ATTR_JWTPATH  = "jwtpath"
+)
+
+// supported authentication methods.
+const (
+	AUTH_TOKEN      = "token"
+	AUTH_APPROLE    = "approle"
+	AUTH_KUBERNETES = "kubernetes"
+)
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+func init() {
+	attrs := listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		ATTR_AUTHMETH, "authentication method (<code>" + AUTH_TOKEN + "</code>, <code>" + AUTH_APPROLE + "</code> or <code>" + AUTH_KUBERNETES + "</code>), defaulted by the given properties",
+		ATTR_AUTHPATH, "mount path of the authentication method (defaulted to the method name)",
+		ATTR_TOKEN, "vault token (method <code>" + AUTH_TOKEN + "</code>)",
+		ATTR_ROLEID, "role id (method <code>" + AUTH_APPROLE + "</code>)",
+		ATTR_SECRETID, "secret id (method <code>" + AUTH_APPROLE + "</code>)",
+		ATTR_ROLE, "role name (method <code>" + AUTH_KUBERNETES + "</code>)",
+		ATTR_JWT, "service account token (method <code>" + AUTH_KUBERNETES + "</code>)",
+		ATTR_JWTPATH, "path of service account token file (method <code>" + AUTH_KUBERNETES + "</code>, defaulted to <code>" + DEFAULT_JWTPATH + "</code>)",
+	})
+
+	cpi.RegisterStandardIdentity(CONSUMER_TYPE, identityMatcher, `HashiCorp Vault credential matcher
+
+This matcher is a hostpath matcher for the URL of a HashiCorp Vault
+server. The credentials are used to authenticate at the server by
+the Vault credential repository.`,
+		attrs)
+}
+
+// DEFAULT_JWTPATH is the default location of the service account token
+// used for the kubernetes authentication.
+const DEFAULT_JWTPATH = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+func GetConsumerId(serverURL string) cpi.ConsumerIdentity {
+	return hostpath.GetConsumerIdentity(CONSUMER_TYPE, serverURL)
+}
+
+func GetCredentials(ctx cpi.ContextProvider, serverURL string) (cpi.Credentials, error) {
+	id := GetConsumerId(serverURL)
+	if id == nil {
+		return nil, nil
+	}
+	return cpi.CredentialsForConsumer(ctx.CredentialsContext(), id, identityMatcher)
+}
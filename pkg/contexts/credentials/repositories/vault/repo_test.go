@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/vault"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/vault/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	ociidentity "github.com/open-component-model/ocm/pkg/contexts/oci/identity"
+)
+
+// fakeVault is an in-process stand-in for the vault HTTP API
+// supporting KV version 2 secrets and the token, AppRole and
+// kubernetes authentication methods.
+type fakeVault struct {
+	*httptest.Server
+	lock      sync.Mutex
+	tokens    map[string]bool
+	secrets   map[string]map[string]interface{}
+	lease     int
+	authLease int
+	logins    int
+	reads     int
+}
+
+func newFakeVault() *fakeVault {
+	v := &fakeVault{
+		tokens:  map[string]bool{"root": true},
+		secrets: map[string]map[string]interface{}{},
+	}
+	v.Server = httptest.NewServer(http.HandlerFunc(v.serve))
+	return v
+}
+
+func (v *fakeVault) set(path string, data map[string]interface{}) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.secrets[path] = data
+}
+
+func (v *fakeVault) revoke() {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.tokens = map[string]bool{"root": true}
+}
+
+func (v *fakeVault) serve(w http.ResponseWriter, r *http.Request) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if r.Method == http.MethodPost && strings.HasPrefix(path, "auth/") {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch path {
+		case "auth/approle/login":
+			if body["role_id"] != "role" || body["secret_id"] != "secret" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		case "auth/kubernetes/login":
+			if body["role"] != "ocm" || body["jwt"] != "jwt" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		v.logins++
+		token := fmt.Sprintf("token-%d", v.logins)
+		v.tokens[token] = true
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"auth": map[string]interface{}{"client_token": token, "lease_duration": v.authLease},
+		})
+		return
+	}
+	if !v.tokens[r.Header.Get("X-Vault-Token")] {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+	data, ok := v.secrets[path]
+	if r.Method != http.MethodGet || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	v.reads++
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"lease_duration": v.lease,
+		"data": map[string]interface{}{
+			"data":     data,
+			"metadata": map[string]interface{}{"version": 1},
+		},
+	})
+}
+
+var _ = Describe("vault credential repository", func() {
+	var ctx credentials.Context
+	var server *fakeVault
+
+	ghcr := cpi.ConsumerIdentity{
+		cpi.ID_TYPE:             ociidentity.CONSUMER_TYPE,
+		ociidentity.ID_HOSTNAME: "ghcr.io",
+	}
+
+	auth := func(props ...string) {
+		creds := cpi.DirectCredentials{}
+		for i := 0; i+1 < len(props); i += 2 {
+			creds[props[i]] = props[i+1]
+		}
+		ctx.SetCredentialsForConsumer(identity.GetConsumerId(server.URL), creds)
+	}
+
+	BeforeEach(func() {
+		ctx = credentials.New()
+		server = newFakeVault()
+		server.set("secret/data/ghcr", map[string]interface{}{
+			cpi.ATTR_USERNAME: "ocm",
+			cpi.ATTR_PASSWORD: "ghcr-secret",
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("serializes repo spec", func() {
+		spec := vault.NewRepositorySpec("https://vault.acme.org", vault.SecretSpec{
+			Path:               "secret/data/ghcr",
+			ConsumerIdentities: []cpi.ConsumerIdentity{ghcr},
+		})
+		data := Must(json.Marshal(spec))
+		Expect(string(data)).To(Equal(`{"type":"Vault","serverURL":"https://vault.acme.org","secrets":[{"path":"secret/data/ghcr","consumerIdentities":[{"hostname":"ghcr.io","type":"OCIRegistry"}]}],"propagateConsumerIdentity":true}`))
+
+		auth(identity.ATTR_TOKEN, "root")
+		s := Must(ctx.RepositorySpecForConfig(data, nil))
+		Expect(s).To(Equal(spec))
+	})
+
+	It("reads secret with token", func() {
+		auth(identity.ATTR_TOKEN, "root")
+		repo := Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL)))
+
+		creds := Must(repo.LookupCredentials("secret/data/ghcr"))
+		Expect(creds.Properties()).To(Equal(cpi.DirectCredentials{
+			cpi.ATTR_USERNAME: "ocm",
+			cpi.ATTR_PASSWORD: "ghcr-secret",
+		}.Properties()))
+
+		Expect(repo.ExistsCredentials("secret/data/ghcr")).To(BeTrue())
+		Expect(repo.ExistsCredentials("secret/data/other")).To(BeFalse())
+		_, err := repo.LookupCredentials("secret/data/other")
+		Expect(err).To(MatchError(`credentials "secret/data/other" is unknown`))
+	})
+
+	It("rejects invalid token", func() {
+		auth(identity.ATTR_TOKEN, "invalid")
+		repo := Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL)))
+
+		_, err := repo.LookupCredentials("secret/data/ghcr")
+		Expect(err).To(MatchError(`cannot read vault secret "secret/data/ghcr": permission denied`))
+	})
+
+	It("fails without credentials", func() {
+		_, err := ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("authenticates with AppRole", func() {
+		auth(identity.ATTR_ROLEID, "role", identity.ATTR_SECRETID, "secret")
+		repo := Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL)))
+
+		creds := Must(repo.LookupCredentials("secret/data/ghcr"))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("ghcr-secret"))
+		Expect(server.logins).To(Equal(1))
+	})
+
+	It("authenticates with kubernetes service account", func() {
+		fs := memoryfs.New()
+		MustBeSuccessful(fs.MkdirAll(vfs.Dir(fs, identity.DEFAULT_JWTPATH), 0o700))
+		MustBeSuccessful(vfs.WriteFile(fs, identity.DEFAULT_JWTPATH, []byte("jwt\n"), 0o600))
+		vfsattr.Set(ctx, fs)
+		auth(identity.ATTR_AUTHMETH, identity.AUTH_KUBERNETES, identity.ATTR_ROLE, "ocm")
+		repo := Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL)))
+
+		creds := Must(repo.LookupCredentials("secret/data/ghcr"))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("ghcr-secret"))
+		Expect(server.logins).To(Equal(1))
+	})
+
+	It("logs in again for rejected token", func() {
+		server.lease = 1
+		auth(identity.ATTR_ROLEID, "role", identity.ATTR_SECRETID, "secret")
+		repo := Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL)))
+		Must(repo.LookupCredentials("secret/data/ghcr"))
+
+		server.revoke()
+		time.Sleep(1100 * time.Millisecond)
+		Must(repo.LookupCredentials("secret/data/ghcr"))
+		Expect(server.logins).To(Equal(2))
+	})
+
+	It("propagates secrets to consumer identities", func() {
+		auth(identity.ATTR_TOKEN, "root")
+		Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL, vault.SecretSpec{
+			Path:               "secret/data/ghcr",
+			ConsumerIdentities: []cpi.ConsumerIdentity{ghcr},
+		})))
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, ghcr))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("ghcr-secret"))
+	})
+
+	It("re-reads secrets with expired lease", func() {
+		auth(identity.ATTR_TOKEN, "root")
+		server.lease = 1
+		Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL, vault.SecretSpec{
+			Path:               "secret/data/ghcr",
+			ConsumerIdentities: []cpi.ConsumerIdentity{ghcr},
+		})))
+
+		creds := Must(credentials.CredentialsForConsumer(ctx, ghcr))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("ghcr-secret"))
+		Expect(credentials.GetExpiration(creds)).To(BeTemporally("~", time.Now().Add(time.Second), 500*time.Millisecond))
+
+		server.set("secret/data/ghcr", map[string]interface{}{
+			cpi.ATTR_USERNAME: "ocm",
+			cpi.ATTR_PASSWORD: "rotated",
+		})
+		creds = Must(credentials.CredentialsForConsumer(ctx, ghcr))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("ghcr-secret"))
+		Expect(server.reads).To(Equal(1))
+
+		time.Sleep(1100 * time.Millisecond)
+		creds = Must(credentials.CredentialsForConsumer(ctx, ghcr))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("rotated"))
+		Expect(server.reads).To(Equal(2))
+	})
+
+	It("refreshes propagated secrets", func() {
+		auth(identity.ATTR_TOKEN, "root")
+		Must(ctx.RepositoryForSpec(vault.NewRepositorySpec(server.URL, vault.SecretSpec{
+			Path:               "secret/data/ghcr",
+			ConsumerIdentities: []cpi.ConsumerIdentity{ghcr},
+		})))
+
+		src := Must(ctx.GetCredentialsForConsumer(ghcr))
+		creds := Must(src.Credentials(ctx))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("ghcr-secret"))
+		Expect(credentials.GetExpiration(creds)).To(BeZero())
+
+		server.set("secret/data/ghcr", map[string]interface{}{
+			cpi.ATTR_USERNAME: "ocm",
+			cpi.ATTR_PASSWORD: "rotated",
+		})
+		creds = Must(credentials.RefreshCredentials(ctx, src))
+		Expect(creds.GetProperty(cpi.ATTR_PASSWORD)).To(Equal("rotated"))
+		Expect(server.reads).To(Equal(2))
+	})
+})
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/vault/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// entry is a cached secret. Secrets with a lease are read again
+// after the lease expired.
+type entry struct {
+	creds   cpi.Credentials
+	expires time.Time
+}
+
+func (e *entry) valid() bool {
+	return e.expires.IsZero() || time.Now().Before(e.expires)
+}
+
+type Repository struct {
+	lock    sync.Mutex
+	ctx     cpi.Context
+	client  *client
+	secrets map[string]*entry
+}
+
+func NewRepository(ctx cpi.Context, spec *RepositorySpec, creds cpi.Credentials) (*Repository, error) {
+	if spec.ServerURL == "" {
+		return nil, errors.Newf("vault server URL required")
+	}
+	if creds == nil {
+		var err error
+		creds, err = identity.GetCredentials(ctx, spec.ServerURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get credentials for vault server %q", spec.ServerURL)
+		}
+	}
+	c, err := newClient(spec.ServerURL, spec.Namespace, creds, vfsattr.Get(ctx))
+	if err != nil {
+		return nil, err
+	}
+	r := &Repository{
+		ctx:     ctx,
+		client:  c,
+		secrets: map[string]*entry{},
+	}
+	for _, s := range spec.Secrets {
+		if s.Path == "" {
+			return nil, errors.Newf("vault secret path required")
+		}
+		if spec.PropagateConsumerIdentity {
+			for _, id := range s.ConsumerIdentities {
+				ctx.SetCredentialsForConsumer(id, credentialGetter{r, normPath(s.Path)})
+			}
+		}
+	}
+	return r, nil
+}
+
+var _ cpi.Repository = &Repository{}
+
+func (r *Repository) ExistsCredentials(name string) (bool, error) {
+	e, err := r.get(name)
+	if err != nil {
+		return false, err
+	}
+	return e != nil, nil
+}
+
+func (r *Repository) LookupCredentials(name string) (cpi.Credentials, error) {
+	e, err := r.get(name)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return nil, cpi.ErrUnknownCredentials(name)
+	}
+	return e.creds, nil
+}
+
+func (r *Repository) WriteCredentials(name string, creds cpi.Credentials) (cpi.Credentials, error) {
+	return nil, errors.ErrNotSupported("write", "credentials", Type)
+}
+
+// get provides the cached secret for a path. It is read again
+// from the vault server, if its lease is expired.
+func (r *Repository) get(name string) (*entry, error) {
+	path := normPath(name)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if e := r.secrets[path]; e != nil && e.valid() {
+		return e, nil
+	}
+	s, err := r.client.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		delete(r.secrets, path)
+		return nil, nil
+	}
+	e := &entry{
+		expires: expiration(s.LeaseDuration),
+	}
+	if e.expires.IsZero() {
+		e.creds = cpi.NewCredentials(s.properties())
+	} else {
+		e.creds = cpi.NewExpiringCredentials(s.properties(), e.expires)
+	}
+	r.secrets[path] = e
+	return e, nil
+}
+
+// invalidate discards the cached secret for a path.
+func (r *Repository) invalidate(name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.secrets, normPath(name))
+}
+
+func normPath(p string) string {
+	return strings.Trim(p, "/")
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	Type   = "Vault"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](Type))
+	cpi.RegisterRepositoryType(cpi.NewRepositoryType[*RepositorySpec](TypeV1))
+}
+
+// RepositorySpec describes a HashiCorp Vault based credential repository interface.
+// The credentials used to access the vault server are taken from the
+// consumer identity of type identity.CONSUMER_TYPE for the server URL.
+type RepositorySpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	// ServerURL is the URL of the vault server.
+	ServerURL string `json:"serverURL"`
+	// Namespace is the optional vault namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Secrets maps secret paths to consumer identities.
+	Secrets []SecretSpec `json:"secrets,omitempty"`
+	// PropagateConsumerIdentity provides the secrets for their
+	// consumer identities.
+	PropagateConsumerIdentity bool `json:"propagateConsumerIdentity,omitempty"`
+}
+
+// SecretSpec describes a secret and the consumer identities it should
+// be used for. The path is the API path of the secret relative to the
+// vault API (for example secret/data/<name> for a KV version 2 engine
+// mounted at secret).
+type SecretSpec struct {
+	Path               string                 `json:"path"`
+	ConsumerIdentities []cpi.ConsumerIdentity `json:"consumerIdentities,omitempty"`
+}
+
+// NewRepositorySpec creates a new vault RepositorySpec.
+func NewRepositorySpec(url string, secrets ...SecretSpec) *RepositorySpec {
+	return &RepositorySpec{
+		ObjectVersionedType:       runtime.NewVersionedTypedObject(Type),
+		ServerURL:                 url,
+		Secrets:                   secrets,
+		PropagateConsumerIdentity: len(secrets) > 0,
+	}
+}
+
+func (a *RepositorySpec) GetType() string {
+	return Type
+}
+
+func (a *RepositorySpec) Repository(ctx cpi.Context, creds cpi.Credentials) (cpi.Repository, error) {
+	r := ctx.GetAttributes().GetOrCreateAttribute(ATTR_REPOS, newRepositories)
+	repos, ok := r.(*Repositories)
+	if !ok {
+		return nil, fmt.Errorf("failed to assert type %T to Repositories", r)
+	}
+	return repos.GetRepository(ctx, a, creds)
+}
+
+func (a *RepositorySpec) key() string {
+	data, _ := json.Marshal(a)
+	return string(data)
+}
@@ -25,3 +25,7 @@ const OCITAG_ANNOTATION = artifactset.OCITAG_ANNOTATION
 // the OCM identity of the origin of an OCI artifact. This is the identity of a
 // component version `<component name>:<component version>`.
 const COMPVERS_ANNOTATION = "software.ocm/component-version"
+
+// SIGNATURE_ANNOTATION is the name of the OCI manifest annotation used to describe
+// the name of a detached signature of a component version.
+const SIGNATURE_ANNOTATION = "software.ocm/signature"
@@ -28,6 +28,10 @@ const (
 	MediaTypeDockerSchema2ManifestList = images.MediaTypeDockerSchema2ManifestList
 
 	MediaTypeImageConfig = ociv1.MediaTypeImageConfig
+
+	// MediaTypeEmptyJSON is the media type of the empty config
+	// used for artifacts according to OCI 1.1.
+	MediaTypeEmptyJSON = "application/vnd.oci.empty.v1+json"
 )
 
 var legacy = false
@@ -119,6 +123,16 @@ func (d *Artifact) Manifest() *Manifest {
 	return d.manifest
 }
 
+// Subject returns the descriptor of the manifest
+// the artifact refers to, if it is a manifest
+// with a subject.
+func (d *Artifact) Subject() *Descriptor {
+	if d.manifest != nil {
+		return d.manifest.Subject
+	}
+	return nil
+}
+
 func (d *Artifact) SetAnnotation(name, value string) error {
 	return d.modifyAnnotation(func(annos *map[string]string) {
 		if *annos == nil {
@@ -49,11 +49,13 @@ func (g *GenericDescriptor) IsManifest() bool {
 
 func (g *GenericDescriptor) AsManifest() *ociv1.Manifest {
 	return &ociv1.Manifest{
-		Versioned:   g.Versioned,
-		MediaType:   g.MediaType,
-		Config:      g.Config,
-		Layers:      g.Layers,
-		Annotations: g.Annotations,
+		Versioned:    g.Versioned,
+		MediaType:    g.MediaType,
+		ArtifactType: g.ArtifactType,
+		Config:       g.Config,
+		Layers:       g.Layers,
+		Subject:      g.Subject,
+		Annotations:  g.Annotations,
 	}
 }
 
@@ -44,6 +44,29 @@ func (m *Manifest) GetBlobDescriptor(digest digest.Digest) *Descriptor {
 	return nil
 }
 
+// GetArtifactType returns the artifact type of the manifest.
+// According to OCI 1.1 the config media type is used
+// if no explicit artifact type is set.
+func (m *Manifest) GetArtifactType() string {
+	if m.ArtifactType != "" {
+		return m.ArtifactType
+	}
+	return m.Config.MediaType
+}
+
+// SetSubject sets the manifest the manifest refers to.
+func (m *Manifest) SetSubject(d *Descriptor) {
+	if d == nil {
+		m.Subject = nil
+		return
+	}
+	s := *d
+	s.Annotations = nil
+	s.Platform = nil
+	s.ArtifactType = ""
+	m.Subject = &s
+}
+
 func (m *Manifest) MimeType() string {
 	return ArtifactMimeType(m.MediaType, MediaTypeImageManifest, legacy)
 }
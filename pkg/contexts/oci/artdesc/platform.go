@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artdesc
+
+import (
+	"github.com/containerd/containerd/platforms"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// ParsePlatforms parses a list of platform specifiers
+// of the form <os>[/<arch>[/<variant>]].
+func ParsePlatforms(specs ...string) ([]Platform, error) {
+	var result []Platform
+	for _, s := range specs {
+		p, err := platforms.Parse(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid platform %q", s)
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// PlatformStrings returns the normalized string representation of
+// a list of platforms.
+func PlatformStrings(list ...Platform) []string {
+	var result []string
+	for _, p := range list {
+		result = append(result, platforms.Format(platforms.Normalize(p)))
+	}
+	return result
+}
+
+// FilterPlatforms returns the descriptors of an index manifest list
+// matching one of the given platforms. Descriptors without platform
+// information are always kept. If no platform is given, the complete
+// list is returned.
+func FilterPlatforms(list []Descriptor, filter ...Platform) []Descriptor {
+	if len(filter) == 0 {
+		return list
+	}
+	matcher := platforms.Any(filter...)
+	var result []Descriptor
+	for _, d := range list {
+		if d.Platform == nil || matcher.Match(*d.Platform) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artdesc
+
+import (
+	"github.com/opencontainers/go-digest"
+)
+
+// ReferrersTag provides the tag used by the referrers tag schema
+// to store the referrers of a subject in registries without support
+// for the referrers API.
+func ReferrersTag(subject digest.Digest) string {
+	alg := subject.Algorithm().String()
+	if len(alg) > 32 {
+		alg = alg[:32]
+	}
+	ref := subject.Encoded()
+	if len(ref) > 64 {
+		ref = ref[:64]
+	}
+	return alg + "-" + ref
+}
+
+// ReferrerDescriptor provides the descriptor used to describe
+// an artifact in a referrers list.
+func ReferrerDescriptor(art *Artifact, dgst digest.Digest, size int64) *Descriptor {
+	d := &Descriptor{
+		MediaType: art.MimeType(),
+		Digest:    dgst,
+		Size:      size,
+	}
+	switch {
+	case art.IsManifest():
+		d.ArtifactType = art.Manifest().GetArtifactType()
+		d.Annotations = art.Manifest().Annotations
+	case art.IsIndex():
+		d.Annotations = art.Index().Annotations
+	}
+	return d
+}
+
+// FilterReferrers filters a referrers list for an artifact type.
+// An empty artifact type matches all entries.
+func FilterReferrers(list []Descriptor, artifactType string) []Descriptor {
+	if artifactType == "" {
+		return list
+	}
+	var result []Descriptor
+	for _, d := range list {
+		if d.ArtifactType == artifactType {
+			result = append(result, d)
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artdesc_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+)
+
+var _ = Describe("referrers", func() {
+	subject := &artdesc.Descriptor{
+		MediaType:   artdesc.MediaTypeImageManifest,
+		Digest:      digest.FromString("subject"),
+		Size:        10,
+		Annotations: map[string]string{"a": "b"},
+	}
+
+	It("provides referrers tag", func() {
+		Expect(artdesc.ReferrersTag(subject.Digest)).To(Equal("sha256-" + subject.Digest.Encoded()))
+	})
+
+	It("keeps subject", func() {
+		m := artdesc.NewManifest()
+		m.ArtifactType = "application/spdx+json"
+		m.Config = artdesc.Descriptor{MediaType: artdesc.MediaTypeEmptyJSON, Digest: digest.FromString("{}"), Size: 2}
+		m.SetSubject(subject)
+
+		blob := Must(m.ToBlobAccess())
+		art := Must(artdesc.Decode(Must(blob.Get())))
+		Expect(art.IsManifest()).To(BeTrue())
+		Expect(art.Subject()).To(Equal(&artdesc.Descriptor{
+			MediaType: artdesc.MediaTypeImageManifest,
+			Digest:    subject.Digest,
+			Size:      10,
+		}))
+
+		d := artdesc.ReferrerDescriptor(art, blob.Digest(), blob.Size())
+		Expect(d.ArtifactType).To(Equal("application/spdx+json"))
+		Expect(d.MediaType).To(Equal(artdesc.MediaTypeImageManifest))
+	})
+
+	It("uses config media type as artifact type", func() {
+		m := artdesc.NewManifest()
+		m.Config = artdesc.Descriptor{MediaType: "application/vnd.test.config+json"}
+		Expect(m.GetArtifactType()).To(Equal("application/vnd.test.config+json"))
+	})
+
+	It("filters referrers", func() {
+		list := []artdesc.Descriptor{{ArtifactType: "a"}, {ArtifactType: "b"}}
+		Expect(artdesc.FilterReferrers(list, "")).To(Equal(list))
+		Expect(artdesc.FilterReferrers(list, "b")).To(Equal(list[1:]))
+	})
+})
@@ -46,6 +46,11 @@ type (
 	DataAccess                       = internal.DataAccess
 	RepositorySource                 = internal.RepositorySource
 	ConsumerIdentityProvider         = internal.ConsumerIdentityProvider
+	BlobGarbageCollector             = internal.BlobGarbageCollector
+	ArtifactDeleter                  = internal.ArtifactDeleter
+	TagRemover                       = internal.TagRemover
+	ReferrersLister                  = internal.ReferrersLister
+	BlobMounter                      = internal.BlobMounter
 )
 
 type Descriptor = ociv1.Descriptor
@@ -116,3 +116,36 @@ func (i *namespaceAccessImpl) AddArtifact(artifact cpi.Artifact, tags ...string)
 func (i *namespaceAccessImpl) NewArtifact(arts ...*artdesc.Artifact) (cpi.ArtifactAccess, error) {
 	return i.NamespaceContainer.NewArtifact(i, arts...)
 }
+
+// DeleteArtifact deletes an artifact, if supported by the container.
+func (i *namespaceAccessImpl) DeleteArtifact(digest digest.Digest) error {
+	if d, ok := i.NamespaceContainer.(cpi.ArtifactDeleter); ok {
+		return d.DeleteArtifact(digest)
+	}
+	return errors.ErrNotSupported("artifact deletion")
+}
+
+// RemoveTags removes tags, if supported by the container.
+func (i *namespaceAccessImpl) RemoveTags(tags ...string) error {
+	if d, ok := i.NamespaceContainer.(cpi.TagRemover); ok {
+		return d.RemoveTags(tags...)
+	}
+	return errors.ErrNotSupported("tag removal")
+}
+
+// Referrers lists the referrers of an artifact, if supported by the container.
+func (i *namespaceAccessImpl) Referrers(digest digest.Digest, artifactType string) ([]artdesc.Descriptor, error) {
+	if r, ok := i.NamespaceContainer.(cpi.ReferrersLister); ok {
+		return r.Referrers(digest, artifactType)
+	}
+	return nil, errors.ErrNotSupported("referrers")
+}
+
+// MountBlob adds a blob available in another namespace. If mounting
+// is not supported by the container, the blob is uploaded.
+func (i *namespaceAccessImpl) MountBlob(src cpi.NamespaceAccess, blob cpi.BlobAccess) error {
+	if m, ok := i.NamespaceContainer.(cpi.BlobMounter); ok {
+		return m.MountBlob(src, blob)
+	}
+	return i.NamespaceContainer.AddBlob(blob)
+}
@@ -212,6 +212,18 @@ func (n *namespaceAccessView) AddBlob(access internal.BlobAccess) error {
 	})
 }
 
+// MountBlob adds a blob available in another namespace. If
+// mounting is not supported by the namespace implementation,
+// the blob is uploaded.
+func (n *namespaceAccessView) MountBlob(src internal.NamespaceAccess, blob internal.BlobAccess) error {
+	return n.Execute(func() error {
+		if m, ok := n.impl.(internal.BlobMounter); ok {
+			return m.MountBlob(src, blob)
+		}
+		return n.impl.AddBlob(blob)
+	})
+}
+
 func (n *namespaceAccessView) HasArtifact(vers string) (ok bool, err error) {
 	err = n.Execute(func() error {
 		ok, err = n.impl.HasArtifact(vers)
@@ -242,6 +254,41 @@ func (n *namespaceAccessView) ListTags() (list []string, err error) {
 	return list, err
 }
 
+// DeleteArtifact deletes an artifact, if supported by the
+// namespace implementation.
+func (n *namespaceAccessView) DeleteArtifact(digest digest.Digest) error {
+	return n.Execute(func() error {
+		if d, ok := n.impl.(internal.ArtifactDeleter); ok {
+			return d.DeleteArtifact(digest)
+		}
+		return errors.ErrNotSupported("artifact deletion")
+	})
+}
+
+// RemoveTags removes tags, if supported by the
+// namespace implementation.
+func (n *namespaceAccessView) RemoveTags(tags ...string) error {
+	return n.Execute(func() error {
+		if d, ok := n.impl.(internal.TagRemover); ok {
+			return d.RemoveTags(tags...)
+		}
+		return errors.ErrNotSupported("tag removal")
+	})
+}
+
+// Referrers lists the referrers of an artifact, if supported
+// by the namespace implementation.
+func (n *namespaceAccessView) Referrers(digest digest.Digest, artifactType string) (list []artdesc.Descriptor, err error) {
+	err = n.Execute(func() error {
+		if r, ok := n.impl.(internal.ReferrersLister); ok {
+			list, err = r.Referrers(digest, artifactType)
+			return err
+		}
+		return errors.ErrNotSupported("referrers")
+	})
+	return list, err
+}
+
 func (n *namespaceAccessView) NewArtifact(artifact ...*artdesc.Artifact) (acc internal.ArtifactAccess, err error) {
 	err = n.Execute(func() error {
 		acc, err = n.impl.NewArtifact(artifact...)
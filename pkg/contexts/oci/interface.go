@@ -41,6 +41,11 @@ type (
 	BlobAccess                       = internal.BlobAccess
 	DataAccess                       = internal.DataAccess
 	ConsumerIdentityProvider         = internal.ConsumerIdentityProvider
+	BlobGarbageCollector             = internal.BlobGarbageCollector
+	ArtifactDeleter                  = internal.ArtifactDeleter
+	TagRemover                       = internal.TagRemover
+	ReferrersLister                  = internal.ReferrersLister
+	BlobMounter                      = internal.BlobMounter
 )
 
 func DefaultContext() internal.Context {
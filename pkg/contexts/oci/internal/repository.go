@@ -37,6 +37,14 @@ type Repository interface {
 // to tell about their credential requests.
 type ConsumerIdentityProvider = credentials.ConsumerIdentityProvider
 
+// BlobGarbageCollector is an optional interface for repositories
+// able to remove blobs, which are not referenced by any artifact
+// anymore.
+type BlobGarbageCollector interface {
+	// CollectGarbage removes unreferenced blobs and returns their digests.
+	CollectGarbage() ([]digest.Digest, error)
+}
+
 type RepositorySource interface {
 	GetRepository() Repository
 }
@@ -74,9 +82,49 @@ type NamespaceAccessImpl interface {
 	HasArtifact(vers string) (bool, error)
 
 	NewArtifact(...*artdesc.Artifact) (ArtifactAccess, error)
+
 	io.Closer
 }
 
+// ArtifactDeleter is an optional interface for namespaces
+// supporting the deletion of artifacts.
+type ArtifactDeleter interface {
+	// DeleteArtifact removes the artifact with the given digest
+	// together with all tags referring to it from the namespace.
+	// Blobs used by the artifact are not touched.
+	DeleteArtifact(digest digest.Digest) error
+}
+
+// TagRemover is an optional interface for namespaces
+// supporting the removal of tags.
+type TagRemover interface {
+	// RemoveTags removes the given tags from the namespace.
+	// The tagged artifacts are kept (as far as supported
+	// by the repository implementation).
+	RemoveTags(tags ...string) error
+}
+
+// ReferrersLister is an optional interface for namespaces
+// supporting the query for referrers according to OCI 1.1.
+type ReferrersLister interface {
+	// Referrers returns the descriptors of all manifests in the
+	// namespace, which refer to the artifact with the given digest
+	// as subject. If an artifact type is given, only manifests
+	// with this artifact type are returned.
+	Referrers(digest digest.Digest, artifactType string) ([]artdesc.Descriptor, error)
+}
+
+// BlobMounter is an optional interface for namespaces able to
+// take over blobs from another namespace without copying the
+// blob content (for example by a cross-repository blob mount
+// in an OCI registry).
+type BlobMounter interface {
+	// MountBlob adds a blob available in the given source namespace.
+	// If the blob cannot be taken over from the source namespace,
+	// it is uploaded like with AddBlob.
+	MountBlob(src NamespaceAccess, blob BlobAccess) error
+}
+
 type NamespaceAccess interface {
 	resource.ResourceView[NamespaceAccess]
 
@@ -228,6 +228,92 @@ func (a *namespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return errors.ErrUnknown(cpi.KIND_OCIARTIFACT, digest.String())
 }
 
+func (a *namespaceContainer) DeleteArtifact(digest digest.Digest) error {
+	if a.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if a.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+
+	a.base.Lock()
+	defer a.base.Unlock()
+
+	idx := a.GetIndex()
+	var manifests []artdesc.Descriptor
+	for _, e := range idx.Manifests {
+		if e.Digest != digest {
+			manifests = append(manifests, e)
+		}
+	}
+	if len(manifests) == len(idx.Manifests) {
+		return errors.ErrUnknown(cpi.KIND_OCIARTIFACT, digest.String())
+	}
+	idx.Manifests = manifests
+	return nil
+}
+
+func (a *namespaceContainer) RemoveTags(tags ...string) error {
+	if a.IsClosed() {
+		return accessio.ErrClosed
+	}
+	if a.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+
+	a.base.Lock()
+	defer a.base.Unlock()
+
+	idx := a.GetIndex()
+	remove := map[string]bool{}
+	for _, t := range tags {
+		remove[t] = true
+	}
+	found := map[string]bool{}
+	for _, e := range idx.Manifests {
+		for _, t := range strings.Split(RetrieveTags(e.Annotations), ",") {
+			if remove[t] {
+				found[t] = true
+			}
+		}
+	}
+	var unknown []string
+	for _, t := range tags {
+		if !found[t] {
+			unknown = append(unknown, t)
+		}
+	}
+	if len(unknown) > 0 {
+		return errors.ErrUnknown(cpi.KIND_OCIARTIFACT, strings.Join(unknown, ", "))
+	}
+
+	for _, e := range idx.Manifests {
+		cur := RetrieveTags(e.Annotations)
+		if cur == "" {
+			continue
+		}
+		var keep []string
+		for _, t := range strings.Split(cur, ",") {
+			if !remove[t] {
+				keep = append(keep, t)
+			}
+		}
+		if len(keep) == 0 {
+			delete(e.Annotations, TAGS_ANNOTATION)
+		} else {
+			e.Annotations[TAGS_ANNOTATION] = strings.Join(keep, ",")
+		}
+		if t, ok := e.Annotations[OCITAG_ANNOTATION]; ok && remove[t] {
+			if len(keep) == 0 {
+				delete(e.Annotations, OCITAG_ANNOTATION)
+			} else {
+				e.Annotations[OCITAG_ANNOTATION] = keep[0]
+			}
+		}
+	}
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // forward
 
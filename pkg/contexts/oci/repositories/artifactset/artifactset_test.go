@@ -20,7 +20,9 @@ import (
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artifactset"
+	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/finalizer"
 	"github.com/open-component-model/ocm/pkg/mime"
 )
@@ -63,6 +65,27 @@ var _ = Describe("artifact management", func() {
 		Expect(vfs.FileExists(tempfs, "test/"+artifactset.OCILayouFileName)).To(Equal(desc == artifactset.OCIArtifactSetDescriptorFileName))
 	})
 
+	TestForAllFormats("removes tags", func(format string) {
+		opts, err := accessio.AccessOptions(&artifactset.Options{}, opts, artifactset.StructureFormat(format))
+		Expect(err).To(Succeed())
+
+		a, err := artifactset.FormatDirectory.Create("test", opts, 0700)
+		Expect(err).To(Succeed())
+		defer a.Close()
+
+		defaultManifestFill(a)
+		dig := a.GetIndex().Manifests[0].Digest
+		MustBeSuccessful(a.AddTags(dig, "v1", "v2"))
+
+		remover := a.NamespaceAccess.(oci.TagRemover)
+		Expect(errors.IsErrUnknownKind(remover.RemoveTags("v3"), oci.KIND_OCIARTIFACT)).To(BeTrue())
+		MustBeSuccessful(remover.RemoveTags("v1"))
+		Expect(a.ListTags()).To(ConsistOf("v2"))
+		MustBeSuccessful(remover.RemoveTags("v2"))
+		Expect(a.ListTags()).To(BeEmpty())
+		Expect(a.GetIndex().Manifests).To(HaveLen(1))
+	})
+
 	TestForAllFormats("instantiate filesystem artifact", func(format string) {
 		opts, err := accessio.AccessOptions(&artifactset.Options{}, opts, artifactset.StructureFormat(format))
 		Expect(err).To(Succeed())
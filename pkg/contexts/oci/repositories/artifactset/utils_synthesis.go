@@ -6,6 +6,7 @@ package artifactset
 
 import (
 	"fmt"
+	"strings"
 
 	. "github.com/open-component-model/ocm/pkg/finalizer"
 
@@ -174,3 +175,50 @@ func SynthesizeArtifactBlobFor(tag string, iter ArtifactIterator) (ArtifactBlob,
 		}
 	})
 }
+
+// FilterPlatforms rewrites an artifact set blob to contain only
+// the manifests of its main index artifact matching the given platforms.
+// The main artifact of the new set is the rewritten index. Other artifacts
+// of the original set are omitted, because they may refer to the original index.
+// If the main artifact is not an index, or if all manifests match,
+// nil is returned.
+func FilterPlatforms(blob blobaccess.BlobAccess, platforms ...artdesc.Platform) (ArtifactBlob, error) {
+	set, err := OpenFromBlob(accessobj.ACC_READONLY, blob)
+	if err != nil {
+		return nil, err
+	}
+	defer set.Close()
+
+	main := set.GetMain()
+	if main == "" {
+		return nil, nil
+	}
+	art, err := set.GetArtifact(main.String())
+	if err != nil {
+		return nil, err
+	}
+	defer art.Close()
+
+	if !art.IsIndex() {
+		return nil, nil
+	}
+	manifests := art.IndexAccess().GetDescriptor().Manifests
+	if len(artdesc.FilterPlatforms(manifests, platforms...)) == len(manifests) {
+		return nil, nil
+	}
+
+	var tags []string
+	if e := set.GetIndex().GetBlobDescriptor(main); e != nil {
+		if t := RetrieveTags(e.Annotations); t != "" {
+			tags = strings.Split(t, ",")
+		}
+	}
+	return SythesizeArtifactSet(func(set *ArtifactSet) (string, error) {
+		digest, err := transfer.TransferArtifactWithOptions(art, set, tags, transfer.WithPlatforms(platforms...))
+		if err != nil {
+			return "", fmt.Errorf("failed to transfer artifact: %w", err)
+		}
+		set.Annotate(MAINARTIFACT_ANNOTATION, digest.String())
+		return art.GetDescriptor().MimeType(), nil
+	})
+}
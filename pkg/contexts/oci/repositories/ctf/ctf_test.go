@@ -24,12 +24,14 @@ import (
 	"github.com/open-component-model/ocm/pkg/common/accessio/refmgmt"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artifactset"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/finalizer"
 	ocmlog "github.com/open-component-model/ocm/pkg/logging"
+	"github.com/open-component-model/ocm/pkg/mime"
 )
 
 var _ = Describe("ctf management", func() {
@@ -112,6 +114,74 @@ var _ = Describe("ctf management", func() {
 			"sha256."+DIGEST_LAYER))
 	})
 
+	It("removes tags and deletes artifacts", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		r := Must(ctf.FormatDirectory.Create(oci.DefaultContext(), "test", &spec.StandardOptions, 0700))
+		n := Must(r.LookupNamespace("mandelsoft/test"))
+		DefaultManifestFill(n)
+		dig := digest.Digest("sha256:" + DIGEST_MANIFEST)
+		MustBeSuccessful(n.AddTags(dig, "v2"))
+
+		err := n.(oci.TagRemover).RemoveTags(TAG, "unknown")
+		Expect(errors.IsErrNotFoundKind(err, cpi.KIND_OCIARTIFACT)).To(BeTrue())
+		MustBeSuccessful(n.(oci.TagRemover).RemoveTags(TAG))
+		MustBeSuccessful(n.Close())
+		MustBeSuccessful(r.Close())
+
+		r = Must(ctf.Open(oci.DefaultContext(), accessobj.ACC_WRITABLE, "test", 0o700, accessio.PathFileSystem(tempfs)))
+		finalize.Close(r, "repo")
+		n = Must(r.LookupNamespace("mandelsoft/test"))
+		finalize.Close(n, "namespace")
+		Expect(n.ListTags()).To(ConsistOf("v2"))
+
+		MustBeSuccessful(n.(oci.TagRemover).RemoveTags("v2"))
+		Expect(n.ListTags()).To(BeEmpty())
+		Expect(n.HasArtifact(dig.String())).To(BeTrue())
+
+		MustBeSuccessful(n.(oci.ArtifactDeleter).DeleteArtifact(dig))
+		Expect(n.HasArtifact(dig.String())).To(BeFalse())
+	})
+
+	It("lists referrers", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		r := Must(ctf.FormatDirectory.Create(oci.DefaultContext(), "test", &spec.StandardOptions, 0700))
+		finalize.Close(r, "repo")
+		n := Must(r.LookupNamespace("mandelsoft/test"))
+		finalize.Close(n, "namespace")
+		DefaultManifestFill(n)
+
+		subject := Must(n.GetArtifact(TAG))
+		finalize.Close(subject, "subject")
+		sblob := Must(subject.Blob())
+		finalize.Close(sblob, "subject blob")
+
+		art := Must(n.NewArtifact())
+		finalize.Close(art, "referrer")
+		Expect(art.AddLayer(blobaccess.ForString(mime.MIME_JSON, `{"sbom":true}`), nil)).To(Equal(0))
+		config := blobaccess.ForString(artdesc.MediaTypeEmptyJSON, "{}")
+		MustBeSuccessful(n.AddBlob(config))
+		m := Must(art.Manifest())
+		m.Config = *artdesc.DefaultBlobDescriptor(config)
+		m.ArtifactType = "application/spdx+json"
+		m.SetSubject(artdesc.DefaultBlobDescriptor(sblob))
+		blob := Must(n.AddArtifact(art))
+		finalize.Close(blob, "referrer blob")
+
+		lister := n.(oci.ReferrersLister)
+		Expect(lister.Referrers(sblob.Digest(), "")).To(Equal([]artdesc.Descriptor{{
+			MediaType:    artdesc.MediaTypeImageManifest,
+			Digest:       blob.Digest(),
+			Size:         blob.Size(),
+			ArtifactType: "application/spdx+json",
+		}}))
+		Expect(lister.Referrers(sblob.Digest(), "application/other")).To(BeEmpty())
+		Expect(lister.Referrers(blob.Digest(), "")).To(BeEmpty())
+	})
+
 	It("instantiate tgz artifact", func() {
 		ctf.FormatTGZ.ApplyOption(&spec.StandardOptions)
 		spec.FilePath = "test.tgz"
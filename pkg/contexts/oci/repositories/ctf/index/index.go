@@ -98,6 +98,107 @@ func (r *RepositoryIndex) addArtifactInfo(m ArtifactMeta) {
 	}
 }
 
+// DeleteArtifactInfo removes all entries for the given digest
+// from a repository. It reports whether an entry has been found.
+func (r *RepositoryIndex) DeleteArtifactInfo(repo string, digest digest.Digest) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	repos := r.byRepository[repo]
+	if repos == nil {
+		return false
+	}
+	found := false
+	for t, a := range repos {
+		if a.Digest == digest {
+			delete(repos, t)
+			found = true
+		}
+	}
+	if len(repos) == 0 {
+		delete(r.byRepository, repo)
+	}
+
+	var list []*ArtifactMeta
+	for _, e := range r.byDigest[digest] {
+		if e.Repository != repo {
+			list = append(list, e)
+		}
+	}
+	if len(list) == 0 {
+		delete(r.byDigest, digest)
+	} else {
+		r.byDigest[digest] = list
+	}
+	return found
+}
+
+// RemoveTags removes tags from a repository. The tagged artifacts
+// are kept as untagged entries, if there is no other tag left for them.
+// If one of the tags is not found, nothing is removed and the unknown
+// tags are returned.
+func (r *RepositoryIndex) RemoveTags(repo string, tags ...string) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	repos := r.byRepository[repo]
+	var unknown []string
+	for _, t := range tags {
+		if strings.HasPrefix(t, "@") || repos[t] == nil {
+			unknown = append(unknown, t)
+		}
+	}
+	if len(unknown) > 0 {
+		return unknown
+	}
+
+	for _, t := range tags {
+		m := repos[t]
+		if m == nil {
+			// duplicate tag
+			continue
+		}
+		delete(repos, t)
+
+		var list []*ArtifactMeta
+		for _, e := range r.byDigest[m.Digest] {
+			if e.Repository != repo || e.Tag != t {
+				list = append(list, e)
+			}
+		}
+
+		key := "@" + m.Digest.String()
+		var other *ArtifactMeta
+		for n, e := range repos {
+			if n != key && e.Digest == m.Digest {
+				other = e
+				break
+			}
+		}
+		if other == nil {
+			other = NewMeta(repo, "", m.Digest)
+			list = append(list, other)
+		}
+		if repos[key] == nil || repos[key].Tag == t {
+			repos[key] = other
+		}
+		r.byDigest[m.Digest] = list
+	}
+	return nil
+}
+
+// GetDigests returns the digests of all artifacts described by the index.
+func (r *RepositoryIndex) GetDigests() []digest.Digest {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result := make([]digest.Digest, 0, len(r.byDigest))
+	for d := range r.byDigest {
+		result = append(result, d)
+	}
+	return result
+}
+
 func (r *RepositoryIndex) HasArtifact(repo, tag string) bool {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
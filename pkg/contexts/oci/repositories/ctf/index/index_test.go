@@ -149,6 +149,52 @@ var _ = Describe("index", func() {
 				}))
 			})
 
+			It("delete shared entry", func() {
+				a1 := NewMeta("repo1", "v1", "digest1")
+				a2 := NewMeta("repo2", "v2", "digest1")
+				rindex.AddArtifactInfo(a1)
+				rindex.AddArtifactInfo(a2)
+
+				Expect(rindex.DeleteArtifactInfo("repo1", "digest1")).To(BeTrue())
+				Expect(rindex.DeleteArtifactInfo("repo1", "digest1")).To(BeFalse())
+
+				Expect(rindex.GetArtifactInfo("repo1", "digest1")).To(BeNil())
+				Expect(rindex.GetArtifactInfo("repo1", "v1")).To(BeNil())
+				Expect(rindex.GetArtifactInfo("repo2", "v2")).To(Equal(a2))
+
+				Expect(rindex.GetArtifactInfos("digest1")).To(ConsistOf(a2))
+				Expect(rindex.GetDescriptor().Index).To(Equal([]ArtifactMeta{
+					*a2,
+				}))
+			})
+
+			It("remove tags", func() {
+				a1 := NewMeta("repo1", "v1", "digest1")
+				a2 := NewMeta("repo1", "v2", "digest1")
+				a3 := NewMeta("repo2", "v1", "digest1")
+				rindex.AddArtifactInfo(a1)
+				rindex.AddArtifactInfo(a2)
+				rindex.AddArtifactInfo(a3)
+
+				Expect(rindex.RemoveTags("repo1", "v2", "v3")).To(Equal([]string{"v3"}))
+				Expect(rindex.GetArtifactInfo("repo1", "v2")).To(Equal(a2))
+
+				Expect(rindex.RemoveTags("repo1", "v2")).To(BeNil())
+				Expect(rindex.GetArtifactInfo("repo1", "v2")).To(BeNil())
+				Expect(rindex.GetArtifactInfo("repo1", "digest1")).To(Equal(a1))
+				Expect(rindex.GetArtifactInfos("digest1")).To(ConsistOf(a1, a3))
+
+				Expect(rindex.RemoveTags("repo1", "v1")).To(BeNil())
+				u := NewMeta("repo1", "", "digest1")
+				Expect(rindex.GetArtifactInfo("repo1", "v1")).To(BeNil())
+				Expect(rindex.GetArtifactInfo("repo1", "digest1")).To(Equal(u))
+				Expect(rindex.GetTags("repo1")).To(BeEmpty())
+				Expect(rindex.GetArtifactInfos("digest1")).To(ConsistOf(a3, u))
+				Expect(rindex.GetDescriptor().Index).To(Equal([]ArtifactMeta{
+					*u, *a3,
+				}))
+			})
+
 			It("shared entry without tag", func() {
 				a1 := NewMeta("repo1", "", "digest1")
 				a2 := NewMeta("repo2", "v2", "digest1")
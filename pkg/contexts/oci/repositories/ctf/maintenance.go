@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ctf
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf/index"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+// CleanupOptions control the maintenance of a transport archive.
+type CleanupOptions struct {
+	// DryRun only reports the found problems and unreferenced blobs,
+	// but does not modify the archive.
+	DryRun bool
+	// Verify recomputes the digests of all blobs to detect
+	// corrupted content.
+	Verify bool
+}
+
+// DanglingReference describes a blob referenced by an artifact,
+// which is not present in the archive.
+type DanglingReference struct {
+	// Artifact is the index entry the missing blob is found for.
+	Artifact string
+	Digest   digest.Digest
+}
+
+func (d DanglingReference) String() string {
+	return fmt.Sprintf("%s: missing blob %s", d.Artifact, d.Digest)
+}
+
+// CorruptBlob describes a blob whose content
+// does not match its digest, or an artifact
+// blob which cannot be parsed.
+type CorruptBlob struct {
+	Digest digest.Digest
+	Error  error
+}
+
+func (c CorruptBlob) String() string {
+	return fmt.Sprintf("%s: %s", c.Digest, c.Error)
+}
+
+// CleanupReport describes the result of a maintenance run.
+type CleanupReport struct {
+	// Unreferenced lists the blobs not reachable from any artifact
+	// in the index. They are removed if not running in dry-run mode.
+	Unreferenced []digest.Digest
+	// Dangling lists the references to missing blobs.
+	Dangling []DanglingReference
+	// Corrupt lists the blobs with inconsistent content.
+	Corrupt []CorruptBlob
+}
+
+// IsConsistent reports whether no dangling references or
+// corrupt blobs have been found.
+func (r *CleanupReport) IsConsistent() bool {
+	return len(r.Dangling) == 0 && len(r.Corrupt) == 0
+}
+
+// ErrInconsistent is returned by Cleanup and CollectGarbage if
+// the archive contains dangling references or corrupt blobs.
+var ErrInconsistent = errors.New("transport archive is inconsistent")
+
+// maxReferrerSize limits the size of unreferenced blobs checked for
+// being a referrer of a reachable artifact.
+const maxReferrerSize = 4 * 1024 * 1024
+
+// Cleanup walks all artifacts described by the index of the archive,
+// determines the set of reachable blobs and removes all other blobs.
+// Artifacts referring to a reachable artifact by their subject are kept, too.
+// Missing and (with option Verify) corrupted blobs are reported. If
+// such inconsistencies are found, nothing is removed, because the set
+// of reachable blobs cannot be determined reliably. In this case
+// the report is returned together with an error of kind ErrInconsistent.
+func (r *Repository) Cleanup(opts ...CleanupOptions) (*CleanupReport, error) {
+	if r.IsClosed() {
+		return nil, cpi.ErrClosed
+	}
+	return r.impl.Cleanup(utils.Optional(opts...))
+}
+
+// CollectGarbage removes all blobs, which are not referenced by any
+// artifact described by the repository index. It returns the
+// digests of the removed blobs. If the archive is inconsistent,
+// nothing is removed and an error is returned.
+func (r *Repository) CollectGarbage() ([]digest.Digest, error) {
+	if r.IsClosed() {
+		return nil, cpi.ErrClosed
+	}
+	return r.impl.CollectGarbage()
+}
+
+func (r *RepositoryImpl) CollectGarbage() ([]digest.Digest, error) {
+	report, err := r.Cleanup(CleanupOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return report.Unreferenced, nil
+}
+
+func (r *RepositoryImpl) Cleanup(opts CleanupOptions) (*CleanupReport, error) {
+	if !opts.DryRun && r.IsReadOnly() {
+		return nil, accessio.ErrReadOnly
+	}
+	r.base.Lock()
+	defer r.base.Unlock()
+
+	list, err := r.base.ListBlobs()
+	if err != nil {
+		return nil, err
+	}
+	blobs := map[digest.Digest]bool{}
+	for _, b := range list {
+		blobs[b] = true
+	}
+
+	report := &CleanupReport{}
+	w := &walker{
+		impl:    r,
+		blobs:   blobs,
+		used:    map[digest.Digest]bool{},
+		corrupt: map[digest.Digest]bool{},
+		report:  report,
+	}
+
+	entries := append([]index.ArtifactMeta{}, r.getIndex().GetDescriptor().Index...)
+	sort.Slice(entries, func(i, j int) bool { return artifactName(&entries[i]) < artifactName(&entries[j]) })
+	for i := range entries {
+		w.walk(artifactName(&entries[i]), entries[i].Digest)
+	}
+	w.referrers(list)
+
+	if opts.Verify {
+		for _, b := range list {
+			if w.corrupt[b] {
+				continue
+			}
+			if err := r.verifyBlob(b); err != nil {
+				report.Corrupt = append(report.Corrupt, CorruptBlob{Digest: b, Error: err})
+			}
+		}
+	}
+
+	for _, b := range list {
+		if !w.used[b] {
+			report.Unreferenced = append(report.Unreferenced, b)
+		}
+	}
+	if !report.IsConsistent() {
+		return report, errors.Wrapf(ErrInconsistent, "%d dangling reference(s) and %d corrupt blob(s) found", len(report.Dangling), len(report.Corrupt))
+	}
+	if !opts.DryRun {
+		for _, b := range report.Unreferenced {
+			if err := r.base.RemoveBlob(b); err != nil {
+				return report, err
+			}
+		}
+	}
+	return report, nil
+}
+
+func (r *RepositoryImpl) verifyBlob(d digest.Digest) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	_, acc, err := r.base.GetBlobData(d)
+	if err != nil {
+		return err
+	}
+	defer acc.Close()
+	reader, err := acc.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	actual, err := d.Algorithm().FromReader(reader)
+	if err != nil {
+		return err
+	}
+	if actual != d {
+		return errors.Newf("digest mismatch: found %s", actual)
+	}
+	return nil
+}
+
+func artifactName(m *index.ArtifactMeta) string {
+	if m.Tag != "" {
+		return m.Repository + ":" + m.Tag
+	}
+	return m.Repository + "@" + m.Digest.String()
+}
+
+type walker struct {
+	impl    *RepositoryImpl
+	blobs   map[digest.Digest]bool
+	used    map[digest.Digest]bool
+	corrupt map[digest.Digest]bool
+	report  *CleanupReport
+}
+
+// use marks a blob as used and reports whether it is available.
+func (w *walker) use(artifact string, d digest.Digest) bool {
+	if w.used[d] {
+		return w.blobs[d]
+	}
+	w.used[d] = true
+	if !w.blobs[d] {
+		w.report.Dangling = append(w.report.Dangling, DanglingReference{Artifact: artifact, Digest: d})
+		return false
+	}
+	return true
+}
+
+func (w *walker) walk(artifact string, d digest.Digest) {
+	if w.used[d] || !w.use(artifact, d) {
+		return
+	}
+	art, err := w.artifact(d)
+	if err != nil {
+		w.corrupt[d] = true
+		w.report.Corrupt = append(w.report.Corrupt, CorruptBlob{Digest: d, Error: err})
+		return
+	}
+	switch {
+	case art.IsManifest():
+		m := art.Manifest()
+		w.use(artifact, m.Config.Digest)
+		for _, l := range m.Layers {
+			w.use(artifact, l.Digest)
+		}
+		if m.Subject != nil {
+			w.walk(artifact, m.Subject.Digest)
+		}
+	case art.IsIndex():
+		for _, m := range art.Index().Manifests {
+			w.walk(artifact, m.Digest)
+		}
+	}
+}
+
+// referrers additionally walks all unreferenced manifests referring to
+// a reachable artifact by their subject, until no more referrers are found.
+func (w *walker) referrers(list []digest.Digest) {
+	checked := map[digest.Digest]bool{}
+	for found := true; found; {
+		found = false
+		for _, b := range list {
+			if w.used[b] || checked[b] {
+				continue
+			}
+			subject := w.subject(b)
+			if subject == "" {
+				checked[b] = true
+				continue
+			}
+			if w.used[subject] {
+				w.walk("referrer of "+subject.String(), b)
+				found = true
+			}
+		}
+	}
+}
+
+// subject provides the subject of the manifest described by the given blob.
+// If the blob is not a manifest with a subject, an empty digest is returned.
+func (w *walker) subject(d digest.Digest) digest.Digest {
+	size, acc, err := w.impl.base.GetBlobData(d)
+	if err != nil {
+		return ""
+	}
+	defer acc.Close()
+	if size > maxReferrerSize {
+		return ""
+	}
+	data, err := acc.Get()
+	if err != nil {
+		return ""
+	}
+	art, err := artdesc.Decode(data)
+	if err != nil || !art.IsManifest() || art.Manifest().Subject == nil {
+		return ""
+	}
+	return art.Manifest().Subject.Digest
+}
+
+func (w *walker) artifact(d digest.Digest) (*artdesc.Artifact, error) {
+	_, acc, err := w.impl.base.GetBlobData(d)
+	if err != nil {
+		return nil, err
+	}
+	defer acc.Close()
+	data, err := acc.Get()
+	if err != nil {
+		return nil, err
+	}
+	art, err := artdesc.Decode(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid artifact")
+	}
+	return art, nil
+}
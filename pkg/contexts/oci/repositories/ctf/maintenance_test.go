@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ctf_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/oci/testhelper"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const CTFPATH = "/tmp/ctf"
+
+var _ = Describe("ctf maintenance", func() {
+	var env *Builder
+	var desc *artdesc.Descriptor
+
+	BeforeEach(func() {
+		env = NewBuilder()
+		env.OCICommonTransport(CTFPATH, accessio.FormatDirectory, func() {
+			desc, _ = OCIManifest1For(env, OCINAMESPACE, OCIVERSION)
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	blobs := func() int {
+		return len(Must(vfs.ReadDir(env.FileSystem(), CTFPATH+"/"+ctf.BlobsDirectoryName)))
+	}
+
+	addBlob := func(ns oci.NamespaceAccess, blob blobaccess.BlobAccess) *artdesc.Descriptor {
+		MustBeSuccessful(ns.AddBlob(blob))
+		return artdesc.DefaultBlobDescriptor(blob)
+	}
+
+	It("keeps referrers and removes unreferenced blobs", func() {
+		repo := Must(ctf.Open(env, accessobj.ACC_WRITABLE, CTFPATH, 0o700, env))
+		defer Close(repo, "repo")
+		ns := Must(repo.LookupNamespace(OCINAMESPACE))
+		defer Close(ns, "namespace")
+
+		m := artdesc.NewManifest()
+		m.Config = *addBlob(ns, blobaccess.ForString(artdesc.MediaTypeEmptyJSON, "{}"))
+		m.Layers = append(m.Layers, *addBlob(ns, blobaccess.ForString(mime.MIME_TEXT, "signature")))
+		m.Subject = desc
+		data := Must(json.Marshal(m))
+		addBlob(ns, blobaccess.ForData(artdesc.MediaTypeImageManifest, data))
+		unused := addBlob(ns, blobaccess.ForString(mime.MIME_TEXT, "unused"))
+
+		count := blobs()
+		Expect(Must(repo.CollectGarbage())).To(ConsistOf(unused.Digest))
+		Expect(blobs()).To(Equal(count - 1))
+	})
+
+	It("removes nothing for inconsistent archive", func() {
+		MustBeSuccessful(vfs.WriteFile(env.FileSystem(), CTFPATH+"/"+ctf.BlobsDirectoryName+"/"+common.DigestToFileName(desc.Digest), []byte("garbage"), 0o600))
+
+		repo := Must(ctf.Open(env, accessobj.ACC_WRITABLE, CTFPATH, 0o700, env))
+		defer Close(repo, "repo")
+		ns := Must(repo.LookupNamespace(OCINAMESPACE))
+		defer Close(ns, "namespace")
+		addBlob(ns, blobaccess.ForString(mime.MIME_TEXT, "unused"))
+
+		count := blobs()
+		_, err := repo.CollectGarbage()
+		Expect(errors.Is(err, ctf.ErrInconsistent)).To(BeTrue())
+		Expect(blobs()).To(Equal(count))
+
+		report, err := repo.Cleanup()
+		Expect(errors.Is(err, ctf.ErrInconsistent)).To(BeTrue())
+		Expect(len(report.Corrupt)).To(Equal(1))
+		Expect(report.Corrupt[0].Digest).To(Equal(desc.Digest))
+		Expect(blobs()).To(Equal(count))
+	})
+})
@@ -5,6 +5,9 @@
 package ctf
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/opencontainers/go-digest"
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
@@ -97,6 +100,56 @@ func (n *namespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return n.repo.getIndex().AddTagsFor(n.impl.GetNamespace(), digest, tags...)
 }
 
+func (n *namespaceContainer) DeleteArtifact(digest digest.Digest) error {
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	if !n.repo.getIndex().DeleteArtifactInfo(n.impl.GetNamespace(), digest) {
+		return errors.ErrNotFound(cpi.KIND_OCIARTIFACT, digest.String(), n.impl.GetNamespace())
+	}
+	return nil
+}
+
+func (n *namespaceContainer) RemoveTags(tags ...string) error {
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	if unknown := n.repo.getIndex().RemoveTags(n.impl.GetNamespace(), tags...); len(unknown) > 0 {
+		return errors.ErrNotFound(cpi.KIND_OCIARTIFACT, strings.Join(unknown, ", "), n.impl.GetNamespace())
+	}
+	return nil
+}
+
+// Referrers evaluates the subjects of all artifacts of the namespace,
+// because the CTF index does not keep track of referrers.
+func (n *namespaceContainer) Referrers(dgst digest.Digest, artifactType string) ([]artdesc.Descriptor, error) {
+	var result []artdesc.Descriptor
+	for _, a := range n.repo.getIndex().GetArtifacts(n.impl.GetNamespace()) {
+		if !strings.HasPrefix(a, "@") {
+			continue
+		}
+		d := digest.Digest(a[1:])
+		_, acc, err := n.repo.base.GetBlobData(d)
+		if err != nil {
+			return nil, errors.Wrapf(err, "artifact %s", d)
+		}
+		data, err := acc.Get()
+		acc.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "artifact %s", d)
+		}
+		art, err := artdesc.Decode(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "artifact %s", d)
+		}
+		if s := art.Subject(); s != nil && s.Digest == dgst {
+			result = append(result, *artdesc.ReferrerDescriptor(art, d, int64(len(data))))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Digest < result[j].Digest })
+	return artdesc.FilterReferrers(result, artifactType), nil
+}
+
 func (n *namespaceContainer) NewArtifact(i support.NamespaceAccessImpl, art ...*artdesc.Artifact) (cpi.ArtifactAccess, error) {
 	if n.IsReadOnly() {
 		return nil, accessio.ErrReadOnly
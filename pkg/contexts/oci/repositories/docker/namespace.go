@@ -12,6 +12,7 @@ import (
 	"github.com/containers/image/v5/image"
 	"github.com/containers/image/v5/types"
 	dockertypes "github.com/docker/docker/api/types"
+	dockerclient "github.com/docker/docker/client"
 	"github.com/mandelsoft/logging"
 	"github.com/opencontainers/go-digest"
 
@@ -265,6 +266,81 @@ func (n *namespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return nil
 }
 
+// DeleteArtifact removes an image from the docker daemon together
+// with all its tags. The digest may either be the image id or the
+// digest of the manifest provided for an image of the namespace.
+func (n *namespaceContainer) DeleteArtifact(digest digest.Digest) error {
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	id, err := n.imageId(digest)
+	if err != nil {
+		return err
+	}
+	n.log.Debug("deleting image", "namespace", n.impl.GetNamespace(), "id", id)
+	_, err = n.repo.client.ImageRemove(dummyContext, id, dockertypes.ImageRemoveOptions{Force: true})
+	if err != nil {
+		if dockerclient.IsErrNotFound(err) {
+			return errors.ErrNotFound(cpi.KIND_OCIARTIFACT, digest.String(), n.impl.GetNamespace())
+		}
+		return fmt.Errorf("failed to remove image: %w", err)
+	}
+	return nil
+}
+
+// imageId maps a digest to the id of a docker image.
+func (n *namespaceContainer) imageId(dig digest.Digest) (string, error) {
+	list, err := n.repo.client.ImageList(dummyContext, dockertypes.ImageListOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, e := range list {
+		if e.ID == dig.String() {
+			return e.ID, nil
+		}
+	}
+	if n.impl.GetNamespace() != "" {
+		tags, err := n.ListTags()
+		if err != nil {
+			return "", err
+		}
+		for _, t := range tags {
+			art, err := n.GetArtifact(n.impl, t)
+			if err != nil {
+				continue
+			}
+			d, id := art.Digest(), ImageId(art)
+			art.Close()
+			if d == dig {
+				return id.String(), nil
+			}
+		}
+	}
+	return "", errors.ErrNotFound(cpi.KIND_OCIARTIFACT, dig.String(), n.impl.GetNamespace())
+}
+
+// RemoveTags removes tags from images. The docker daemon deletes an image
+// together with its last tag, if it is not used by a container.
+func (n *namespaceContainer) RemoveTags(tags ...string) error {
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	if n.impl.GetNamespace() == "" {
+		return errors.ErrNotSupported("tag removal for untagged images")
+	}
+	for _, tag := range tags {
+		ref := n.impl.GetNamespace() + ":" + tag
+		_, err := n.repo.client.ImageRemove(dummyContext, ref, dockertypes.ImageRemoveOptions{})
+		if err != nil {
+			if dockerclient.IsErrNotFound(err) {
+				return errors.ErrNotFound(cpi.KIND_OCIARTIFACT, tag, n.impl.GetNamespace())
+			}
+			return fmt.Errorf("failed to remove image tag: %w", err)
+		}
+	}
+	return nil
+}
+
 func (n *namespaceContainer) NewArtifact(i support.NamespaceAccessImpl, art ...*artdesc.Artifact) (cpi.ArtifactAccess, error) {
 	if n.IsReadOnly() {
 		return nil, accessio.ErrReadOnly
@@ -18,20 +18,22 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi/support"
+	"github.com/open-component-model/ocm/pkg/docker"
 	"github.com/open-component-model/ocm/pkg/docker/resolve"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/logging"
 )
 
 type NamespaceContainer struct {
-	impl     support.NamespaceAccessImpl
-	repo     *RepositoryImpl
-	resolver resolve.Resolver
-	lister   resolve.Lister
-	fetcher  resolve.Fetcher
-	pusher   resolve.Pusher
-	blobs    *BlobContainers
-	checked  bool
+	impl      support.NamespaceAccessImpl
+	repo      *RepositoryImpl
+	resolver  resolve.Resolver
+	lister    resolve.Lister
+	referrers resolve.Referrers
+	fetcher   resolve.Fetcher
+	pusher    resolve.Pusher
+	blobs     *BlobContainers
+	checked   bool
 }
 
 var _ support.NamespaceContainer = (*NamespaceContainer)(nil)
@@ -54,13 +56,18 @@ func NewNamespace(repo *RepositoryImpl, name string) (cpi.NamespaceAccess, error
 	if err != nil {
 		return nil, err
 	}
+	referrers, err := resolver.Referrers(context.Background(), ref)
+	if err != nil {
+		return nil, err
+	}
 	c := &NamespaceContainer{
-		repo:     repo,
-		resolver: resolver,
-		lister:   lister,
-		fetcher:  fetcher,
-		pusher:   pusher,
-		blobs:    NewBlobContainers(repo.GetContext(), fetcher, pusher),
+		repo:      repo,
+		resolver:  resolver,
+		lister:    lister,
+		referrers: referrers,
+		fetcher:   fetcher,
+		pusher:    pusher,
+		blobs:     NewBlobContainers(repo.GetContext(), fetcher, pusher),
 	}
 	return support.NewNamespaceAccess(name, c, repo)
 }
@@ -143,6 +150,60 @@ func (n *NamespaceContainer) AddBlob(blob cpi.BlobAccess) error {
 	return nil
 }
 
+// MountBlob adds a blob available in another namespace. If the source
+// namespace is hosted by the same registry, a cross-repository blob mount
+// is requested. If the registry refuses the mount, the blob is uploaded.
+func (n *NamespaceContainer) MountBlob(src cpi.NamespaceAccess, blob cpi.BlobAccess) error {
+	from := n.mountSource(src)
+	if from == "" {
+		return n.AddBlob(blob)
+	}
+	log := n.repo.GetContext().Logger()
+	log.Debug("mounting blob", "digest", blob.Digest(), "from", from)
+	key, value, err := docker.DistributionSourceAnnotation(from)
+	if err != nil {
+		return err
+	}
+	err = n.assureCreated()
+	if err != nil {
+		return err
+	}
+	desc := *artdesc.DefaultBlobDescriptor(blob)
+	desc.Annotations = map[string]string{key: value}
+	if err := pushData(dummyContext, n.pusher, desc, blob); err != nil {
+		log.Debug("mounting blob failed", "digest", blob.Digest(), "error", err.Error())
+		return fmt.Errorf("unable to add blob (OCI repository %s): %w", n.impl.GetNamespace(), err)
+	}
+	log.Debug("mounting blob done", "digest", blob.Digest())
+	return nil
+}
+
+// mountSource provides the locator of the source namespace usable
+// for a cross-repository blob mount. It is empty, if the source
+// namespace is not hosted by the same registry.
+func (n *NamespaceContainer) mountSource(src cpi.NamespaceAccess) string {
+	i, err := cpi.GetNamespaceAccessImplementation(src)
+	if err != nil {
+		return ""
+	}
+	c, err := support.GetArtifactSetContainer(i)
+	if err != nil {
+		return ""
+	}
+	s, ok := c.(*NamespaceContainer)
+	if !ok {
+		return ""
+	}
+	if s.repo.info.Scheme != n.repo.info.Scheme || s.repo.info.HostPort() != n.repo.info.HostPort() {
+		return ""
+	}
+	from := s.repo.GetRef(s.impl.GetNamespace(), "")
+	if from == n.repo.GetRef(n.impl.GetNamespace(), "") {
+		return ""
+	}
+	return from
+}
+
 func (n *NamespaceContainer) ListTags() ([]string, error) {
 	return n.lister.List(dummyContext)
 }
@@ -231,6 +292,13 @@ func (n *NamespaceContainer) AddArtifact(artifact cpi.Artifact, tags ...string)
 		}
 	}
 
+	if subject := artifact.Artifact().Subject(); subject != nil {
+		d := artdesc.ReferrerDescriptor(artifact.Artifact(), blob.Digest(), blob.Size())
+		d.MediaType = blob.MimeType()
+		if err := n.addReferrer(subject.Digest, d); err != nil {
+			return nil, errors.Wrapf(err, "cannot register referrer for %s", subject.Digest)
+		}
+	}
 	return blob, err
 }
 
@@ -256,6 +324,130 @@ func (n *NamespaceContainer) AddTags(digest digest.Digest, tags ...string) error
 	return nil
 }
 
+func (n *NamespaceContainer) DeleteArtifact(digest digest.Digest) error {
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	deleter, err := n.resolver.Deleter(context.Background(), n.repo.GetRef(n.impl.GetNamespace(), ""))
+	if err != nil {
+		return err
+	}
+	n.repo.GetContext().Logger().Debug("deleting artifact", "namespace", n.impl.GetNamespace(), "digest", digest)
+	err = deleter.Delete(context.Background(), digest)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return errors.ErrNotFound(cpi.KIND_OCIARTIFACT, digest.String(), n.impl.GetNamespace())
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoveTags removes tags using the tag deletion of the distribution API.
+// This is an optional feature of the API, which is not supported
+// by all registries.
+func (n *NamespaceContainer) RemoveTags(tags ...string) error {
+	if n.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	deleter, err := n.resolver.Deleter(context.Background(), n.repo.GetRef(n.impl.GetNamespace(), ""))
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		n.repo.GetContext().Logger().Debug("removing tag", "namespace", n.impl.GetNamespace(), "tag", tag)
+		err = deleter.Untag(context.Background(), tag)
+		if err != nil {
+			switch {
+			case errdefs.IsNotFound(err):
+				return errors.ErrNotFound(cpi.KIND_OCIARTIFACT, tag, n.impl.GetNamespace())
+			case errdefs.IsNotImplemented(err):
+				return errors.ErrNotSupported("tag removal", n.repo.info.HostPort())
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Referrers lists the referrers of an artifact using the referrers API.
+// If the registry does not support this API, the referrers tag schema
+// is used.
+func (n *NamespaceContainer) Referrers(dgst digest.Digest, artifactType string) ([]artdesc.Descriptor, error) {
+	n.repo.GetContext().Logger().Debug("listing referrers", "namespace", n.impl.GetNamespace(), "digest", dgst)
+	list, err := n.referrers.List(dummyContext, dgst, artifactType)
+	if err == nil {
+		return list, nil
+	}
+	if !errdefs.IsNotImplemented(err) {
+		return nil, err
+	}
+	n.repo.GetContext().Logger().Debug("referrers API not supported, using tag schema", "namespace", n.impl.GetNamespace(), "digest", dgst)
+	index, err := n.getReferrersIndex(dgst)
+	if err != nil || index == nil {
+		return nil, err
+	}
+	return artdesc.FilterReferrers(index.Manifests, artifactType), nil
+}
+
+// getReferrersIndex provides the referrers index stored for a subject
+// according to the referrers tag schema. If there is none, nil is returned.
+func (n *NamespaceContainer) getReferrersIndex(subject digest.Digest) (*artdesc.Index, error) {
+	ref := n.repo.GetRef(n.impl.GetNamespace(), artdesc.ReferrersTag(subject))
+	_, desc, err := n.resolver.Resolve(context.Background(), ref)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	acc, err := NewDataAccess(n.fetcher, desc.Digest, desc.MediaType, false)
+	if err != nil {
+		return nil, err
+	}
+	defer acc.Close()
+	data, err := acc.Get()
+	if err != nil {
+		return nil, err
+	}
+	index, err := artdesc.DecodeIndex(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid referrers index %s", ref)
+	}
+	return index, nil
+}
+
+// addReferrer maintains the referrers tag schema for a new referrer,
+// if the registry does not support the referrers API.
+func (n *NamespaceContainer) addReferrer(subject digest.Digest, d *artdesc.Descriptor) error {
+	_, err := n.referrers.List(dummyContext, subject, "")
+	if err == nil {
+		return nil
+	}
+	if !errdefs.IsNotImplemented(err) {
+		return err
+	}
+	index, err := n.getReferrersIndex(subject)
+	if err != nil {
+		return err
+	}
+	if index == nil {
+		index = artdesc.NewIndex()
+	}
+	for _, e := range index.Manifests {
+		if e.Digest == d.Digest {
+			return nil
+		}
+	}
+	index.AddManifest(d)
+	blob, err := index.ToBlobAccess()
+	if err != nil {
+		return err
+	}
+	n.repo.GetContext().Logger().Debug("updating referrers tag", "namespace", n.impl.GetNamespace(), "subject", subject, "referrer", d.Digest)
+	return n.push(artdesc.ReferrersTag(subject), blob)
+}
+
 func (n *NamespaceContainer) NewArtifact(i support.NamespaceAccessImpl, art ...*artdesc.Artifact) (cpi.ArtifactAccess, error) {
 	if n.IsReadOnly() {
 		return nil, accessio.ErrReadOnly
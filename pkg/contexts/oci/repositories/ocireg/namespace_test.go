@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocireg_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ocireg"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/finalizer"
+)
+
+var _ = Describe("artifact and tag deletion", func() {
+	var server *httptest.Server
+	var manifests map[string]bool
+	var requests []string
+
+	present := digest.FromString("present")
+	absent := digest.FromString("absent")
+
+	BeforeEach(func() {
+		manifests = map[string]bool{present.String(): true}
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			if r.Method != http.MethodDelete {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			d := r.URL.Path[len("/v2/test/repo/manifests/"):]
+			if d == "unsupported" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if !manifests[d] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(manifests, d)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("deletes artifact", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		repo := Must(oci.DefaultContext().RepositoryForSpec(ocireg.NewRepositorySpec(server.URL)))
+		finalize.Close(repo, "repo")
+		ns := Must(repo.LookupNamespace("test/repo"))
+		finalize.Close(ns, "namespace")
+
+		deleter, ok := ns.(oci.ArtifactDeleter)
+		Expect(ok).To(BeTrue())
+
+		MustBeSuccessful(deleter.DeleteArtifact(present))
+		Expect(manifests).To(BeEmpty())
+		Expect(requests).To(ContainElement("DELETE /v2/test/repo/manifests/" + present.String()))
+
+		err := deleter.DeleteArtifact(absent)
+		Expect(errors.IsErrNotFoundKind(err, oci.KIND_OCIARTIFACT)).To(BeTrue())
+	})
+
+	It("removes tags", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		repo := Must(oci.DefaultContext().RepositoryForSpec(ocireg.NewRepositorySpec(server.URL)))
+		finalize.Close(repo, "repo")
+		ns := Must(repo.LookupNamespace("test/repo"))
+		finalize.Close(ns, "namespace")
+
+		remover, ok := ns.(oci.TagRemover)
+		Expect(ok).To(BeTrue())
+
+		manifests["v1"] = true
+		MustBeSuccessful(remover.RemoveTags("v1"))
+		Expect(manifests).To(HaveLen(1))
+		Expect(requests).To(ContainElement("DELETE /v2/test/repo/manifests/v1"))
+
+		err := remover.RemoveTags("v1")
+		Expect(errors.IsErrNotFoundKind(err, oci.KIND_OCIARTIFACT)).To(BeTrue())
+
+		err = remover.RemoveTags("unsupported")
+		Expect(errors.IsErrNotSupported(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocireg_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ocireg"
+	"github.com/open-component-model/ocm/pkg/finalizer"
+)
+
+type manifest struct {
+	mediaType string
+	data      []byte
+}
+
+var _ = Describe("referrers", func() {
+	var server *httptest.Server
+	var manifests map[string]*manifest
+	var referrers map[string][]artdesc.Descriptor
+	var supported bool
+
+	subject := &artdesc.Descriptor{
+		MediaType: artdesc.MediaTypeImageManifest,
+		Digest:    digest.FromString("subject"),
+		Size:      7,
+	}
+
+	BeforeEach(func() {
+		manifests = map[string]*manifest{}
+		referrers = map[string][]artdesc.Descriptor{}
+		supported = true
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, "/v2/test/repo/")
+			switch {
+			case strings.HasPrefix(path, "referrers/"):
+				if !supported {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				index := artdesc.NewIndex()
+				index.Manifests = referrers[strings.TrimPrefix(path, "referrers/")]
+				data, _ := json.Marshal(index)
+				w.Header().Set("Content-Type", artdesc.MediaTypeImageIndex)
+				w.Write(data)
+			case strings.HasPrefix(path, "manifests/"):
+				ref := strings.TrimPrefix(path, "manifests/")
+				switch r.Method {
+				case http.MethodPut:
+					data, _ := io.ReadAll(r.Body)
+					m := &manifest{r.Header.Get("Content-Type"), data}
+					dig := digest.FromBytes(data)
+					manifests[ref] = m
+					manifests[dig.String()] = m
+					if supported {
+						if art, err := artdesc.Decode(data); err == nil && art.Subject() != nil {
+							s := art.Subject().Digest.String()
+							referrers[s] = append(referrers[s], *artdesc.ReferrerDescriptor(art, dig, int64(len(data))))
+						}
+					}
+					w.Header().Set("Docker-Content-Digest", dig.String())
+					w.WriteHeader(http.StatusCreated)
+				default:
+					m := manifests[ref]
+					if m == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.Header().Set("Content-Type", m.mediaType)
+					w.Header().Set("Docker-Content-Digest", digest.FromBytes(m.data).String())
+					w.Header().Set("Content-Length", fmt.Sprintf("%d", len(m.data)))
+					if r.Method == http.MethodGet {
+						w.Write(m.data)
+					}
+				}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	addReferrer := func(ns oci.NamespaceAccess, artifactType string, finalize *finalizer.Finalizer) blobaccess.BlobAccess {
+		m := artdesc.NewManifest()
+		m.ArtifactType = artifactType
+		m.Config = *artdesc.DefaultBlobDescriptor(blobaccess.ForString(artdesc.MediaTypeEmptyJSON, "{}"))
+		m.SetSubject(subject)
+		desc := artdesc.New()
+		MustBeSuccessful(desc.SetManifest(m))
+		art := Must(ns.NewArtifact(desc))
+		finalize.Close(art, "referrer")
+		blob := Must(ns.AddArtifact(art))
+		finalize.Close(blob, "referrer blob")
+		return blob
+	}
+
+	lookup := func(finalize *finalizer.Finalizer) oci.NamespaceAccess {
+		repo := Must(oci.DefaultContext().RepositoryForSpec(ocireg.NewRepositorySpec(server.URL)))
+		finalize.Close(repo, "repo")
+		ns := Must(repo.LookupNamespace("test/repo"))
+		finalize.Close(ns, "namespace")
+		return ns
+	}
+
+	It("uses referrers API", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		ns := lookup(&finalize)
+		sbom := addReferrer(ns, "application/spdx+json", &finalize)
+		sig := addReferrer(ns, "application/signature", &finalize)
+		Expect(manifests).NotTo(HaveKey(artdesc.ReferrersTag(subject.Digest)))
+
+		lister := ns.(oci.ReferrersLister)
+		list := Must(lister.Referrers(subject.Digest, ""))
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].Digest).To(Equal(sbom.Digest()))
+		Expect(list[1].Digest).To(Equal(sig.Digest()))
+
+		list = Must(lister.Referrers(subject.Digest, "application/signature"))
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Digest).To(Equal(sig.Digest()))
+	})
+
+	It("uses referrers tag schema", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		supported = false
+		ns := lookup(&finalize)
+		lister := ns.(oci.ReferrersLister)
+		Expect(lister.Referrers(subject.Digest, "")).To(BeEmpty())
+
+		sbom := addReferrer(ns, "application/spdx+json", &finalize)
+		sig := addReferrer(ns, "application/signature", &finalize)
+		Expect(manifests).To(HaveKey(artdesc.ReferrersTag(subject.Digest)))
+
+		list := Must(lister.Referrers(subject.Digest, ""))
+		Expect(list).To(Equal([]artdesc.Descriptor{
+			{MediaType: artdesc.MediaTypeImageManifest, Digest: sbom.Digest(), Size: sbom.Size(), ArtifactType: "application/spdx+json"},
+			{MediaType: artdesc.MediaTypeImageManifest, Digest: sig.Digest(), Size: sig.Size(), ArtifactType: "application/signature"},
+		}))
+
+		list = Must(lister.Referrers(subject.Digest, "application/spdx+json"))
+		Expect(list).To(HaveLen(1))
+		Expect(list[0].Digest).To(Equal(sbom.Digest()))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"crypto/x509"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/remotes/docker/config"
@@ -124,9 +125,28 @@ func (r *RepositoryImpl) getResolver(comp string) (resolve.Resolver, error) {
 		logger.Trace("no credentials")
 	}
 
+	// resolvers may be used by long-running operations, therefore
+	// expiring credentials are re-acquired on demand.
+	var lock sync.Mutex
+	current := func() credentials.Credentials {
+		lock.Lock()
+		defer lock.Unlock()
+		if creds != nil && r.info.Creds == nil && credentials.IsExpired(creds, credentials.EXPIRATION_MARGIN) {
+			logger.Trace("credentials expired, re-acquire")
+			n, err := r.getCreds(comp)
+			if err != nil {
+				logger.Error("cannot re-acquire credentials", "error", err.Error())
+			} else if n != nil {
+				creds = n
+			}
+		}
+		return creds
+	}
+
 	opts := docker.ResolverOptions{
 		Hosts: docker.ConvertHosts(config.ConfigureHosts(context.Background(), config.HostOptions{
 			Credentials: func(host string) (string, string, error) {
+				creds := current()
 				if creds != nil {
 					p := creds.GetProperty(credentials.ATTR_IDENTITY_TOKEN)
 					if p == "" {
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"encoding/json"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// filteredIndex is an index artifact based on an original index
+// with a reduced list of manifests.
+type filteredIndex struct {
+	desc *artdesc.Artifact
+	mime string
+	data []byte
+}
+
+var _ cpi.Artifact = (*filteredIndex)(nil)
+
+func newFilteredIndex(orig *artdesc.Index, manifests []artdesc.Descriptor) (*filteredIndex, error) {
+	index := *orig
+	index.Manifests = manifests
+	index.MediaType = index.MimeType()
+
+	desc := artdesc.New()
+	err := desc.SetIndex(&index)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(desc)
+	if err != nil {
+		return nil, err
+	}
+	return &filteredIndex{
+		desc: desc,
+		mime: index.MediaType,
+		data: data,
+	}, nil
+}
+
+func (i *filteredIndex) IsManifest() bool {
+	return false
+}
+
+func (i *filteredIndex) IsIndex() bool {
+	return true
+}
+
+func (i *filteredIndex) Digest() digest.Digest {
+	return digest.FromBytes(i.data)
+}
+
+func (i *filteredIndex) Blob() (cpi.BlobAccess, error) {
+	return blobaccess.ForData(i.mime, i.data), nil
+}
+
+func (i *filteredIndex) Artifact() *artdesc.Artifact {
+	return i.desc
+}
+
+func (i *filteredIndex) Manifest() (*artdesc.Manifest, error) {
+	return nil, errors.ErrInvalid("artifact type")
+}
+
+func (i *filteredIndex) Index() (*artdesc.Index, error) {
+	return i.desc.Index(), nil
+}
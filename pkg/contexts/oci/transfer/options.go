@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
+	"github.com/open-component-model/ocm/pkg/optionutils"
+)
+
+type Option = optionutils.Option[*Options]
+
+type Options struct {
+	// Source is the namespace hosting the transferred artifacts.
+	// If the target namespace supports it (see cpi.BlobMounter),
+	// blobs are taken over from this namespace instead of being
+	// copied.
+	Source cpi.NamespaceAccess
+	// ReferrersSource is the namespace used to look up the referrers
+	// (OCI 1.1) of the transferred artifacts. If set, the referrers
+	// are transferred together with the artifacts.
+	ReferrersSource cpi.NamespaceAccess
+	// Platforms restricts the manifests of transferred index
+	// artifacts to the given platforms. If an index is filtered,
+	// a rewritten index with a new digest is stored instead
+	// of the original one.
+	Platforms []artdesc.Platform
+}
+
+func (o *Options) ApplyTo(opts *Options) {
+	if opts == nil {
+		return
+	}
+	if o.Source != nil {
+		opts.Source = o.Source
+	}
+	if o.ReferrersSource != nil {
+		opts.ReferrersSource = o.ReferrersSource
+	}
+	if len(o.Platforms) > 0 {
+		opts.Platforms = o.Platforms
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type source struct {
+	ns cpi.NamespaceAccess
+}
+
+func (o *source) ApplyTo(opts *Options) {
+	opts.Source = o.ns
+}
+
+// WithSource provides the namespace hosting the transferred
+// artifacts. It is used to mount blobs instead of copying them,
+// if the source and target namespaces are hosted by the same registry.
+func WithSource(ns cpi.NamespaceAccess) Option {
+	return &source{ns}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type referrers struct {
+	source cpi.NamespaceAccess
+}
+
+func (o *referrers) ApplyTo(opts *Options) {
+	opts.ReferrersSource = o.source
+}
+
+// WithReferrers requests to transfer the referrers of the
+// transferred artifacts found in the given source namespace.
+func WithReferrers(source cpi.NamespaceAccess) Option {
+	return &referrers{source}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type platforms []artdesc.Platform
+
+func (o platforms) ApplyTo(opts *Options) {
+	opts.Platforms = o
+}
+
+// WithPlatforms restricts the manifests of transferred
+// index artifacts to the given platforms.
+func WithPlatforms(list ...artdesc.Platform) Option {
+	return platforms(list)
+}
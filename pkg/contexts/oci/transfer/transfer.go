@@ -5,21 +5,55 @@
 package transfer
 
 import (
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/cpi"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/finalizer"
 	"github.com/open-component-model/ocm/pkg/logging"
+	"github.com/open-component-model/ocm/pkg/optionutils"
 )
 
 func TransferArtifact(art cpi.ArtifactAccess, set cpi.ArtifactSink, tags ...string) error {
+	_, err := transferArtifact(art, set, &Options{}, tags...)
+	return err
+}
+
+// TransferArtifactWithOptions transfers an artifact according to the given
+// options. It returns the digest of the artifact stored in the target, which
+// differs from the original one, if an index has been rewritten
+// by a platform filter.
+func TransferArtifactWithOptions(art cpi.ArtifactAccess, set cpi.ArtifactSink, tags []string, opts ...Option) (digest.Digest, error) {
+	return transferArtifact(art, set, optionutils.EvalOptions(opts...), tags...)
+}
+
+func transferArtifact(art cpi.ArtifactAccess, set cpi.ArtifactSink, opts *Options, tags ...string) (digest.Digest, error) {
+	var err error
+	dig := art.Digest()
 	if art.GetDescriptor().IsIndex() {
-		return TransferIndex(art.IndexAccess(), set, tags...)
+		dig, err = transferIndex(art.IndexAccess(), set, opts, tags...)
 	} else {
-		return TransferManifest(art.ManifestAccess(), set, tags...)
+		err = transferManifest(art.ManifestAccess(), set, opts, tags...)
 	}
+	if err != nil {
+		return "", err
+	}
+	if dig != art.Digest() {
+		// referrers of the original index do not refer to the rewritten one.
+		return dig, nil
+	}
+	return dig, transferReferrers(dig, set, opts)
 }
 
 func TransferIndex(art cpi.IndexAccess, set cpi.ArtifactSink, tags ...string) (err error) {
+	_, err = transferIndex(art, set, &Options{}, tags...)
+	return err
+}
+
+func transferIndex(art cpi.IndexAccess, set cpi.ArtifactSink, opts *Options, tags ...string) (dig digest.Digest, err error) {
 	logging.Logger().Debug("transfer OCI index", "digest", art.Digest())
 	defer func() {
 		logging.Logger().Debug("transfer OCI index done", "error", logging.ErrorMessage(err))
@@ -28,31 +62,59 @@ func TransferIndex(art cpi.IndexAccess, set cpi.ArtifactSink, tags ...string) (e
 	var finalize finalizer.Finalizer
 	defer finalize.FinalizeWithErrorPropagation(&err)
 
-	for _, l := range art.GetDescriptor().Manifests {
+	desc := art.GetDescriptor()
+	manifests := artdesc.FilterPlatforms(desc.Manifests, opts.Platforms...)
+	if len(manifests) != len(desc.Manifests) && !hasPlatform(manifests) {
+		return "", errors.Newf("no manifest of index %s matches the platforms %s", art.Digest(), strings.Join(artdesc.PlatformStrings(opts.Platforms...), ", "))
+	}
+
+	for _, l := range manifests {
 		loop := finalize.Nested()
 		logging.Logger().Debug("indexed manifest", "digest", "digest", l.Digest, "size", l.Size)
 		art, err := art.GetArtifact(l.Digest)
 		if err != nil {
-			return errors.Wrapf(err, "getting indexed artifact %s", l.Digest)
+			return "", errors.Wrapf(err, "getting indexed artifact %s", l.Digest)
 		}
 		loop.Close(art)
-		err = TransferArtifact(art, set)
+		_, err = transferArtifact(art, set, opts)
 		if err != nil {
-			return errors.Wrapf(err, "transferring indexed artifact %s", l.Digest)
+			return "", errors.Wrapf(err, "transferring indexed artifact %s", l.Digest)
 		}
 		err = loop.Finalize()
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
-	_, err = set.AddArtifact(art, tags...)
+
+	var index cpi.Artifact = art
+	if len(manifests) != len(desc.Manifests) {
+		logging.Logger().Debug("rewrite OCI index", "digest", art.Digest(), "manifests", len(manifests))
+		index, err = newFilteredIndex(desc, manifests)
+		if err != nil {
+			return "", errors.Wrapf(err, "rewriting index artifact")
+		}
+	}
+	_, err = set.AddArtifact(index, tags...)
 	if err != nil {
-		return errors.Wrapf(err, "transferring index artifact")
+		return "", errors.Wrapf(err, "transferring index artifact")
 	}
-	return err
+	return index.Digest(), err
+}
+
+func hasPlatform(list []artdesc.Descriptor) bool {
+	for _, d := range list {
+		if d.Platform != nil {
+			return true
+		}
+	}
+	return false
 }
 
 func TransferManifest(art cpi.ManifestAccess, set cpi.ArtifactSink, tags ...string) (err error) {
+	return transferManifest(art, set, &Options{}, tags...)
+}
+
+func transferManifest(art cpi.ManifestAccess, set cpi.ArtifactSink, opts *Options, tags ...string) (err error) {
 	logging.Logger().Debug("transfer OCI manifest", "digest", art.Digest())
 	defer func() {
 		logging.Logger().Debug("transfer OCI manifest done", "error", logging.ErrorMessage(err))
@@ -62,7 +124,7 @@ func TransferManifest(art cpi.ManifestAccess, set cpi.ArtifactSink, tags ...stri
 	if err != nil {
 		return errors.Wrapf(err, "getting config blob")
 	}
-	err = set.AddBlob(blob)
+	err = addBlob(set, blob, opts)
 	blob.Close()
 	if err != nil {
 		return errors.Wrapf(err, "transferring config blob")
@@ -73,7 +135,7 @@ func TransferManifest(art cpi.ManifestAccess, set cpi.ArtifactSink, tags ...stri
 		if err != nil {
 			return errors.Wrapf(err, "getting layer blob %s", l.Digest)
 		}
-		err = set.AddBlob(blob)
+		err = addBlob(set, blob, opts)
 		blob.Close()
 		if err != nil {
 			return errors.Wrapf(err, "transferring layer blob %s", l.Digest)
@@ -85,3 +147,56 @@ func TransferManifest(art cpi.ManifestAccess, set cpi.ArtifactSink, tags ...stri
 	}
 	return blob.Close()
 }
+
+// addBlob adds a blob to the target. If the source namespace is known
+// and the target supports it, the blob is mounted from the source.
+func addBlob(set cpi.ArtifactSink, blob cpi.BlobAccess, opts *Options) error {
+	if opts.Source != nil {
+		if m, ok := set.(cpi.BlobMounter); ok {
+			return m.MountBlob(opts.Source, blob)
+		}
+	}
+	return set.AddBlob(blob)
+}
+
+// transferReferrers transfers the referrers of an artifact, if requested.
+// Referrers are transferred recursively, because they may be referred
+// themselves (for example a signature for an SBOM).
+func transferReferrers(dgst digest.Digest, set cpi.ArtifactSink, opts *Options) (err error) {
+	if opts.ReferrersSource == nil {
+		return nil
+	}
+	lister, ok := opts.ReferrersSource.(cpi.ReferrersLister)
+	if !ok {
+		return nil
+	}
+	list, err := lister.Referrers(dgst, "")
+	if err != nil {
+		if errors.IsErrNotSupported(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "listing referrers for %s", dgst)
+	}
+
+	var finalize finalizer.Finalizer
+	defer finalize.FinalizeWithErrorPropagation(&err)
+
+	for _, d := range list {
+		loop := finalize.Nested()
+		logging.Logger().Debug("referrer", "subject", dgst, "digest", d.Digest, "artifactType", d.ArtifactType)
+		art, err := opts.ReferrersSource.GetArtifact(d.Digest.String())
+		if err != nil {
+			return errors.Wrapf(err, "getting referrer %s", d.Digest)
+		}
+		loop.Close(art)
+		_, err = transferArtifact(art, set, opts)
+		if err != nil {
+			return errors.Wrapf(err, "transferring referrer %s", d.Digest)
+		}
+		err = loop.Finalize()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
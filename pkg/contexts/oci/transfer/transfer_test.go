@@ -11,12 +11,17 @@ import (
 	. "github.com/open-component-model/ocm/pkg/env/builder"
 	. "github.com/open-component-model/ocm/pkg/testutils"
 
+	"github.com/opencontainers/go-digest"
+
 	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
 	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/ctf"
 	"github.com/open-component-model/ocm/pkg/contexts/oci/transfer"
 	"github.com/open-component-model/ocm/pkg/finalizer"
+	"github.com/open-component-model/ocm/pkg/mime"
 )
 
 const OUT = "/tmp/res"
@@ -84,4 +89,179 @@ var _ = Describe("transfer OCI artifacts", func() {
 		data = Must(blob.Get())
 		Expect(string(data)).To(Equal(OCILAYER2))
 	})
+
+	It("transfers referrers", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		src := Must(ctf.Open(env.OCIContext(), accessobj.ACC_WRITABLE, OCIPATH, 0, env))
+		finalize.Close(src, "source")
+		sns := Must(src.LookupNamespace(OCINAMESPACE3))
+		finalize.Close(sns, "source namespace")
+
+		// signature for SBOM for index
+		sbom := addReferrer(sns, idesc, "application/spdx+json", "sbom")
+		sig := addReferrer(sns, sbom, "application/signature", "signature")
+
+		art := Must(sns.GetArtifact(OCIINDEXVERSION))
+		finalize.Close(art, "source artifact")
+
+		tgt := Must(ctf.Create(env.OCIContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+		finalize.Close(tgt, "target")
+		ns := Must(tgt.LookupNamespace(OCINAMESPACE3))
+		finalize.Close(ns, "target namespace")
+
+		Expect(transfer.TransferArtifactWithOptions(art, ns, []string{OCIINDEXVERSION}, transfer.WithReferrers(sns))).To(Equal(idesc.Digest))
+
+		lister := ns.(oci.ReferrersLister)
+		Expect(lister.Referrers(idesc.Digest, "")).To(Equal([]artdesc.Descriptor{*sbom}))
+		Expect(lister.Referrers(sbom.Digest, "")).To(Equal([]artdesc.Descriptor{*sig}))
+
+		tsig := Must(ns.GetArtifact(sig.Digest.String()))
+		finalize.Close(tsig, "target signature")
+		blob := Must(tsig.ManifestAccess().GetBlob(digest.FromString("signature")))
+		finalize.Close(blob, "signature blob")
+		Expect(blob.Get()).To(Equal([]byte("signature")))
+	})
+
+	It("ignores referrers by default", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		src := Must(ctf.Open(env.OCIContext(), accessobj.ACC_WRITABLE, OCIPATH, 0, env))
+		finalize.Close(src, "source")
+		sns := Must(src.LookupNamespace(OCINAMESPACE3))
+		finalize.Close(sns, "source namespace")
+		addReferrer(sns, idesc, "application/spdx+json", "sbom")
+
+		art := Must(sns.GetArtifact(OCIINDEXVERSION))
+		finalize.Close(art, "source artifact")
+
+		tgt := Must(ctf.Create(env.OCIContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+		finalize.Close(tgt, "target")
+		ns := Must(tgt.LookupNamespace(OCINAMESPACE3))
+		finalize.Close(ns, "target namespace")
+
+		MustBeSuccessful(transfer.TransferArtifact(art, ns, OCIINDEXVERSION))
+		Expect(ns.(oci.ReferrersLister).Referrers(idesc.Digest, "")).To(BeEmpty())
+	})
+
+	Context("platforms", func() {
+		var pdesc *artdesc.Descriptor
+		var amd64 *artdesc.Descriptor
+		var arm64 *artdesc.Descriptor
+
+		BeforeEach(func() {
+			env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+				amd64, _ = OCIManifest1For(env, OCINAMESPACE3, "")
+				arm64, _ = OCIManifest2For(env, OCINAMESPACE3, "")
+				env.Namespace(OCINAMESPACE3, func() {
+					pdesc = env.Index("multi", func() {
+						env.Artifact(withPlatform(amd64, "linux", "amd64"))
+						env.Artifact(withPlatform(arm64, "linux", "arm64"))
+					})
+				})
+			})
+		})
+
+		It("filters index manifests", func() {
+			var finalize finalizer.Finalizer
+			defer Defer(finalize.Finalize)
+
+			src := Must(ctf.Open(env.OCIContext(), accessobj.ACC_READONLY, OCIPATH, 0, env))
+			finalize.Close(src, "source")
+			art := Must(src.LookupArtifact(OCINAMESPACE3, "multi"))
+			finalize.Close(art, "source artifact")
+
+			tgt := Must(ctf.Create(env.OCIContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+			finalize.Close(tgt, "target")
+			ns := Must(tgt.LookupNamespace(OCINAMESPACE3))
+			finalize.Close(ns, "target namespace")
+
+			platforms := Must(artdesc.ParsePlatforms("linux/arm64"))
+			dig := Must(transfer.TransferArtifactWithOptions(art, ns, []string{"multi"}, transfer.WithPlatforms(platforms...)))
+			Expect(dig).NotTo(Equal(pdesc.Digest))
+
+			tart := Must(ns.GetArtifact("multi"))
+			finalize.Close(tart, "target artifact")
+			Expect(tart.Digest()).To(Equal(dig))
+			manifests := tart.IndexAccess().GetDescriptor().Manifests
+			Expect(len(manifests)).To(Equal(1))
+			Expect(manifests[0].Digest).To(Equal(arm64.Digest))
+			Expect(manifests[0].Platform.Architecture).To(Equal("arm64"))
+			Expect(ns.GetArtifact(amd64.Digest.String())).Error().To(HaveOccurred())
+		})
+
+		It("keeps index without filtered manifests", func() {
+			var finalize finalizer.Finalizer
+			defer Defer(finalize.Finalize)
+
+			src := Must(ctf.Open(env.OCIContext(), accessobj.ACC_READONLY, OCIPATH, 0, env))
+			finalize.Close(src, "source")
+			art := Must(src.LookupArtifact(OCINAMESPACE3, "multi"))
+			finalize.Close(art, "source artifact")
+
+			tgt := Must(ctf.Create(env.OCIContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+			finalize.Close(tgt, "target")
+			ns := Must(tgt.LookupNamespace(OCINAMESPACE3))
+			finalize.Close(ns, "target namespace")
+
+			platforms := Must(artdesc.ParsePlatforms("linux/arm64", "linux/amd64"))
+			Expect(transfer.TransferArtifactWithOptions(art, ns, []string{"multi"}, transfer.WithPlatforms(platforms...))).To(Equal(pdesc.Digest))
+		})
+
+		It("fails for unmatched platforms", func() {
+			var finalize finalizer.Finalizer
+			defer Defer(finalize.Finalize)
+
+			src := Must(ctf.Open(env.OCIContext(), accessobj.ACC_READONLY, OCIPATH, 0, env))
+			finalize.Close(src, "source")
+			art := Must(src.LookupArtifact(OCINAMESPACE3, "multi"))
+			finalize.Close(art, "source artifact")
+
+			tgt := Must(ctf.Create(env.OCIContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+			finalize.Close(tgt, "target")
+			ns := Must(tgt.LookupNamespace(OCINAMESPACE3))
+			finalize.Close(ns, "target namespace")
+
+			platforms := Must(artdesc.ParsePlatforms("windows/amd64"))
+			_, err := transfer.TransferArtifactWithOptions(art, ns, []string{"multi"}, transfer.WithPlatforms(platforms...))
+			Expect(err).To(MatchError(ContainSubstring("matches the platforms windows/amd64")))
+		})
+	})
 })
+
+func withPlatform(d *artdesc.Descriptor, os, arch string) *artdesc.Descriptor {
+	r := *d
+	r.Platform = &artdesc.Platform{OS: os, Architecture: arch}
+	return &r
+}
+
+func addReferrer(ns oci.NamespaceAccess, subject *artdesc.Descriptor, artifactType string, content string) *artdesc.Descriptor {
+	var finalize finalizer.Finalizer
+	defer Defer(finalize.Finalize)
+
+	config := blobaccess.ForString(artdesc.MediaTypeEmptyJSON, "{}")
+	MustBeSuccessful(ns.AddBlob(config))
+	layer := blobaccess.ForString(mime.MIME_OCTET, content)
+	MustBeSuccessful(ns.AddBlob(layer))
+
+	m := artdesc.NewManifest()
+	m.ArtifactType = artifactType
+	m.Config = *artdesc.DefaultBlobDescriptor(config)
+	m.Layers = []artdesc.Descriptor{*artdesc.DefaultBlobDescriptor(layer)}
+	m.SetSubject(subject)
+	desc := artdesc.New()
+	MustBeSuccessful(desc.SetManifest(m))
+
+	art := Must(ns.NewArtifact(desc))
+	finalize.Close(art, "referrer")
+	blob := Must(ns.AddArtifact(art))
+	finalize.Close(blob, "referrer blob")
+	return &artdesc.Descriptor{
+		MediaType:    artdesc.MediaTypeImageManifest,
+		Digest:       blob.Digest(),
+		Size:         blob.Size(),
+		ArtifactType: artifactType,
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"github.com/open-component-model/ocm/pkg/cobrautils/flagsets"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/options"
+)
+
+func ConfigHandler() flagsets.ConfigOptionTypeSetHandler {
+	return flagsets.NewConfigOptionTypeSetHandler(
+		Type, AddConfig,
+		options.RepositoryOption,
+		options.RefOption,
+		options.CommitOption,
+		options.PathSpecOption,
+	)
+}
+
+func AddConfig(opts flagsets.ConfigOptions, config flagsets.Config) error {
+	flagsets.AddFieldByOptionP(opts, options.RepositoryOption, config, "repoUrl")
+	flagsets.AddFieldByOptionP(opts, options.RefOption, config, "ref")
+	flagsets.AddFieldByOptionP(opts, options.CommitOption, config, "commit")
+	flagsets.AddFieldByOptionP(opts, options.PathSpecOption, config, "pathSpec")
+	return nil
+}
+
+var usage = `
+This method implements the access of the file tree of a Git repository.
+It works with any Git server reachable via HTTP(S) and with local repositories
+given by a <code>file://</code> URL. The file tree of the selected commit
+(or a sub directory of it) is provided as gzipped tar archive.
+Credentials are taken from the credential context using the consumer type
+<code>Git</code> and the repository URL as hostpath identity.
+`
+
+var formatV1 = `
+The type specific specification fields are:
+
+- **<code>repoUrl</code>** *string*
+
+  URL of the Git repository (for example <code>https://github.com/open-component-model/ocm</code>).
+
+- **<code>ref</code>** *string* (optional)
+
+  A branch or tag name, or a full reference name (for example <code>refs/heads/main</code>).
+  If neither a ref nor a commit is given, the default branch is used.
+
+- **<code>commit</code>** *string* (optional)
+
+  The id of the commit to access. If specified it takes precedence
+  over the <code>ref</code> field.
+
+- **<code>pathSpec</code>** *string* (optional)
+
+  A sub directory in the repository tree. If specified, only the file tree
+  below this directory is provided.
+`
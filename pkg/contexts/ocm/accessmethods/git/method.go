@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess/git"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type of a Git repository.
+const (
+	Type   = "git"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType[*AccessSpec](Type, cpi.WithDescription(usage)))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType[*AccessSpec](TypeV1, cpi.WithFormatSpec(formatV1), cpi.WithConfigHandler(ConfigHandler())))
+}
+
+// AccessSpec describes the access for a file tree in a Git repository.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// RepoURL is the URL of the Git repository.
+	RepoURL string `json:"repoUrl"`
+	// Ref is an optional branch or tag name (or full reference name).
+	Ref string `json:"ref,omitempty"`
+	// Commit is an optional commit id. It takes precedence over Ref.
+	Commit string `json:"commit,omitempty"`
+	// PathSpec is an optional path in the repository tree.
+	PathSpec string `json:"pathSpec,omitempty"`
+}
+
+var _ cpi.AccessSpec = (*AccessSpec)(nil)
+
+// New creates a new Git access spec version v1.
+func New(repoURL, ref, commit, pathSpec string) *AccessSpec {
+	return &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedTypedObject(Type),
+		RepoURL:             repoURL,
+		Ref:                 ref,
+		Commit:              commit,
+		PathSpec:            pathSpec,
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	ref := a.Commit
+	if ref == "" {
+		ref = a.Ref
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if a.PathSpec != "" {
+		return fmt.Sprintf("Git repository %s[%s]:%s", a.RepoURL, ref, a.PathSpec)
+	}
+	return fmt.Sprintf("Git repository %s[%s]", a.RepoURL, ref)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (a *AccessSpec) GlobalAccessSpec(ctx cpi.Context) cpi.AccessSpec {
+	return a
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+func (a *AccessSpec) GetInexpensiveContentVersionIdentity(access cpi.ComponentVersionAccess) string {
+	if a.PathSpec != "" || a.Commit == "" {
+		return ""
+	}
+	return a.Commit
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (cpi.AccessMethod, error) {
+	if a.RepoURL == "" {
+		return nil, fmt.Errorf("repository URL required for git access")
+	}
+	factory := func() (blobaccess.BlobAccess, error) {
+		return git.BlobAccessForGit(a.RepoURL,
+			git.WithCredentialContext(c.GetContext()),
+			git.WithRef(a.Ref),
+			git.WithCommit(a.Commit),
+			git.WithPathSpec(a.PathSpec),
+		)
+	}
+	return cpi.NewDefaultMethod(c, a, mime.MIME_TGZ, factory), nil
+}
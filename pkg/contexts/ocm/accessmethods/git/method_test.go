@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/git"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/utils/tarutils"
+)
+
+func commit(repo *gogit.Repository, dir string, files map[string]string) plumbing.Hash {
+	wt := Must(repo.Worktree())
+	for n, c := range files {
+		p := filepath.Join(dir, n)
+		MustBeSuccessful(os.MkdirAll(filepath.Dir(p), 0o700))
+		MustBeSuccessful(os.WriteFile(p, []byte(c), 0o600))
+		Must(wt.Add(n))
+	}
+	return Must(wt.Commit("update", &gogit.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@acme.org", When: time.Now()},
+	}))
+}
+
+var _ = Describe("Method", func() {
+	var ctx ocm.Context
+	var cv ocm.ComponentVersionAccess
+	var dir string
+	var url string
+	var first plumbing.Hash
+
+	BeforeEach(func() {
+		ctx = ocm.New()
+		cv = &cpi.DummyComponentVersionAccess{Context: ctx}
+
+		dir = Must(os.MkdirTemp("", "gittest-"))
+		url = "file://" + dir
+		repo := Must(gogit.PlainInit(dir, false))
+		first = commit(repo, dir, map[string]string{"README.md": "readme", "sub/file": "first"})
+		Must(repo.CreateTag("v1", first, nil))
+		commit(repo, dir, map[string]string{"sub/file": "second", "sub/other": "other"})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("accesses default branch", func() {
+		acc := git.New(url, "", "", "")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_TGZ))
+
+		r := Must(m.Reader())
+		defer r.Close()
+		Expect(tarutils.ListArchiveContentFromReader(r)).To(ConsistOf("README.md", "sub", "sub/file", "sub/other"))
+	})
+
+	It("accesses tag with path spec", func() {
+		acc := git.New(url, "v1", "", "sub")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+
+		r := Must(m.Reader())
+		defer r.Close()
+		Expect(tarutils.ListArchiveContentFromReader(r)).To(ConsistOf("file"))
+	})
+
+	It("accesses commit", func() {
+		acc := git.New(url, "", first.String(), "")
+		Expect(acc.GetInexpensiveContentVersionIdentity(cv)).To(Equal(first.String()))
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+
+		r := Must(m.Reader())
+		defer r.Close()
+		Expect(tarutils.ListArchiveContentFromReader(r)).To(ConsistOf("README.md", "sub", "sub/file"))
+	})
+
+	It("fails for unknown reference", func() {
+		acc := git.New(url, "unknown", "", "")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		_, err := m.Reader()
+		Expect(err).To(MatchError(ContainSubstring("git reference \"unknown\" not found")))
+	})
+
+	It("rejects path spec outside of repository", func() {
+		acc := git.New(url, "", "", "../other")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		_, err := m.Reader()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -287,7 +287,7 @@ func (m *accessMethod) setup() error {
 		return fmt.Errorf("failed to get download link: %w", err)
 	}
 
-	d := hd.NewDownloader(link)
+	var d downloader.Downloader = hd.NewDownloader(link)
 	if m.spec.downloader != nil {
 		d = m.spec.downloader
 	}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven
+
+import (
+	"github.com/open-component-model/ocm/pkg/cobrautils/flagsets"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/options"
+)
+
+func ConfigHandler() flagsets.ConfigOptionTypeSetHandler {
+	return flagsets.NewConfigOptionTypeSetHandler(
+		Type, AddConfig,
+		options.RepositoryOption,
+		options.GroupIdOption,
+		options.ArtifactIdOption,
+		options.VersionOption,
+		options.ClassifierOption,
+		options.ExtensionOption,
+	)
+}
+
+func AddConfig(opts flagsets.ConfigOptions, config flagsets.Config) error {
+	flagsets.AddFieldByOptionP(opts, options.RepositoryOption, config, "repoUrl")
+	flagsets.AddFieldByOptionP(opts, options.GroupIdOption, config, "groupId")
+	flagsets.AddFieldByOptionP(opts, options.ArtifactIdOption, config, "artifactId")
+	flagsets.AddFieldByOptionP(opts, options.VersionOption, config, "version")
+	flagsets.AddFieldByOptionP(opts, options.ClassifierOption, config, "classifier")
+	flagsets.AddFieldByOptionP(opts, options.ExtensionOption, config, "extension")
+	return nil
+}
+
+var usage = `
+This method implements the access of a dedicated file of a Maven artifact
+in a Maven repository. If the repository provides a SHA-256 or SHA-1
+checksum file for the accessed file, the downloaded content is verified.
+`
+
+var formatV1 = `
+The type specific specification fields are:
+
+- **<code>repoUrl</code>** *string*
+
+  Base URL of the Maven repository.
+
+- **<code>groupId</code>** *string*
+
+  The group id of the Maven artifact.
+
+- **<code>artifactId</code>** *string*
+
+  The artifact id of the Maven artifact.
+
+- **<code>version</code>** *string*
+
+  The version of the Maven artifact.
+
+- **<code>classifier</code>** (optional) *string*
+
+  The classifier of the accessed artifact file.
+
+- **<code>extension</code>** (optional) *string*
+
+  The extension of the accessed artifact file (default is <code>jar</code>).
+`
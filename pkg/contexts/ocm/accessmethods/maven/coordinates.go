@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven
+
+import (
+	"path"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+// Coordinates describes a dedicated file of a Maven artifact.
+type Coordinates struct {
+	// GroupId of the Maven artifact.
+	GroupId string `json:"groupId"`
+	// ArtifactId of the Maven artifact.
+	ArtifactId string `json:"artifactId"`
+	// Version of the Maven artifact.
+	Version string `json:"version"`
+	// Classifier of the Maven artifact.
+	Classifier string `json:"classifier,omitempty"`
+	// Extension of the Maven artifact (default is jar).
+	Extension string `json:"extension,omitempty"`
+}
+
+// ParseGAV parses Maven coordinates of the form
+// <groupId>:<artifactId>:<version>[:<classifier>[:<extension>]].
+func ParseGAV(gav string) (*Coordinates, error) {
+	parts := strings.Split(gav, ":")
+	if len(parts) < 3 || len(parts) > 5 {
+		return nil, errors.ErrInvalid("maven coordinates", gav)
+	}
+	for _, p := range parts {
+		if strings.ContainsAny(p, "/@") {
+			return nil, errors.ErrInvalid("maven coordinates", gav)
+		}
+	}
+	c := &Coordinates{
+		GroupId:    parts[0],
+		ArtifactId: parts[1],
+		Version:    parts[2],
+	}
+	if len(parts) > 3 {
+		c.Classifier = parts[3]
+	}
+	if len(parts) > 4 {
+		c.Extension = parts[4]
+	}
+	return c, c.Validate()
+}
+
+func (c *Coordinates) Validate() error {
+	if c.GroupId == "" {
+		return errors.Newf("maven groupId required")
+	}
+	if c.ArtifactId == "" {
+		return errors.Newf("maven artifactId required")
+	}
+	if c.Version == "" {
+		return errors.Newf("maven version required")
+	}
+	// the coordinates are used to compose file paths and URLs
+	fields := []string{
+		"groupId", c.GroupId,
+		"artifactId", c.ArtifactId,
+		"version", c.Version,
+		"classifier", c.Classifier,
+		"extension", c.Extension,
+	}
+	for i := 0; i < len(fields); i += 2 {
+		if v := fields[i+1]; strings.ContainsAny(v, "/\\") || strings.Contains(v, "..") {
+			return errors.ErrInvalid("maven "+fields[i], v)
+		}
+	}
+	return nil
+}
+
+// GAV provides the coordinate string.
+func (c *Coordinates) GAV() string {
+	gav := c.GroupId + ":" + c.ArtifactId + ":" + c.Version
+	if c.Classifier != "" || c.Extension != "" {
+		gav += ":" + c.Classifier
+	}
+	if c.Extension != "" {
+		gav += ":" + c.Extension
+	}
+	return gav
+}
+
+func (c *Coordinates) GetExtension() string {
+	if c.Extension == "" {
+		return "jar"
+	}
+	return c.Extension
+}
+
+// FileName provides the file name of the artifact file in a Maven repository.
+func (c *Coordinates) FileName() string {
+	name := c.ArtifactId + "-" + c.Version
+	if c.Classifier != "" {
+		name += "-" + c.Classifier
+	}
+	return name + "." + c.GetExtension()
+}
+
+// Path provides the relative path of the artifact file in a Maven repository.
+func (c *Coordinates) Path() string {
+	return path.Join(strings.ReplaceAll(c.GroupId, ".", "/"), c.ArtifactId, c.Version, c.FileName())
+}
+
+// URL provides the URL of the artifact file for the given repository URL.
+func (c *Coordinates) URL(repoURL string) string {
+	return strings.TrimSuffix(repoURL, "/") + "/" + c.Path()
+}
+
+// MimeType provides the media type derived from the file extension.
+func (c *Coordinates) MimeType() string {
+	switch c.GetExtension() {
+	case "jar", "war", "ear":
+		return mime.MIME_JAR
+	case "pom", "xml":
+		return mime.MIME_XML
+	case "tgz", "tar.gz":
+		return mime.MIME_TGZ
+	case "zip":
+		return mime.MIME_ZIP
+	}
+	return mime.MIME_OCTET
+}
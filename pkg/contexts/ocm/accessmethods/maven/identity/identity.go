@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"net/http"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/listformat"
+)
+
+// CONSUMER_TYPE is the Maven repository type.
+const CONSUMER_TYPE = "MavenRepository"
+
+// identity properties.
+const (
+	ID_TYPE       = cpi.ID_TYPE
+	ID_SCHEME     = hostpath.ID_SCHEME
+	ID_HOSTNAME   = hostpath.ID_HOSTNAME
+	ID_PORT       = hostpath.ID_PORT
+	ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+)
+
+// credential properties.
+const (
+	ATTR_USERNAME = cpi.ATTR_USERNAME
+	ATTR_PASSWORD = cpi.ATTR_PASSWORD
+)
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+func init() {
+	attrs := listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		ATTR_USERNAME, "the basic auth user name",
+		ATTR_PASSWORD, "the basic auth password",
+	})
+	cpi.RegisterStandardIdentity(CONSUMER_TYPE, identityMatcher,
+		`Maven repository credential matcher
+
+This matcher is a hostpath matcher.`,
+		attrs)
+}
+
+func GetConsumerId(repoURL string) cpi.ConsumerIdentity {
+	return hostpath.GetConsumerIdentity(CONSUMER_TYPE, repoURL)
+}
+
+func GetCredentials(ctx cpi.ContextProvider, repoURL string) (cpi.Credentials, error) {
+	id := GetConsumerId(repoURL)
+	if id == nil {
+		return nil, nil
+	}
+	return cpi.CredentialsForConsumer(ctx.CredentialsContext(), id, identityMatcher)
+}
+
+// BasicAuth sets the basic auth credentials found for the given
+// repository URL, if any, at the given request.
+func BasicAuth(req *http.Request, ctx cpi.ContextProvider, repoURL string) error {
+	creds, err := GetCredentials(ctx, repoURL)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get credentials for %s", repoURL)
+	}
+	if creds != nil {
+		if u := creds.GetProperty(ATTR_USERNAME); u != "" {
+			req.SetBasicAuth(u, creds.GetProperty(ATTR_PASSWORD))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type of Maven repository.
+const (
+	Type   = "maven"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType[*AccessSpec](Type, cpi.WithDescription(usage)))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType[*AccessSpec](TypeV1, cpi.WithFormatSpec(formatV1), cpi.WithConfigHandler(ConfigHandler())))
+}
+
+// AccessSpec describes the access for a file of a Maven artifact.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// RepoUrl is the base URL of the Maven repository.
+	RepoUrl string `json:"repoUrl"`
+
+	Coordinates `json:",inline"`
+}
+
+var _ cpi.AccessSpec = (*AccessSpec)(nil)
+
+// New creates a new Maven repository access spec version v1.
+func New(repoUrl, groupId, artifactId, version string, opts ...Option) *AccessSpec {
+	a := &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedTypedObject(Type),
+		RepoUrl:             repoUrl,
+		Coordinates: Coordinates{
+			GroupId:    groupId,
+			ArtifactId: artifactId,
+			Version:    version,
+		},
+	}
+	for _, o := range opts {
+		o(&a.Coordinates)
+	}
+	return a
+}
+
+// Option is an optional setting for the Maven coordinates.
+type Option func(c *Coordinates)
+
+// WithClassifier sets the classifier of the accessed artifact file.
+func WithClassifier(c string) Option {
+	return func(coords *Coordinates) {
+		coords.Classifier = c
+	}
+}
+
+// WithExtension sets the extension of the accessed artifact file.
+func WithExtension(e string) Option {
+	return func(coords *Coordinates) {
+		coords.Extension = e
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	return fmt.Sprintf("Maven artifact %s in repository %s", a.GAV(), a.RepoUrl)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (a *AccessSpec) GlobalAccessSpec(ctx cpi.Context) cpi.AccessSpec {
+	return a
+}
+
+func (a *AccessSpec) GetReferenceHint(cv cpi.ComponentVersionAccess) string {
+	return a.GAV()
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+func (a *AccessSpec) GetInexpensiveContentVersionIdentity(access cpi.ComponentVersionAccess) string {
+	_, sum, _ := a.getChecksum(access.GetContext())
+	return sum
+}
+
+// ArtifactURL provides the URL of the accessed artifact file.
+func (a *AccessSpec) ArtifactURL() string {
+	return a.URL(a.RepoUrl)
+}
+
+// getChecksum provides the checksum found in the sidecar files of the artifact file.
+// SHA-256 is preferred over SHA-1. If no checksum file is found, no checksum is returned.
+func (a *AccessSpec) getChecksum(ctx cpi.Context) (crypto.Hash, string, error) {
+	url := a.ArtifactURL()
+	for _, h := range []struct {
+		hash   crypto.Hash
+		suffix string
+	}{
+		{crypto.SHA256, ".sha256"},
+		{crypto.SHA1, ".sha1"},
+	} {
+		r, err := reader(ctx, url+h.suffix, vfsattr.Get(ctx))
+		if err != nil {
+			if errors.IsErrNotFound(err) {
+				continue
+			}
+			return 0, "", err
+		}
+		buf := &bytes.Buffer{}
+		_, err = io.Copy(buf, io.LimitReader(r, 1000))
+		r.Close()
+		if err != nil {
+			return 0, "", errors.Wrapf(err, "cannot read checksum file %s", url+h.suffix)
+		}
+		// checksum files may contain the file name after the checksum
+		fields := strings.Fields(buf.String())
+		if len(fields) == 0 {
+			return 0, "", errors.Newf("empty checksum file %s", url+h.suffix)
+		}
+		return h.hash, strings.ToLower(fields[0]), nil
+	}
+	return 0, "", nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (cpi.AccessMethod, error) {
+	if a.RepoUrl == "" {
+		return nil, errors.ErrInvalid("repoUrl", a.RepoUrl)
+	}
+	if err := a.Coordinates.Validate(); err != nil {
+		return nil, err
+	}
+
+	factory := func() (blobaccess.BlobAccess, error) {
+		ctx := c.GetContext()
+		hash, sum, err := a.getChecksum(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		url := a.ArtifactURL()
+		f := func() (io.ReadCloser, error) {
+			r, err := reader(ctx, url, vfsattr.Get(ctx))
+			if err != nil {
+				return nil, err
+			}
+			if sum != "" {
+				r = accessio.VerifyingReaderWithHash(r, hash, sum)
+			}
+			return r, nil
+		}
+		acc := blobaccess.DataAccessForReaderFunction(f, url)
+		return accessobj.CachedBlobAccessForWriter(ctx, a.MimeType(), accessio.NewDataAccessWriter(acc)), nil
+	}
+	return cpi.NewDefaultMethod(c, a, a.MimeType(), factory), nil
+}
+
+func reader(ctx cpi.Context, url string, fs vfs.FileSystem) (io.ReadCloser, error) {
+	if strings.HasPrefix(url, "file://") {
+		path := url[7:]
+		r, err := fs.OpenFile(path, vfs.O_RDONLY, 0o600)
+		if err != nil {
+			if vfs.IsErrNotExist(err) {
+				return nil, errors.ErrNotFound("file", url)
+			}
+			return nil, err
+		}
+		return r, nil
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = identity.BasicAuth(req, ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, errors.ErrNotFound("file", url)
+		}
+		buf := &bytes.Buffer{}
+		_, err = io.Copy(buf, io.LimitReader(resp.Body, 2000))
+		if err != nil {
+			return nil, errors.Newf("maven request %s provides %s", url, resp.Status)
+		}
+		return nil, errors.Newf("maven request %s provides %s: %s", url, resp.Status, buf.String())
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven_test
+
+import (
+	"crypto/sha1" //nolint: gosec // checksum files of Maven repositories
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const (
+	CONTENT = "this is some jar content"
+	JARPATH = "/repo/com/example/lib/1.0.0/lib-1.0.0.jar"
+)
+
+func sha1sum(s string) string {
+	h := sha1.Sum([]byte(s)) //nolint: gosec // see import
+	return hex.EncodeToString(h[:])
+}
+
+func sha256sum(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+var _ = Describe("Method", func() {
+	var ctx ocm.Context
+	var cv ocm.ComponentVersionAccess
+	var server *httptest.Server
+	var files map[string]string
+	var auth string
+
+	BeforeEach(func() {
+		ctx = ocm.New()
+		cv = &cpi.DummyComponentVersionAccess{Context: ctx}
+		auth = ""
+		files = map[string]string{
+			JARPATH:           CONTENT,
+			JARPATH + ".sha1": sha1sum(CONTENT) + "  lib-1.0.0.jar\n",
+		}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			data, ok := files[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(data))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("handles coordinates", func() {
+		c := Must(maven.ParseGAV("com.example:lib:1.0.0:sources:zip"))
+		Expect(c).To(Equal(&maven.Coordinates{
+			GroupId:    "com.example",
+			ArtifactId: "lib",
+			Version:    "1.0.0",
+			Classifier: "sources",
+			Extension:  "zip",
+		}))
+		Expect(c.GAV()).To(Equal("com.example:lib:1.0.0:sources:zip"))
+		Expect(c.Path()).To(Equal("com/example/lib/1.0.0/lib-1.0.0-sources.zip"))
+
+		c = Must(maven.ParseGAV("com.example:lib:1.0.0"))
+		Expect(c.FileName()).To(Equal("lib-1.0.0.jar"))
+		Expect(c.MimeType()).To(Equal(mime.MIME_JAR))
+
+		_, err := maven.ParseGAV("ghcr.io:443/repo:1.0.0")
+		Expect(err).To(HaveOccurred())
+		_, err = maven.ParseGAV("com.example:lib")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("downloads artifact verified by sha1", func() {
+		acc := maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0")
+		Expect(acc.GetReferenceHint(cv)).To(Equal("com.example:lib:1.0.0"))
+		Expect(acc.GetInexpensiveContentVersionIdentity(cv)).To(Equal(sha1sum(CONTENT)))
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_JAR))
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+		Expect(auth).To(Equal(""))
+	})
+
+	It("prefers sha256", func() {
+		files[JARPATH+".sha256"] = sha256sum(CONTENT)
+		acc := maven.New(server.URL+"/repo/", "com.example", "lib", "1.0.0")
+		Expect(acc.GetInexpensiveContentVersionIdentity(cv)).To(Equal(sha256sum(CONTENT)))
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+	})
+
+	It("detects checksum mismatch", func() {
+		files[JARPATH+".sha1"] = sha1sum("other")
+		acc := maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		_, err := m.Get()
+		Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+
+	It("downloads artifact without checksum", func() {
+		files["/repo/com/example/lib/1.0.0/lib-1.0.0-sources.zip"] = CONTENT
+		acc := maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0", maven.WithClassifier("sources"), maven.WithExtension("zip"))
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal("application/zip"))
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+	})
+
+	It("uses credentials", func() {
+		ctx.CredentialsContext().SetCredentialsForConsumer(identity.GetConsumerId(server.URL+"/repo"),
+			credentials.NewCredentials(common.Properties{identity.ATTR_USERNAME: "user", identity.ATTR_PASSWORD: "pass"}))
+		acc := maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+		Expect(auth).To(Equal("Basic dXNlcjpwYXNz"))
+	})
+
+	It("fails for unknown artifact", func() {
+		acc := maven.New(server.URL+"/repo", "com.example", "other", "1.0.0")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		_, err := m.Get()
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+})
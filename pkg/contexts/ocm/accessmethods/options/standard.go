@@ -34,6 +34,30 @@ var HostnameOption = RegisterOption(NewStringOptionType("accessHostname", "hostn
 // CommitOption.
 var CommitOption = RegisterOption(NewStringOptionType("commit", "git commit id"))
 
+// RefOption.
+var RefOption = RegisterOption(NewStringOptionType("ref", "git reference (branch or tag)"))
+
+// PathSpecOption.
+var PathSpecOption = RegisterOption(NewStringOptionType("pathSpec", "path in accessed repository"))
+
+// URLOption.
+var URLOption = RegisterOption(NewStringOptionType("url", "artifact or server url"))
+
+// HTTPHeaderOption.
+var HTTPHeaderOption = RegisterOption(NewStringMapOptionType("header", "http headers"))
+
+// GroupIdOption.
+var GroupIdOption = RegisterOption(NewStringOptionType("groupId", "maven group id"))
+
+// ArtifactIdOption.
+var ArtifactIdOption = RegisterOption(NewStringOptionType("artifactId", "maven artifact id"))
+
+// ClassifierOption.
+var ClassifierOption = RegisterOption(NewStringOptionType("classifier", "maven classifier"))
+
+// ExtensionOption.
+var ExtensionOption = RegisterOption(NewStringOptionType("extension", "maven extension name"))
+
 // GlobalAccessOption.
 var GlobalAccessOption = RegisterOption(NewValueMapYAMLOptionType("globalAccess", "access specification for global access"))
 
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wget
+
+import (
+	"github.com/open-component-model/ocm/pkg/cobrautils/flagsets"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/options"
+)
+
+func ConfigHandler() flagsets.ConfigOptionTypeSetHandler {
+	return flagsets.NewConfigOptionTypeSetHandler(
+		Type, AddConfig,
+		options.URLOption,
+		options.MediatypeOption,
+		options.DigestOption,
+		options.HTTPHeaderOption,
+	)
+}
+
+func AddConfig(opts flagsets.ConfigOptions, config flagsets.Config) error {
+	flagsets.AddFieldByOptionP(opts, options.URLOption, config, "url")
+	flagsets.AddFieldByOptionP(opts, options.MediatypeOption, config, "mediaType")
+	flagsets.AddFieldByOptionP(opts, options.DigestOption, config, "digest")
+	return flagsets.AddFieldByMappedOptionP(opts, options.HTTPHeaderOption, config, mapHeader, "header")
+}
+
+func mapHeader(v interface{}) (interface{}, error) {
+	header := map[string][]string{}
+	for k, v := range v.(map[string]string) {
+		header[k] = []string{v}
+	}
+	return header, nil
+}
+
+var usage = `
+This method implements the access of a blob provided by a generic
+HTTP(S) URL. The downloaded content can be verified against an expected
+digest. Credentials are taken from the credential context using the
+consumer type <code>wget</code> and the URL as hostpath identity.
+The credential attributes <code>username</code> and <code>password</code>
+are used for basic authentication, a <code>token</code> is used as bearer token.
+`
+
+var formatV1 = `
+The type specific specification fields are:
+
+- **<code>url</code>** *string*
+
+  The HTTP(S) URL of the blob.
+
+- **<code>mediaType</code>** *string* (optional)
+
+  The media type of the blob (default is <code>application/octet-stream</code>).
+
+- **<code>header</code>** *map[string][]string* (optional)
+
+  Additional HTTP headers used for the download request.
+
+- **<code>digest</code>** *string* (optional)
+
+  The expected digest of the blob content (for example <code>sha256:...</code>).
+  If specified, the downloaded content is verified against this digest.
+`
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/identity/hostpath"
+	"github.com/open-component-model/ocm/pkg/listformat"
+)
+
+const CONSUMER_TYPE = "wget"
+
+// identity properties.
+const (
+	ID_TYPE       = cpi.ID_TYPE
+	ID_SCHEME     = hostpath.ID_SCHEME
+	ID_HOSTNAME   = hostpath.ID_HOSTNAME
+	ID_PORT       = hostpath.ID_PORT
+	ID_PATHPREFIX = hostpath.ID_PATHPREFIX
+)
+
+// credential properties.
+const (
+	ATTR_USERNAME = cpi.ATTR_USERNAME
+	ATTR_PASSWORD = cpi.ATTR_PASSWORD
+	ATTR_TOKEN    = cpi.ATTR_TOKEN
+)
+
+var identityMatcher = hostpath.IdentityMatcher(CONSUMER_TYPE)
+
+func IdentityMatcher(pattern, cur, id cpi.ConsumerIdentity) bool {
+	return identityMatcher(pattern, cur, id)
+}
+
+func init() {
+	attrs := listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		ATTR_USERNAME, "the basic auth user name",
+		ATTR_PASSWORD, "the basic auth password",
+		ATTR_TOKEN, "bearer token (alternatively)",
+	})
+	cpi.RegisterStandardIdentity(CONSUMER_TYPE, identityMatcher,
+		`HTTP(S) download credential matcher
+
+This matcher is a hostpath matcher.`,
+		attrs)
+}
+
+func GetConsumerId(rawURL string) cpi.ConsumerIdentity {
+	return hostpath.GetConsumerIdentity(CONSUMER_TYPE, rawURL)
+}
+
+func GetCredentials(ctx cpi.ContextProvider, rawURL string) (cpi.Credentials, error) {
+	id := GetConsumerId(rawURL)
+	if id == nil {
+		return nil, nil
+	}
+	return cpi.CredentialsForConsumer(ctx.CredentialsContext(), id, identityMatcher)
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wget
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	hd "github.com/open-component-model/ocm/pkg/common/accessio/downloader/http"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/wget/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Type is the access type for generic HTTP(S) downloads.
+const (
+	Type   = "wget"
+	TypeV1 = Type + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cpi.RegisterAccessType(cpi.NewAccessSpecType[*AccessSpec](Type, cpi.WithDescription(usage)))
+	cpi.RegisterAccessType(cpi.NewAccessSpecType[*AccessSpec](TypeV1, cpi.WithFormatSpec(formatV1), cpi.WithConfigHandler(ConfigHandler())))
+}
+
+// AccessSpec describes the access for a blob provided by an HTTP(S) URL.
+type AccessSpec struct {
+	runtime.ObjectVersionedType `json:",inline"`
+
+	// URL is the HTTP(S) URL of the blob.
+	URL string `json:"url"`
+	// MediaType is the media type of the blob.
+	MediaType string `json:"mediaType,omitempty"`
+	// Header is an optional set of additional HTTP request headers.
+	Header map[string][]string `json:"header,omitempty"`
+	// Digest is an optional expected digest of the downloaded content.
+	Digest digest.Digest `json:"digest,omitempty"`
+}
+
+var _ cpi.AccessSpec = (*AccessSpec)(nil)
+
+// New creates a new wget access spec version v1.
+func New(url string, mediaType string, dig digest.Digest) *AccessSpec {
+	return &AccessSpec{
+		ObjectVersionedType: runtime.NewVersionedTypedObject(Type),
+		URL:                 url,
+		MediaType:           mediaType,
+		Digest:              dig,
+	}
+}
+
+func (a *AccessSpec) Describe(ctx cpi.Context) string {
+	return fmt.Sprintf("Download %s", a.URL)
+}
+
+func (_ *AccessSpec) IsLocal(cpi.Context) bool {
+	return false
+}
+
+func (a *AccessSpec) GlobalAccessSpec(ctx cpi.Context) cpi.AccessSpec {
+	return a
+}
+
+func (_ *AccessSpec) GetType() string {
+	return Type
+}
+
+func (a *AccessSpec) AccessMethod(c cpi.ComponentVersionAccess) (cpi.AccessMethod, error) {
+	return newMethod(c, a)
+}
+
+func (a *AccessSpec) GetInexpensiveContentVersionIdentity(access cpi.ComponentVersionAccess) string {
+	return a.Digest.String()
+}
+
+func (a *AccessSpec) GetMimeType() string {
+	if a.MediaType == "" {
+		return mime.MIME_OCTET
+	}
+	return a.MediaType
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func newMethod(c cpi.ComponentVersionAccess, a *AccessSpec) (cpi.AccessMethod, error) {
+	if a.URL == "" {
+		return nil, errors.ErrInvalid("url", a.URL)
+	}
+	if a.Digest != "" {
+		if err := a.Digest.Validate(); err != nil {
+			return nil, errors.ErrInvalidWrap(err, "digest", a.Digest.String())
+		}
+	}
+
+	factory := func() (blobaccess.BlobAccess, error) {
+		f := func() (io.ReadCloser, error) {
+			r, err := a.reader(c.GetContext())
+			if err != nil {
+				return nil, err
+			}
+			if a.Digest != "" {
+				r = accessio.VerifyingReader(r, a.Digest)
+			}
+			return r, nil
+		}
+		acc := blobaccess.DataAccessForReaderFunction(f, a.URL)
+		return accessobj.CachedBlobAccessForWriter(c.GetContext(), a.GetMimeType(), accessio.NewDataAccessWriter(acc)), nil
+	}
+	return cpi.NewDefaultMethod(c, a, a.GetMimeType(), factory), nil
+}
+
+func (a *AccessSpec) reader(ctx cpi.Context) (io.ReadCloser, error) {
+	opts := []hd.Option{hd.WithHeader(a.Header)}
+
+	creds, err := identity.GetCredentials(ctx, a.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get credentials for %s", a.URL)
+	}
+	if creds != nil {
+		if t := creds.GetProperty(identity.ATTR_TOKEN); t != "" {
+			opts = append(opts, hd.WithToken(t))
+		} else if u := creds.GetProperty(identity.ATTR_USERNAME); u != "" {
+			opts = append(opts, hd.WithBasicAuth(u, creds.GetProperty(identity.ATTR_PASSWORD)))
+		}
+	}
+	return hd.NewDownloader(a.URL, opts...).Reader()
+}
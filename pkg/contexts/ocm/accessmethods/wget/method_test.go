@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wget_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/wget"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/wget/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const CONTENT = "this is some content"
+
+var _ = Describe("Method", func() {
+	var ctx ocm.Context
+	var cv ocm.ComponentVersionAccess
+	var server *httptest.Server
+	var auth string
+	var header string
+
+	BeforeEach(func() {
+		ctx = ocm.New()
+		cv = &cpi.DummyComponentVersionAccess{Context: ctx}
+		auth = ""
+		header = ""
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			header = r.Header.Get("X-Test")
+			if r.URL.Path != "/content" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(CONTENT))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("downloads content", func() {
+		acc := wget.New(server.URL+"/content", mime.MIME_TEXT, "")
+		acc.Header = map[string][]string{"X-Test": {"value"}}
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_TEXT))
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+		Expect(header).To(Equal("value"))
+		Expect(auth).To(Equal(""))
+	})
+
+	It("verifies pinned digest", func() {
+		acc := wget.New(server.URL+"/content", "", digest.FromString(CONTENT))
+		Expect(acc.GetInexpensiveContentVersionIdentity(cv)).To(Equal(digest.FromString(CONTENT).String()))
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(m.MimeType()).To(Equal(mime.MIME_OCTET))
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+	})
+
+	It("detects digest mismatch", func() {
+		acc := wget.New(server.URL+"/content", "", digest.FromString("other"))
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		_, err := m.Get()
+		Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+
+	It("uses credentials", func() {
+		ctx.CredentialsContext().SetCredentialsForConsumer(identity.GetConsumerId(server.URL),
+			credentials.NewCredentials(common.Properties{identity.ATTR_TOKEN: "mytoken"}))
+		acc := wget.New(server.URL+"/content", "", "")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		Expect(string(Must(m.Get()))).To(Equal(CONTENT))
+		Expect(auth).To(Equal("Bearer mytoken"))
+	})
+
+	It("fails for unknown url", func() {
+		acc := wget.New(server.URL+"/unknown", "", "")
+
+		m := Must(acc.AccessMethod(cv))
+		defer m.Close()
+		_, err := m.Get()
+		Expect(err).To(MatchError(ContainSubstring("404 Not Found")))
+	})
+})
@@ -8,9 +8,12 @@ import (
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/compatattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/hashattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keepblobattr"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keystoreattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/mapocirepoattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/ociuploadattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/plugincacheattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/plugindirattr"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/tsaattr"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
 )
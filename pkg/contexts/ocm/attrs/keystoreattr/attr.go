@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystoreattr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	ocm "github.com/open-component-model/ocm/pkg/contexts/ocm/context"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+)
+
+const (
+	ATTR_KEY   = "ocm.software/signing/keystores"
+	ATTR_SHORT = "keystores"
+)
+
+type (
+	Context         = ocm.Context
+	ContextProvider = ocm.ContextProvider
+)
+
+func init() {
+	datacontext.RegisterAttributeType(ATTR_KEY, AttributeType{}, ATTR_SHORT)
+}
+
+type AttributeType struct{}
+
+func (a AttributeType) Name() string {
+	return ATTR_KEY
+}
+
+func (a AttributeType) Description() string {
+	return `
+*JSON*
+Key stores used to resolve key references (<code>&lt;store>:&lt;alias></code>)
+for signing and verification. The value is a map of store names to store
+specifications:
+
+<pre>
+{
+  "&lt;store name>": {
+    "type": "&lt;store type>",
+    "path": "&lt;path>",
+    "token": "&lt;token label>",
+    "slot": &lt;slot number>
+  }
+}
+</pre>
+
+The attributes used by a store specification depend on its type.
+The following store types are supported:
+` + keyprovider.Usage()
+}
+
+func (a AttributeType) Encode(v interface{}, marshaller runtime.Marshaler) ([]byte, error) {
+	switch s := v.(type) {
+	case Stores:
+		return json.Marshal(s)
+	case *Attribute:
+		return json.Marshal(s.GetStores())
+	}
+	return nil, fmt.Errorf("key store map required")
+}
+
+func (a AttributeType) Decode(data []byte, unmarshaller runtime.Unmarshaler) (interface{}, error) {
+	var value Stores
+	err := unmarshaller.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	return New(value), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Stores maps store names to key store specifications.
+type Stores map[string]keyprovider.StoreSpec
+
+// Attribute describes the configured key stores and caches
+// the key providers created for them.
+type Attribute struct {
+	lock      sync.Mutex
+	stores    Stores
+	providers map[string]keyprovider.KeyProvider
+}
+
+func New(stores Stores) *Attribute {
+	a := &Attribute{
+		stores:    Stores{},
+		providers: map[string]keyprovider.KeyProvider{},
+	}
+	for n, s := range stores {
+		a.stores[n] = s
+	}
+	return a
+}
+
+// GetStores provides a copy of the configured store specifications.
+func (a *Attribute) GetStores() Stores {
+	if a == nil {
+		return nil
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	stores := Stores{}
+	for n, s := range a.stores {
+		stores[n] = s
+	}
+	return stores
+}
+
+// GetProvider provides the key provider for a configured key store.
+func (a *Attribute) GetProvider(ctx Context, name string) (keyprovider.KeyProvider, error) {
+	if a == nil {
+		return nil, errors.ErrUnknown(keyprovider.KIND_KEYSTORE, name)
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if p := a.providers[name]; p != nil {
+		return p, nil
+	}
+	spec, ok := a.stores[name]
+	if !ok {
+		return nil, errors.ErrUnknown(keyprovider.KIND_KEYSTORE, name)
+	}
+	p, err := keyprovider.Create(vfsattr.Get(ctx), name, &spec)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := p.(io.Closer); ok {
+		ctx.Finalizer().Close(c)
+	}
+	a.providers[name] = p
+	return p, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Get provides the key stores configured for a context.
+// If nothing is configured, nil is returned.
+func Get(ctx ContextProvider) *Attribute {
+	a := ctx.OCMContext().GetAttributes().GetAttribute(ATTR_KEY)
+	if a == nil {
+		return nil
+	}
+	return a.(*Attribute)
+}
+
+func Set(ctx ContextProvider, stores Stores) error {
+	return ctx.OCMContext().GetAttributes().SetAttribute(ATTR_KEY, New(stores))
+}
+
+// GetProvider provides the key provider for a key store configured
+// for a context.
+func GetProvider(ctx ContextProvider, name string) (keyprovider.KeyProvider, error) {
+	return Get(ctx).GetProvider(ctx.OCMContext(), name)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystoreattr_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keystoreattr"
+	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider/file"
+)
+
+var store = keyprovider.StoreSpec{
+	Type: file.TYPE,
+	Path: "/secure/release.p12",
+}
+
+var _ = Describe("attribute", func() {
+	var cfgctx config.Context
+	var ocmctx ocm.Context
+
+	BeforeEach(func() {
+		ocmctx = ocm.New(datacontext.MODE_EXTENDED)
+		cfgctx = ocmctx.ConfigContext()
+	})
+
+	It("marshal/unmarshal", func() {
+		cfg := keystoreattr.NewConfig()
+		cfg.AddStore("release", store)
+		data := Must(json.Marshal(cfg))
+
+		r := &keystoreattr.Config{}
+		Expect(json.Unmarshal(data, r)).To(Succeed())
+		Expect(r).To(Equal(cfg))
+	})
+
+	It("decode", func() {
+		data := Must(json.Marshal(keystoreattr.Stores{"release": store}))
+		r := Must(keystoreattr.AttributeType{}.Decode(data, runtime.DefaultYAMLEncoding))
+		Expect(r.(*keystoreattr.Attribute).GetStores()).To(Equal(keystoreattr.Stores{"release": store}))
+	})
+
+	It("applies config", func() {
+		other := keyprovider.StoreSpec{Type: "pkcs11", Path: "/usr/lib/softhsm/libsofthsm2.so", Token: "signing"}
+
+		cfg := keystoreattr.NewConfig()
+		cfg.AddStore("release", store)
+		MustBeSuccessful(cfgctx.ApplyConfig(cfg, "from test"))
+		cfg = keystoreattr.NewConfig()
+		cfg.AddStore("hsm", other)
+		MustBeSuccessful(cfgctx.ApplyConfig(cfg, "from test"))
+		Expect(keystoreattr.Get(ocmctx).GetStores()).To(Equal(keystoreattr.Stores{"release": store, "hsm": other}))
+	})
+
+	It("provides key providers", func() {
+		MustBeSuccessful(keystoreattr.Set(ocmctx, keystoreattr.Stores{"release": store}))
+
+		p := Must(keystoreattr.GetProvider(ocmctx, "release"))
+		Expect(p).To(BeAssignableToTypeOf(&file.Provider{}))
+		Expect(keystoreattr.GetProvider(ocmctx, "release")).To(BeIdenticalTo(p))
+
+		_, err := keystoreattr.GetProvider(ocmctx, "hsm")
+		Expect(err).To(MatchError(`key store "hsm" is unknown`))
+	})
+
+	It("provides no stores by default", func() {
+		Expect(keystoreattr.Get(ocmctx)).To(BeNil())
+		_, err := keystoreattr.GetProvider(ocmctx, "release")
+		Expect(err).To(MatchError(`key store "release" is unknown`))
+	})
+})
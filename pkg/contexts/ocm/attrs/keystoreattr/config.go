@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keystoreattr
+
+import (
+	cfgcpi "github.com/open-component-model/ocm/pkg/contexts/config/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+)
+
+const (
+	ConfigType   = "keystores" + cfgcpi.OCM_CONFIG_TYPE_SUFFIX
+	ConfigTypeV1 = ConfigType + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cfgcpi.RegisterConfigType(configType{cfgcpi.NewConfigType[*Config](ConfigType)})
+	cfgcpi.RegisterConfigType(configType{cfgcpi.NewConfigType[*Config](ConfigTypeV1)})
+}
+
+// configType provides the usage based on the key store
+// types registered when it is requested.
+type configType struct {
+	cfgcpi.ConfigType
+}
+
+func (configType) Usage() string {
+	return usage()
+}
+
+// Config describes a set of key stores.
+type Config struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	Stores                      Stores `json:"stores"`
+}
+
+// NewConfig creates a new key store config.
+func NewConfig() *Config {
+	return &Config{
+		ObjectVersionedType: runtime.NewVersionedTypedObject(ConfigType),
+		Stores:              Stores{},
+	}
+}
+
+func (a *Config) GetType() string {
+	return ConfigType
+}
+
+func (a *Config) AddStore(name string, spec keyprovider.StoreSpec) {
+	if a.Stores == nil {
+		a.Stores = Stores{}
+	}
+	a.Stores[name] = spec
+}
+
+func (a *Config) ApplyTo(ctx cfgcpi.Context, target interface{}) error {
+	t, ok := target.(Context)
+	if !ok {
+		return cfgcpi.ErrNoContext(ConfigType)
+	}
+	for n, s := range a.Stores {
+		if s.Type == "" {
+			return errors.Newf("applying config failed: type required for key store %q", n)
+		}
+	}
+	stores := Get(t).GetStores()
+	if stores == nil {
+		stores = Stores{}
+	}
+	for n, s := range a.Stores {
+		stores[n] = s
+	}
+	return errors.Wrapf(Set(t, stores), "applying config failed")
+}
+
+func usage() string {
+	return `
+The config type <code>` + ConfigType + `</code> can be used to define
+key stores. Keys held by a key store can be used for signing and verification
+with a key reference of the form <code>&lt;store>:&lt;alias></code>.
+
+The following store types are supported:
+` + keyprovider.Usage() + `
+The passphrase or PIN of a key store is taken from the
+credentials (property <code>password</code>) configured for the consumer type
+<code>` + keyprovider.CONSUMER_TYPE + `</code> with the identity attribute
+<code>name</code> set to the store name.
+
+<pre>
+    type: ` + ConfigType + `
+    stores:
+      release:
+        type: file
+        path: /secure/release.p12
+</pre>
+`
+}
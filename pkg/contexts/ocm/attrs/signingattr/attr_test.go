@@ -13,7 +13,10 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/config"
 	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/tsaattr"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
 )
 
 const NAME = "test"
@@ -47,4 +50,15 @@ var _ = Describe("attribute", func() {
 		Expect(signingattr.Get(ocmctx).GetPublicKey(NAME)).To(Equal([]byte("keydata")))
 	})
 
+	It("applies time stamping authority", func() {
+		cfg := signingattr.New()
+		cfg.SetTimestampAuthority("https://tsa.acme.org/tsr", common.Properties{"token": "secret"})
+
+		Expect(cfgctx.ApplyConfig(cfg, "from test")).To(Succeed())
+		Expect(tsaattr.Get(ocmctx)).To(Equal("https://tsa.acme.org/tsr"))
+		creds, err := tsa.GetCredentials(ocmctx, "https://tsa.acme.org/tsr")
+		Expect(err).To(Succeed())
+		Expect(creds.GetProperty(tsa.ATTR_TOKEN)).To(Equal("secret"))
+	})
+
 })
@@ -11,10 +11,14 @@ import (
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 
+	"github.com/open-component-model/ocm/pkg/common"
 	cfgcpi "github.com/open-component-model/ocm/pkg/contexts/config/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/tsaattr"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/runtime"
 	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
 	"github.com/open-component-model/ocm/pkg/utils"
 )
 
@@ -33,6 +37,15 @@ type Config struct {
 	runtime.ObjectVersionedType `json:",inline"`
 	PublicKeys                  map[string]KeySpec `json:"publicKeys"`
 	PrivateKeys                 map[string]KeySpec `json:"privateKeys"`
+	// TimestampAuthority optionally describes an RFC 3161 time stamping
+	// authority used to timestamp created signatures.
+	TimestampAuthority *TimestampAuthority `json:"timestampAuthority,omitempty"`
+}
+
+// TimestampAuthority describes an RFC 3161 time stamping authority.
+type TimestampAuthority struct {
+	URL         string            `json:"url"`
+	Credentials common.Properties `json:"credentials,omitempty"`
 }
 
 type RawData []byte
@@ -147,12 +160,38 @@ func (a *Config) AddPrivateKeyData(name string, data []byte) {
 	a.addKeyData(&a.PrivateKeys, name, data)
 }
 
+// SetTimestampAuthority configures the time stamping authority used to
+// timestamp signatures.
+func (a *Config) SetTimestampAuthority(url string, creds common.Properties) {
+	a.TimestampAuthority = &TimestampAuthority{URL: url, Credentials: creds}
+}
+
 func (a *Config) ApplyTo(ctx cfgcpi.Context, target interface{}) error {
 	t, ok := target.(Context)
 	if !ok {
 		return cfgcpi.ErrNoContext(ConfigType)
 	}
-	return errors.Wrapf(a.ApplyToRegistry(Get(t)), "applying config failed")
+	if err := a.ApplyToRegistry(Get(t)); err != nil {
+		return errors.Wrapf(err, "applying config failed")
+	}
+	return errors.Wrapf(a.applyTimestampAuthority(t), "applying config failed")
+}
+
+func (a *Config) applyTimestampAuthority(ctx Context) error {
+	if a.TimestampAuthority == nil {
+		return nil
+	}
+	if a.TimestampAuthority.URL == "" {
+		return errors.Newf("time stamping authority URL required")
+	}
+	if len(a.TimestampAuthority.Credentials) > 0 {
+		id := tsa.GetConsumerId(a.TimestampAuthority.URL)
+		if id == nil {
+			return errors.ErrInvalid("time stamping authority URL", a.TimestampAuthority.URL)
+		}
+		ctx.CredentialsContext().SetCredentialsForConsumer(id, credentials.NewCredentials(a.TimestampAuthority.Credentials))
+	}
+	return tsaattr.Set(ctx, a.TimestampAuthority.URL)
 }
 
 func (a *Config) ApplyToRegistry(registry signing.KeyRegistryFuncs) error {
@@ -190,5 +229,18 @@ public and private keys. A key value might be given by one of the fields:
        &lt;name>:
          data: &lt;base64 encoded key representation>
        ...
+    timestampAuthority:
+       url: &lt;URL of RFC 3161 time stamping authority>
+       credentials:
+         username: &lt;user>
+         password: &lt;password>
 </pre>
+
+If a <code>timestampAuthority</code> is configured, signatures created
+by signing operations are timestamped by this authority. The optional
+credentials are registered for the consumer type
+<code>` + tsa.CONSUMER_TYPE + `</code>. Alternatively, a
+bearer <code>token</code> can be given. The timestamp is stored together
+with the signature and verifications validate certificates as of the
+timestamped time.
 `
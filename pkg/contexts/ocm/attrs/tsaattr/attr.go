@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tsaattr
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+)
+
+const (
+	ATTR_KEY   = "ocm.software/signing/tsa"
+	ATTR_SHORT = "tsa"
+)
+
+func init() {
+	datacontext.RegisterAttributeType(ATTR_KEY, AttributeType{}, ATTR_SHORT)
+}
+
+type AttributeType struct{}
+
+func (a AttributeType) Name() string {
+	return ATTR_KEY
+}
+
+func (a AttributeType) Description() string {
+	return `
+*string*
+URL of an RFC 3161 time stamping authority. If set, signatures created
+by a signing operation are timestamped by this authority. Credentials for
+the authority are taken from the credentials context using the consumer type
+<code>` + tsa.CONSUMER_TYPE + `</code>.
+`
+}
+
+func (a AttributeType) Encode(v interface{}, marshaller runtime.Marshaler) ([]byte, error) {
+	if _, ok := v.(string); !ok {
+		return nil, fmt.Errorf("URL string required")
+	}
+	return marshaller.Marshal(v)
+}
+
+func (a AttributeType) Decode(data []byte, unmarshaller runtime.Unmarshaler) (interface{}, error) {
+	var value string
+	err := unmarshaller.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	if value != "" {
+		if _, err := url.Parse(value); err != nil {
+			return nil, errors.ErrInvalidWrap(err, "time stamping authority URL", value)
+		}
+	}
+	return value, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Get provides the URL of the time stamping authority configured for a
+// context. If nothing is configured, an empty string is returned.
+func Get(ctx datacontext.Context) string {
+	a := ctx.GetAttributes().GetAttribute(ATTR_KEY)
+	if a == nil {
+		return ""
+	}
+	return a.(string)
+}
+
+func Set(ctx datacontext.Context, url string) error {
+	return ctx.GetAttributes().SetAttribute(ATTR_KEY, url)
+}
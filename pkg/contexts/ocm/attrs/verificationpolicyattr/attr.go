@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verificationpolicyattr
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	ocm "github.com/open-component-model/ocm/pkg/contexts/ocm/context"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	ATTR_KEY   = "ocm.software/signing/verificationpolicies"
+	ATTR_SHORT = "verificationpolicies"
+)
+
+type (
+	Context         = ocm.Context
+	ContextProvider = ocm.ContextProvider
+)
+
+func init() {
+	datacontext.RegisterAttributeType(ATTR_KEY, AttributeType{}, ATTR_SHORT)
+}
+
+type AttributeType struct{}
+
+func (a AttributeType) Name() string {
+	return ATTR_KEY
+}
+
+func (a AttributeType) Description() string {
+	return `
+*JSON*
+List of signature verification policies enforced when verifying
+component versions. Every policy has the following format:
+
+<pre>
+{
+  "name": "&lt;policy name>",
+  "components": [ "&lt;component name pattern>" ],
+  "signatures": [
+    {
+      "name": "&lt;signature name>",
+      "issuer": "&lt;distinguished name>",
+      "algorithms": [ "&lt;signing algorithm>" ],
+      "maxAge": "&lt;duration>",
+      "rootCertificates": [ { "path": "&lt;file path>" } ]
+    }
+  ]
+}
+</pre>
+
+The issuer is a distinguished name according to RFC 4514 (or a plain
+common name). The maximum age is checked against the creation time of
+the component descriptor.
+`
+}
+
+func (a AttributeType) Encode(v interface{}, marshaller runtime.Marshaler) ([]byte, error) {
+	switch p := v.(type) {
+	case Policies:
+		return json.Marshal(p)
+	case *Policies:
+		return json.Marshal(p)
+	}
+	return nil, fmt.Errorf("verification policy list required")
+}
+
+func (a AttributeType) Decode(data []byte, unmarshaller runtime.Unmarshaler) (interface{}, error) {
+	var value Policies
+	err := unmarshaller.Unmarshal(data, &value)
+	if err != nil {
+		return nil, err
+	}
+	return &value, value.Validate()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Get provides the verification policies configured for a context.
+// If nothing is configured, nil is returned.
+func Get(ctx ContextProvider) Policies {
+	a := ctx.OCMContext().GetAttributes().GetAttribute(ATTR_KEY)
+	if a == nil {
+		return nil
+	}
+	return *a.(*Policies)
+}
+
+func Set(ctx ContextProvider, policies Policies) error {
+	return ctx.OCMContext().GetAttributes().SetAttribute(ATTR_KEY, &policies)
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verificationpolicyattr_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/config"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+var policy = verificationpolicyattr.Policy{
+	Name:       "production",
+	Components: []string{"acme.org/prod/*"},
+	Signatures: []verificationpolicyattr.SignatureRequirement{
+		{
+			Issuer:     "CN=Release-Team",
+			Algorithms: []string{"RSASSA-PKCS1-V1_5"},
+			MaxAge:     "30d",
+		},
+	},
+}
+
+var _ = Describe("attribute", func() {
+	var cfgctx config.Context
+	var ocmctx ocm.Context
+
+	BeforeEach(func() {
+		ocmctx = ocm.New(datacontext.MODE_EXTENDED)
+		cfgctx = ocmctx.ConfigContext()
+	})
+
+	It("marshal/unmarshal", func() {
+		cfg := verificationpolicyattr.New(policy)
+		data := Must(json.Marshal(cfg))
+
+		r := &verificationpolicyattr.Config{}
+		Expect(json.Unmarshal(data, r)).To(Succeed())
+		Expect(r).To(Equal(cfg))
+	})
+
+	It("decode", func() {
+		data := Must(json.Marshal(verificationpolicyattr.Policies{policy}))
+		r := Must(verificationpolicyattr.AttributeType{}.Decode(data, runtime.DefaultYAMLEncoding))
+		Expect(r).To(Equal(&verificationpolicyattr.Policies{policy}))
+	})
+
+	It("rejects invalid policies", func() {
+		p := policy
+		p.Signatures = []verificationpolicyattr.SignatureRequirement{{MaxAge: "a week"}}
+		data := Must(json.Marshal(verificationpolicyattr.Policies{p}))
+		_, err := verificationpolicyattr.AttributeType{}.Decode(data, runtime.DefaultYAMLEncoding)
+		Expect(err).To(MatchError(ContainSubstring(`max age "a week" is invalid`)))
+
+		p.Signatures = []verificationpolicyattr.SignatureRequirement{{Issuer: `CN="Release-Team`}}
+		data = Must(json.Marshal(verificationpolicyattr.Policies{p}))
+		_, err = verificationpolicyattr.AttributeType{}.Decode(data, runtime.DefaultYAMLEncoding)
+		Expect(err).To(MatchError(ContainSubstring(`distinguished name "CN="Release-Team" is invalid`)))
+	})
+
+	It("applies config", func() {
+		other := verificationpolicyattr.Policy{
+			Components: []string{"acme.org/test/**"},
+		}
+		MustBeSuccessful(cfgctx.ApplyConfig(verificationpolicyattr.New(policy), "from test"))
+		MustBeSuccessful(cfgctx.ApplyConfig(verificationpolicyattr.New(other), "from test"))
+		Expect(verificationpolicyattr.Get(ocmctx)).To(Equal(verificationpolicyattr.Policies{policy, other}))
+	})
+
+	It("provides no policies by default", func() {
+		Expect(verificationpolicyattr.Get(ocmctx)).To(BeNil())
+	})
+})
+
+var _ = Describe("policy", func() {
+	It("matches components", func() {
+		p := verificationpolicyattr.Policy{
+			Components: []string{"acme.org/prod/*", "acme.org/test/**"},
+		}
+		Expect(p.Matches("acme.org/prod/a")).To(BeTrue())
+		Expect(p.Matches("acme.org/prod/a/b")).To(BeFalse())
+		Expect(p.Matches("acme.org/test/a")).To(BeTrue())
+		Expect(p.Matches("acme.org/test/a/b")).To(BeTrue())
+		Expect(p.Matches("acme.org/test")).To(BeFalse())
+		Expect(p.Matches("acme.org/dev/a")).To(BeFalse())
+
+		list := verificationpolicyattr.Policies{policy, p}
+		Expect(list.ForComponent("acme.org/prod/a")).To(Equal(verificationpolicyattr.Policies{policy, p}))
+		Expect(list.ForComponent("acme.org/test/a")).To(Equal(verificationpolicyattr.Policies{p}))
+		Expect(list.ForComponent("acme.org/dev/a")).To(BeNil())
+	})
+
+	It("matches issuers", func() {
+		r := verificationpolicyattr.SignatureRequirement{Issuer: "CN=Release-Team"}
+		Expect(r.MatchesIssuer("CN=Release-Team,O=acme")).To(BeTrue())
+		Expect(r.MatchesIssuer("Release-Team")).To(BeTrue())
+		Expect(r.MatchesIssuer("CN=Dev-Team, O=acme")).To(BeFalse())
+		Expect(r.MatchesIssuer("")).To(BeFalse())
+
+		r = verificationpolicyattr.SignatureRequirement{Issuer: "cn=Release-Team, O=acme"}
+		Expect(r.MatchesIssuer("O=acme,CN=Release-Team")).To(BeTrue())
+		Expect(r.MatchesIssuer("Release-Team")).To(BeFalse())
+
+		r = verificationpolicyattr.SignatureRequirement{Issuer: `CN=Release\, Team,O="acme, inc"`}
+		Expect(r.MatchesIssuer(`O=ACME\2C Inc+OU=dev,commonName=release\, team`)).To(BeTrue())
+		Expect(r.MatchesIssuer(`CN=Release,O=acme, inc`)).To(BeFalse())
+
+		r = verificationpolicyattr.SignatureRequirement{Issuer: "OU=a,OU=b"}
+		Expect(r.MatchesIssuer("2.5.4.11=b+CN=x,OU=a")).To(BeTrue())
+		Expect(r.MatchesIssuer("OU=a")).To(BeFalse())
+	})
+
+	It("determines max age", func() {
+		r := verificationpolicyattr.SignatureRequirement{MaxAge: "2d"}
+		Expect(r.GetMaxAge()).To(Equal(48 * time.Hour))
+		r.MaxAge = "90m"
+		Expect(r.GetMaxAge()).To(Equal(90 * time.Minute))
+		r.MaxAge = ""
+		Expect(r.GetMaxAge()).To(Equal(time.Duration(0)))
+	})
+})
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verificationpolicyattr
+
+import (
+	cfgcpi "github.com/open-component-model/ocm/pkg/contexts/config/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	ConfigType   = "verificationpolicies" + cfgcpi.OCM_CONFIG_TYPE_SUFFIX
+	ConfigTypeV1 = ConfigType + runtime.VersionSeparator + "v1"
+)
+
+func init() {
+	cfgcpi.RegisterConfigType(cfgcpi.NewConfigType[*Config](ConfigType, usage))
+	cfgcpi.RegisterConfigType(cfgcpi.NewConfigType[*Config](ConfigTypeV1, usage))
+}
+
+// Config describes a set of signature verification policies.
+type Config struct {
+	runtime.ObjectVersionedType `json:",inline"`
+	Policies                    Policies `json:"policies"`
+}
+
+// New creates a new verification policy config.
+func New(policies ...Policy) *Config {
+	return &Config{
+		ObjectVersionedType: runtime.NewVersionedTypedObject(ConfigType),
+		Policies:            policies,
+	}
+}
+
+func (a *Config) GetType() string {
+	return ConfigType
+}
+
+func (a *Config) AddPolicy(p Policy) {
+	a.Policies = append(a.Policies, p)
+}
+
+func (a *Config) ApplyTo(ctx cfgcpi.Context, target interface{}) error {
+	t, ok := target.(Context)
+	if !ok {
+		return cfgcpi.ErrNoContext(ConfigType)
+	}
+	if err := a.Policies.Validate(); err != nil {
+		return errors.Wrapf(err, "applying config failed")
+	}
+	old := Get(t)
+	policies := make(Policies, 0, len(old)+len(a.Policies))
+	policies = append(append(policies, old...), a.Policies...)
+	return errors.Wrapf(Set(t, policies), "applying config failed")
+}
+
+const usage = `
+The config type <code>` + ConfigType + `</code> can be used to define
+signature verification policies. They are enforced for all component
+versions of components matching the given name patterns, whenever signatures
+are verified (for example with <code>ocm verify componentversions</code>) or
+component versions are transferred. Policies of multiple config objects
+are accumulated.
+
+A policy describes a list of required signatures. Every requirement must
+be met by a valid signature of the component version. A requirement may
+restrict the signature name, the issuer (given as distinguished name
+according to RFC 4514 or common name), the accepted signing algorithms,
+the maximum age of the component version (as duration, additionally
+supporting days with unit <code>d</code>) and the trust roots used to
+validate the certificate of the signature. The issuer requirement is met,
+if all its attributes are contained in the issuer name of the signature.
+Attribute types and values are compared case-insensitively.
+
+The age of a component version is determined by the creation time of its
+component descriptor, not by the time of signing, which is not recorded in
+signatures. Component versions without creation time never meet a maximum
+age requirement. Root certificates are specified like keys with the config
+type <code>keys.config.ocm.software</code>.
+
+Component name patterns use shell file name syntax. A trailing <code>/**</code>
+matches all nested component names.
+
+<pre>
+    type: ` + ConfigType + `
+    policies:
+    - name: production
+      components:
+      - acme.org/prod/*
+      signatures:
+      - issuer: CN=Release-Team
+        algorithms:
+        - RSASSA-PKCS1-V1_5
+        maxAge: 30d
+        rootCertificates:
+        - path: &lt;file path>
+</pre>
+`
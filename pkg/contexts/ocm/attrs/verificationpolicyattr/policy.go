@@ -0,0 +1,344 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verificationpolicyattr
+
+import (
+	"crypto/x509"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+const KIND_POLICY = "verification policy"
+
+// Policies is a list of verification policies.
+type Policies []Policy
+
+// Policy describes the signatures required for component versions
+// of components matching at least one of the given name patterns.
+type Policy struct {
+	Name string `json:"name,omitempty"`
+	// Components is a list of component name patterns.
+	// A pattern uses the syntax of path.Match, additionally
+	// a trailing /** matches any sub component hierarchy.
+	Components []string `json:"components"`
+	// Signatures describes the signatures, which must be present.
+	Signatures []SignatureRequirement `json:"signatures"`
+}
+
+// SignatureRequirement describes a signature required by a policy.
+// All given constraints must be met by a single valid signature.
+type SignatureRequirement struct {
+	// Name is the name of the required signature. If empty, any
+	// signature meeting the other constraints is accepted.
+	Name string `json:"name,omitempty"`
+	// Issuer is a distinguished name (RFC 4514), whose attributes must be
+	// contained in the issuer of the signature. A value without
+	// attribute names is matched against the common name.
+	Issuer string `json:"issuer,omitempty"`
+	// Algorithms is a list of accepted signing algorithms.
+	Algorithms []string `json:"algorithms,omitempty"`
+	// MaxAge is the maximum age of the component version given as
+	// duration. Additionally, the unit d (days) is supported.
+	// The age is determined by the creation time of the component
+	// descriptor, not by the signing time, which is not recorded
+	// in a signature. Component versions without creation time
+	// do not meet this constraint.
+	MaxAge string `json:"maxAge,omitempty"`
+	// RootCertificates is a list of trust roots used to validate the
+	// certificate used to verify the signature.
+	RootCertificates []signingattr.KeySpec `json:"rootCertificates,omitempty"`
+}
+
+func (p Policies) Validate() error {
+	for i, e := range p {
+		if err := e.Validate(); err != nil {
+			return errors.Wrapf(err, "policy %d", i)
+		}
+	}
+	return nil
+}
+
+// ForComponent provides the policies relevant for the given component name.
+func (p Policies) ForComponent(name string) Policies {
+	var result Policies
+	for _, e := range p {
+		if e.Matches(name) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func (p *Policy) GetName() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return strings.Join(p.Components, ",")
+}
+
+func (p *Policy) Validate() error {
+	if len(p.Components) == 0 {
+		return errors.ErrInvalid(KIND_POLICY, p.Name, "no component patterns")
+	}
+	for _, c := range p.Components {
+		if _, err := path.Match(strings.TrimSuffix(c, "/**"), ""); err != nil {
+			return errors.ErrInvalidWrap(err, "component pattern", c)
+		}
+	}
+	for i, s := range p.Signatures {
+		if _, err := s.GetMaxAge(); err != nil {
+			return errors.Wrapf(err, "signature requirement %d", i)
+		}
+		if s.Issuer != "" {
+			if _, err := parseDN(s.Issuer); err != nil {
+				return errors.Wrapf(err, "signature requirement %d", i)
+			}
+		}
+	}
+	return nil
+}
+
+// Matches checks whether the policy is relevant for the given component name.
+func (p *Policy) Matches(name string) bool {
+	for _, c := range p.Components {
+		if strings.HasSuffix(c, "/**") {
+			prefix := strings.TrimSuffix(c, "/**")
+			n := strings.Count(prefix, "/") + 1
+			segs := strings.Split(name, "/")
+			if len(segs) > n {
+				if ok, _ := path.Match(prefix, strings.Join(segs[:n], "/")); ok {
+					return true
+				}
+			}
+			continue
+		}
+		if ok, _ := path.Match(c, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMaxAge provides the configured maximum age. 0 means no restriction.
+func (r *SignatureRequirement) GetMaxAge() (time.Duration, error) {
+	if r.MaxAge == "" {
+		return 0, nil
+	}
+	if strings.HasSuffix(r.MaxAge, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(r.MaxAge, "d"))
+		if err != nil {
+			return 0, errors.ErrInvalidWrap(err, "max age", r.MaxAge)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(r.MaxAge)
+	if err != nil {
+		return 0, errors.ErrInvalidWrap(err, "max age", r.MaxAge)
+	}
+	return d, nil
+}
+
+// AcceptsAlgorithm checks whether a signing algorithm is accepted.
+func (r *SignatureRequirement) AcceptsAlgorithm(algo string) bool {
+	if len(r.Algorithms) == 0 {
+		return true
+	}
+	for _, a := range r.Algorithms {
+		if a == algo {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesIssuer checks whether one of the given issuer names
+// meets the issuer requirement.
+func (r *SignatureRequirement) MatchesIssuer(names ...string) bool {
+	if r.Issuer == "" {
+		return true
+	}
+	req, err := parseDN(r.Issuer)
+	if err != nil {
+		return false
+	}
+	for _, n := range names {
+		if n == "" {
+			continue
+		}
+		dn, err := parseDN(n)
+		if err == nil && dn.contains(req) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRootCertificates provides the configured trust roots.
+// If no roots are configured, nil is returned.
+func (r *SignatureRequirement) GetRootCertificates() (*x509.CertPool, error) {
+	if len(r.RootCertificates) == 0 {
+		return nil, nil
+	}
+	pool := x509.NewCertPool()
+	for i, k := range r.RootCertificates {
+		data, err := k.Get()
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get root certificate %d", i)
+		}
+		switch c := data.(type) {
+		case []byte:
+			if !pool.AppendCertsFromPEM(c) {
+				return nil, errors.Newf("cannot add root certificate %d", i)
+			}
+		default:
+			cert, err := signing.GetCertificate(c)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid root certificate %d", i)
+			}
+			pool.AddCert(cert)
+		}
+	}
+	return pool, nil
+}
+
+// dnAttributeTypes maps long names and object identifiers of the
+// standard attribute types (RFC 4514, section 3) to their short names.
+var dnAttributeTypes = map[string]string{
+	"COMMONNAME":                 "CN",
+	"2.5.4.3":                    "CN",
+	"LOCALITYNAME":               "L",
+	"2.5.4.7":                    "L",
+	"STATEORPROVINCENAME":        "ST",
+	"2.5.4.8":                    "ST",
+	"ORGANIZATIONNAME":           "O",
+	"2.5.4.10":                   "O",
+	"ORGANIZATIONALUNITNAME":     "OU",
+	"2.5.4.11":                   "OU",
+	"COUNTRYNAME":                "C",
+	"2.5.4.6":                    "C",
+	"STREETADDRESS":              "STREET",
+	"2.5.4.9":                    "STREET",
+	"DOMAINCOMPONENT":            "DC",
+	"0.9.2342.19200300.100.1.25": "DC",
+	"USERID":                     "UID",
+	"0.9.2342.19200300.100.1.1":  "UID",
+	"2.5.4.5":                    "SERIALNUMBER",
+	"2.5.4.17":                   "POSTALCODE",
+}
+
+// dn is a parsed distinguished name. It maps the normalized attribute
+// types to the normalized values of all relative distinguished names.
+type dn map[string][]string
+
+// contains checks whether all attribute values of the given name
+// are contained in the name.
+func (n dn) contains(o dn) bool {
+	for k, values := range o {
+	outer:
+		for _, v := range values {
+			for _, c := range n[k] {
+				if c == v {
+					continue outer
+				}
+			}
+			return false
+		}
+	}
+	return true
+}
+
+// parseDN parses a distinguished name according to RFC 4514.
+// Multi-valued RDNs (joined by +) are treated like separate RDNs.
+// Attribute types are matched case-insensitively, long names and
+// object identifiers of the standard types are mapped to their short names.
+// Values are unescaped and compared case-insensitively with
+// insignificant spaces removed (caseIgnoreMatch).
+// A name without attribute names is taken as common name.
+func parseDN(name string) (dn, error) {
+	result := dn{}
+	if !strings.Contains(name, "=") {
+		result["CN"] = []string{normalizeDNValue(name)}
+		return result, nil
+	}
+	for i := 0; i < len(name); {
+		// attribute type
+		j := strings.IndexByte(name[i:], '=')
+		if j < 0 {
+			return nil, errors.ErrInvalid("distinguished name", name)
+		}
+		typ := strings.ToUpper(strings.TrimSpace(name[i : i+j]))
+		if typ == "" {
+			return nil, errors.ErrInvalid("distinguished name", name)
+		}
+		if short := dnAttributeTypes[strings.TrimPrefix(typ, "OID.")]; short != "" {
+			typ = short
+		}
+		i += j + 1
+
+		// attribute value
+		var value []byte
+		quoted := false
+		escaped := false
+		trailing := 0 // number of trailing unescaped spaces
+		for ; i < len(name); i++ {
+			c := name[i]
+			if escaped {
+				escaped = false
+				if isHex(c) && i+1 < len(name) && isHex(name[i+1]) {
+					b, _ := strconv.ParseUint(name[i:i+2], 16, 8)
+					value = append(value, byte(b))
+					i++
+				} else {
+					value = append(value, c)
+				}
+				trailing = 0
+				continue
+			}
+			if c == '\\' {
+				escaped = true
+				continue
+			}
+			if c == '"' {
+				quoted = !quoted
+				continue
+			}
+			if !quoted && (c == ',' || c == ';' || c == '+') {
+				break
+			}
+			if c == ' ' && !quoted {
+				if len(value) == 0 {
+					continue
+				}
+				trailing++
+			} else {
+				trailing = 0
+			}
+			value = append(value, c)
+		}
+		if escaped || quoted {
+			return nil, errors.ErrInvalid("distinguished name", name)
+		}
+		value = value[:len(value)-trailing]
+		result[typ] = append(result[typ], normalizeDNValue(string(value)))
+		if i < len(name) {
+			i++ // skip separator
+		}
+	}
+	return result, nil
+}
+
+func normalizeDNValue(v string) string {
+	return strings.ToLower(strings.Join(strings.Fields(v), " "))
+}
+
+func isHex(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
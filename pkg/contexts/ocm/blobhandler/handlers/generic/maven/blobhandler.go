@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint: gosec // used as checksum file for Maven repositories
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven/identity"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// artifactHandler uploads blobs with a reference hint describing
+// Maven coordinates into a Maven repository.
+type artifactHandler struct {
+	spec *Config
+}
+
+func NewArtifactHandler(spec *Config) cpi.BlobHandler {
+	return &artifactHandler{spec}
+}
+
+func (b *artifactHandler) StoreBlob(blob cpi.BlobAccess, artType, hint string, global cpi.AccessSpec, ctx cpi.StorageContext) (cpi.AccessSpec, error) {
+	if b.spec == nil || b.spec.Url == "" || hint == "" {
+		return nil, nil
+	}
+	coords, err := maven.ParseGAV(hint)
+	if err != nil {
+		// not a Maven artifact
+		return nil, nil
+	}
+
+	cpi.BlobHandlerLogger(ctx.GetContext()).Debug("maven artifact handler",
+		"arttype", artType,
+		"mediatype", blob.MimeType(),
+		"hint", hint,
+		"target", b.spec.Url,
+	)
+
+	url := coords.URL(b.spec.Url)
+
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	sha1sum := sha1.New() //nolint: gosec // see import
+	sha256sum := sha256.New()
+	err = upload(ctx.GetContext(), url, io.TeeReader(r, io.MultiWriter(sha1sum, sha256sum)), blob.Size())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot upload maven artifact %s", coords.GAV())
+	}
+	for suffix, h := range map[string]hash.Hash{".sha1": sha1sum, ".sha256": sha256sum} {
+		sum := []byte(hex.EncodeToString(h.Sum(nil)))
+		err = upload(ctx.GetContext(), url+suffix, bytes.NewReader(sum), int64(len(sum)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot upload checksum for maven artifact %s", coords.GAV())
+		}
+	}
+	return maven.New(b.spec.Url, coords.GroupId, coords.ArtifactId, coords.Version,
+		maven.WithClassifier(coords.Classifier), maven.WithExtension(coords.Extension)), nil
+}
+
+func upload(ctx cpi.Context, url string, r io.Reader, size int64) error {
+	if strings.HasPrefix(url, "file://") {
+		fs := vfsattr.Get(ctx)
+		path := url[7:]
+		err := fs.MkdirAll(vfs.Dir(fs, path), 0o755)
+		if err != nil {
+			return err
+		}
+		f, err := fs.OpenFile(path, vfs.O_CREATE|vfs.O_TRUNC|vfs.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, r)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, url, r)
+	if err != nil {
+		return err
+	}
+	if size >= 0 {
+		req.ContentLength = size
+	}
+	err = identity.BasicAuth(req, ctx, url)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		buf := &bytes.Buffer{}
+		_, err = io.Copy(buf, io.LimitReader(resp.Body, 2000))
+		if err != nil {
+			return errors.Newf("upload request %s provides %s", url, resp.Status)
+		}
+		return errors.Newf("upload request %s provides %s: %s", url, resp.Status, buf.String())
+	}
+	return nil
+}
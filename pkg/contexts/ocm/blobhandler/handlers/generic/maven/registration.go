@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/listformat"
+	"github.com/open-component-model/ocm/pkg/registrations"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+// Config describes the target Maven repository.
+type Config struct {
+	Url string `json:"url"`
+}
+
+func init() {
+	cpi.RegisterBlobHandlerRegistrationHandler("ocm/mavenArtifacts", &RegistrationHandler{})
+}
+
+type RegistrationHandler struct{}
+
+var _ cpi.BlobHandlerRegistrationHandler = (*RegistrationHandler)(nil)
+
+func (r *RegistrationHandler) RegisterByName(handler string, ctx cpi.Context, config cpi.BlobHandlerConfig, olist ...cpi.BlobHandlerOption) (bool, error) {
+	if handler != "" {
+		return true, fmt.Errorf("invalid mavenArtifacts handler %q", handler)
+	}
+	if config == nil {
+		return true, fmt.Errorf("maven target specification required")
+	}
+	cfg, err := registrations.DecodeConfig[Config](config, decodeConfig)
+	if err != nil {
+		return true, errors.Wrapf(err, "blob handler configuration")
+	}
+	if cfg.Url == "" {
+		return true, fmt.Errorf("maven repository url required")
+	}
+
+	ctx.BlobHandlers().Register(NewArtifactHandler(cfg), cpi.NewBlobHandlerOptions(olist...))
+	return true, nil
+}
+
+// decodeConfig accepts a config object or a single string
+// describing the repository URL.
+func decodeConfig(data []byte, unmarshaller runtime.Unmarshaler) (interface{}, error) {
+	var cfg Config
+	if err := unmarshaller.Unmarshal(data, &cfg); err == nil {
+		return &cfg, nil
+	}
+	return &Config{Url: strings.Trim(string(data), "\"")}, nil
+}
+
+func (r *RegistrationHandler) GetHandlers(ctx cpi.Context) registrations.HandlerInfos {
+	return registrations.NewLeafHandlerInfo("uploading Maven artifacts", `
+The <code>mavenArtifacts</code> uploader is able to upload local blobs
+as files of Maven artifacts into a Maven repository. The Maven coordinates
+are taken from the reference hint of the blob, which must have the form
+<code>&lt;groupId>:&lt;artifactId>:&lt;version>[:&lt;classifier>[:&lt;extension>]]</code>.
+Blobs without such a hint are not handled.
+Along with the artifact file, SHA-1 and SHA-256 checksum files are uploaded.
+The blob is replaced by a <code>maven</code> access specification.
+
+It accepts a config with the following fields:
+`+listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		"url", "the base URL of the Maven repository",
+	})+`
+Alternatively, a single string value can be given representing the
+repository URL.`,
+	)
+}
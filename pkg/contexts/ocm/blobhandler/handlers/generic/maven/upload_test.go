@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/localblob"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler"
+	v1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	ctfocm "github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const (
+	COMP    = "github.com/compa"
+	VERS    = "1.0.0"
+	CTF     = "ctf"
+	COPY    = "ctf.copy"
+	CONTENT = "this is some jar content"
+	JARPATH = "/repo/com/example/lib/1.0.0/lib-1.0.0.jar"
+)
+
+var _ = Describe("upload", func() {
+	var env *Builder
+	var server *httptest.Server
+	var lock sync.Mutex
+	var files map[string]string
+
+	BeforeEach(func() {
+		env = NewBuilder()
+		files = map[string]string{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lock.Lock()
+			defer lock.Unlock()
+			switch r.Method {
+			case http.MethodPut:
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				files[r.URL.Path] = string(data)
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodGet:
+				data, ok := files[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(data))
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		}))
+
+		env.OCMCommonTransport(CTF, accessio.FormatDirectory, func() {
+			env.Component(COMP, func() {
+				env.Version(VERS, func() {
+					env.Provider("mandelsoft")
+					env.Resource("lib", "", resourcetypes.BLOB, v1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_JAR, CONTENT)
+						env.Hint("com.example:lib:1.0.0")
+					})
+					env.Resource("other", "", resourcetypes.BLOB, v1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, "other")
+						env.Hint("other")
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		env.Cleanup()
+	})
+
+	It("uploads local blobs with maven hint", func() {
+		ctx := env.OCMContext()
+
+		ctf := Must(ctfocm.Open(ctx, accessobj.ACC_READONLY, CTF, 0o700, env))
+		defer Close(ctf, "ctf")
+		cv := Must(ctf.LookupComponentVersion(COMP, VERS))
+		defer Close(cv, "source version")
+
+		copy := Must(ctfocm.Create(ctx, accessobj.ACC_CREATE, COPY, 0o700, env))
+		defer Close(copy, "copy")
+
+		MustBeSuccessful(blobhandler.RegisterHandlerByName(ctx, "ocm/mavenArtifacts", server.URL+"/repo"))
+		MustBeSuccessful(transfer.TransferVersion(nil, nil, cv, copy, nil))
+
+		sum := sha256.Sum256([]byte(CONTENT))
+		Expect(files[JARPATH]).To(Equal(CONTENT))
+		Expect(files[JARPATH+".sha256"]).To(Equal(hex.EncodeToString(sum[:])))
+		Expect(files).To(HaveKey(JARPATH + ".sha1"))
+		Expect(len(files)).To(Equal(3))
+
+		cv2 := Must(copy.LookupComponentVersion(COMP, VERS))
+		defer Close(cv2, "target version")
+
+		ra := Must(cv2.GetResourceByIndex(0))
+		acc := Must(ra.Access())
+		Expect(acc.GetKind()).To(Equal(maven.Type))
+		spec := Must(ctx.AccessSpecForSpec(acc)).(*maven.AccessSpec)
+		Expect(spec.RepoUrl).To(Equal(server.URL + "/repo"))
+		Expect(spec.GAV()).To(Equal("com.example:lib:1.0.0"))
+		Expect(string(Must(Must(ra.BlobAccess()).Get()))).To(Equal(CONTENT))
+
+		ra = Must(cv2.GetResourceByIndex(1))
+		acc = Must(ra.Access())
+		Expect(acc.GetKind()).To(Equal(localblob.Type))
+	})
+})
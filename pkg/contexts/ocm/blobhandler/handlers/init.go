@@ -5,6 +5,7 @@
 package handlers
 
 import (
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/handlers/generic/maven"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/handlers/generic/ocirepo"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/handlers/oci/ocirepo"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/handlers/ocm/comparch"
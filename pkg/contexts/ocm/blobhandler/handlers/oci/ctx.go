@@ -6,6 +6,7 @@ package oci
 
 import (
 	"reflect"
+	"sync"
 
 	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 
@@ -23,11 +24,22 @@ type StorageContext struct {
 	Repository cpi.Repository
 	Namespace  cpi.NamespaceAccess
 	Manifest   cpi.ManifestAccess
+
+	// layerLock serializes the modification of manifest layers, because
+	// blobs of a component version may be added concurrently.
+	layerLock *sync.Mutex
 }
 
 var _ ocmcpi.StorageContext = (*StorageContext)(nil)
 
-func New(vers ocmcpi.ComponentVersionAccess, impltyp string, ocirepo oci.Repository, namespace oci.NamespaceAccess, manifest oci.ManifestAccess) *StorageContext {
+// New creates a storage context for the given manifest.
+// The lock is used to serialize the modification of the manifest layers.
+// It must be shared by all storage contexts for the same manifest.
+// If nil is given, a new lock is used.
+func New(vers ocmcpi.ComponentVersionAccess, impltyp string, ocirepo oci.Repository, namespace oci.NamespaceAccess, manifest oci.ManifestAccess, lock *sync.Mutex) *StorageContext {
+	if lock == nil {
+		lock = &sync.Mutex{}
+	}
 	return &StorageContext{
 		DefaultStorageContext: *ocmcpi.NewDefaultStorageContext(
 			vers.Repository(),
@@ -40,6 +52,7 @@ func New(vers ocmcpi.ComponentVersionAccess, impltyp string, ocirepo oci.Reposit
 		Repository: ocirepo,
 		Namespace:  namespace,
 		Manifest:   manifest,
+		layerLock:  lock,
 	}
 }
 
@@ -52,6 +65,9 @@ func (s *StorageContext) TargetComponentVersion() ocmcpi.ComponentVersionAccess
 }
 
 func (s *StorageContext) AssureLayer(blob cpi.BlobAccess) error {
+	s.layerLock.Lock()
+	defer s.layerLock.Unlock()
+
 	d := artdesc.DefaultBlobDescriptor(blob)
 	desc := s.Manifest.GetDescriptor()
 
@@ -6,12 +6,15 @@ package compdesc_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/open-component-model/ocm/pkg/testutils"
 
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	compdescv2 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/versions/v2"
 	compdescv3 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/versions/ocm.software/v3alpha1"
 )
 
@@ -168,4 +171,33 @@ spec:
 		Expect(err).To(Succeed())
 		Expect(cd2).To(Equal(cd))
 	})
+
+	DescribeTable("keeps signature timestamps", func(version string) {
+		cd := Must(compdesc.Decode([]byte(CDv2)))
+		cd.Metadata.ConfiguredVersion = version
+		cd.Signatures = append(cd.Signatures, metav1.Signature{
+			Name: "sig",
+			Digest: metav1.DigestSpec{
+				HashAlgorithm:          "SHA-256",
+				NormalisationAlgorithm: compdesc.JsonNormalisationV1,
+				Value:                  "digest",
+			},
+			Signature: metav1.SignatureSpec{
+				Algorithm: "RSASSA-PKCS1-V1_5",
+				Value:     "signature",
+				MediaType: "application/vnd.ocm.signature.rsa",
+			},
+			Timestamp: &metav1.TimestampSpec{
+				Value: "dG9rZW4=",
+				Time:  metav1.NewTimestampPFor(time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)),
+			},
+		})
+		data := Must(compdesc.Encode(cd))
+		Expect(string(data)).To(ContainSubstring("timestamp:"))
+		cd2 := Must(compdesc.Decode(data))
+		Expect(cd2.Signatures).To(Equal(cd.Signatures))
+	},
+		Entry("v2", compdescv2.SchemaVersion),
+		Entry(compdescv3.VersionName, compdescv3.SchemaVersion),
+	)
 })
@@ -166,13 +166,25 @@ func (s *SignatureSpec) ConvertToSigning() *signing.Signature {
 	}
 }
 
+// TimestampSpec describes an RFC 3161 timestamp for the value of a signature.
+// +k8s:deepcopy-gen=true
+// +k8s:openapi-gen=true
+type TimestampSpec struct {
+	// Value is the base64 encoded DER representation of the timestamp token.
+	Value string `json:"value"`
+	// Time is the time attested by the timestamp token.
+	// It is informational only, verification uses the token.
+	Time *Timestamp `json:"time,omitempty"`
+}
+
 // Signature defines a digest and corresponding signature, identifiable by name.
 // +k8s:deepcopy-gen=true
 // +k8s:openapi-gen=true
 type Signature struct {
-	Name      string        `json:"name"`
-	Digest    DigestSpec    `json:"digest"`
-	Signature SignatureSpec `json:"signature"`
+	Name      string         `json:"name"`
+	Digest    DigestSpec     `json:"digest"`
+	Signature SignatureSpec  `json:"signature"`
+	Timestamp *TimestampSpec `json:"timestamp,omitempty"`
 }
 
 // Copy provides a copy of the signature data.
@@ -180,8 +192,7 @@ func (s *Signature) Copy() *Signature {
 	if s == nil {
 		return nil
 	}
-	r := *s
-	return &r
+	return s.DeepCopy()
 }
 
 // ConvertToSigning converts a cd signature to a signing signature.
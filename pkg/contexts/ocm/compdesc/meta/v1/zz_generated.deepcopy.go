@@ -190,6 +190,11 @@ func (in *Signature) DeepCopyInto(out *Signature) {
 	*out = *in
 	out.Digest = in.Digest
 	out.Signature = in.Signature
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(TimestampSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Signature.
@@ -217,6 +222,25 @@ func (in *SignatureSpec) DeepCopy() *SignatureSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampSpec) DeepCopyInto(out *TimestampSpec) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampSpec.
+func (in *TimestampSpec) DeepCopy() *TimestampSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timestamp) DeepCopyInto(out *Timestamp) {
 	*out = *in
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _ResourcesComponentDescriptorOcmV3SchemaYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x1a\x5d\x6f\xdb\x38\xf2\xdd\xbf\x62\xb0\x29\x20\xa7\x89\xe2\x24\x45\x0f\xa8\x5f\x82\x5c\x8b\x03\x8a\xbb\xdd\x2c\xda\xde\x3d\x5c\x9a\x2b\x68\x69\x64\xb3\x4b\x91\x3e\x92\x76\xe2\xed\xf6\xbf\x1f\x48\x8a\x12\x25\x4b\xb6\x65\xbb\xbd\x3b\xec\xf6\xa1\x31\xc9\xf9\xe2\x70\x66\x38\x33\xe2\x33\x9a\x8e\x21\x9a\x69\x3d\x57\xe3\xd1\x68\x4a\x64\x8a\x1c\xe5\x45\xc2\xc4\x22\x1d\xa9\x64\x86\x39\x51\xa3\x44\xe4\x73\xc1\x91\xeb\x38\x45\x95\x48\x3a\xd7\x42\xc6\x22\xc9\xe3\xe5\x0b\xc2\xe6\x33\x72\x15\x0d\x9e\x39\xd8\x80\xd6\x67\x25\x78\xec\x66\x2f\x84\x9c\x8e\x52\x49\x32\x3d\xba\xbe\xbc\xbe\x8c\xaf\xae\x0b\xd2\xd1\xc0\x13\xa4\x82\x8f\x21\xba\x7b\xfd\x23\xbc\xf6\xcc\xe0\x4d\xc9\x0c\x96\x2f\xa0\xc2\xc8\x28\xa7\x06\x41\x8d\x07\x00\x39\x6a\x62\xfe\x02\xe8\xd5\x1c\xc7\x10\x89\xc9\x67\x4c\x74\x64\xa7\xea\xd4\xcb\x6d\xc0\x12\xa5\xa2\x82\x5b\xe4\x94\x68\xe2\xa0\x25\xfe\x7b\x41\x25\xa6\x8e\x1c\x40\x0c\x11\x27\x39\x46\xd5\xb0\xc0\x73\x33\x24\x4d\xad\x18\x84\xfd\x2c\xc5\x1c\xa5\xa6\xa8\xc6\x90\x11\xa6\xd0\xae\xcf\xab\xd9\x82\x82\xa1\xe6\x7f\x03\x3c\x93\x98\x8d\x21\x3a\x19\x05\x3b\xaa\x54\xfd\x53\xc0\xb9\x60\xbb\x05\x55\x22\x23\x4f\x98\xbe\xc7\x7c\x89\xd2\xa3\x32\x32\x41\xa6\xb6\x60\x3a\x20\x8f\x32\x97\x62\x49\x53\x94\x5b\x90\x3c\x98\x47\x4b\x24\x12\xb3\xf2\x81\x86\x9b\x74\x87\xa2\xb4\xa4\x7c\x5a\x4e\x66\x42\xe6\x44\x8f\x21\x25\x1a\x63\x4d\x73\x1c\xd8\x83\x94\x53\xec\x3c\xc9\x75\x65\x12\x36\x15\x92\xea\x59\x5e\x31\x9b\x13\xad\x51\x9a\xa3\xfe\xd7\x3d\x89\x7f\x7d\x30\xff\x5d\xc6\xaf\x46\x9f\xe2\x87\xb3\x67\xa5\x9c\x82\x67\x74\x3a\x86\x2f\xf0\x75\x87\x63\x0c\xf5\x57\x88\x45\xa4\x24\x2b\x47\x8d\x6a\xcc\x4b\x81\xba\x55\x1b\x79\x42\x9d\xdb\xdb\xc1\xf4\x08\x5b\x60\x97\x2e\xea\x86\xd5\xa2\x73\x8b\x3d\x86\x2f\x5f\xbb\x2c\x2a\x50\xdd\xf2\xfe\x32\x7e\x15\x28\x4c\xd1\x29\xa7\x7c\xda\xa4\x1f\x4d\x84\x60\x48\xb8\x07\x0b\xce\xaf\x53\x1b\x16\x66\xbb\xf7\x0c\xcc\x29\x05\x7e\x50\x53\x9b\xdb\x97\x23\x92\x93\xa7\xbf\x21\x9f\xea\xd9\x18\xae\x5f\xbe\x1c\xb4\xda\x40\xec\x8c\xe0\xe1\xf9\xf0\xfe\xe2\xa1\x31\x75\xfa\xdc\xcf\x7d\xb9\x3e\xff\x3a\x1c\xd5\x96\x3f\xb5\xa0\x7c\x32\x38\xa7\x46\x37\x03\x00\x9a\x22\xd7\x54\xaf\x6e\xb5\x96\x74\xb2\xd0\xf8\x57\x5c\x39\x51\x73\xca\x4b\xb9\xda\xa4\x32\xcc\x87\xf7\xf1\xa7\x33\x2f\x88\x9f\x3c\xbd\x71\xa4\x6b\xbe\xec\x68\x9e\x80\x26\xbf\x20\x87\x4c\x8a\x1c\x94\x5d\x30\x71\x15\x08\x4f\x81\xa4\x9f\x17\x4a\x63\x0a\x5a\x00\x61\x4c\x3c\x02\xe1\x20\xe6\x4e\xbf\xc0\x90\xa4\x94\x4f\x21\x5a\x46\xe7\x90\x93\xcf\x26\x78\x73\xb6\x3a\xb7\xa8\x76\x7c\x91\x53\x5e\xcc\x7a\x5e\x33\xaa\x20\x47\xc2\x15\xe8\x19\x42\x26\x0c\x55\x43\xc4\xa9\x5f\x01\x91\x68\x58\x19\xcb\xa2\x69\x5d\x5e\xe5\x05\xbe\xba\xb8\xbe\x78\x11\xfe\x8e\x33\x21\xce\x26\x44\x16\x73\xcb\x10\x60\xd9\x06\x71\x75\x71\xed\x7f\x95\x60\x01\x7c\xf9\xb3\x86\x16\x2a\x7b\xf9\x70\x33\xbc\xfc\xed\xfe\x2a\x7e\xf5\xf0\x31\x7d\x7e\x3a\xbc\x19\x7f\xbc\x08\x27\x4e\x6f\xda\xa7\xe2\xe1\xf0\x66\x5c\x4d\xfe\xf6\x31\xb5\x67\x74\x1b\xff\x33\x7e\x30\xfe\xe1\x7f\x7b\x92\x3b\x02\x9f\x7a\x8e\x67\xc3\x70\xe1\xcc\x12\xa9\xcd\x58\xc8\xc2\x07\x1b\x96\xdf\x66\x7a\xdb\x02\xe7\xca\xf8\x91\x32\x51\xaf\xd5\x31\xdb\x4c\x39\x82\xaf\xce\x14\xe7\x42\x51\x2d\xe4\xea\xb5\xe0\x1a\x9f\x74\x9f\x20\x66\xa0\xba\x82\x96\xa5\xd0\x0c\x2a\xc1\x1e\x45\x42\xdf\xb5\xf3\x26\x8c\xdd\x65\x15\x97\x8e\xdb\xb0\x81\x5a\xc5\xd2\xa6\x9c\x85\xac\x13\xa2\xf0\xef\x92\x45\x55\x4c\x5c\x13\xd9\xfc\x2b\xc0\xc2\xa9\xd6\xd8\xe4\x6f\x9b\x20\x8e\xfd\x48\xe6\xf3\x5a\x24\xdd\x88\x0a\x80\x7c\x91\x8f\xe1\x3e\x5a\x48\xf6\x33\xd1\xb3\xe8\x1c\x22\x35\x23\xd7\x2f\xff\x14\xa7\x74\x8a\x4a\x47\x0f\x83\x06\x9d\xbe\x94\xad\x8e\xa7\x54\x69\xb9\x32\xd4\xef\x5e\xbf\x2d\x87\x0f\xe6\x0c\x48\x92\xa0\x52\x3b\xe6\x57\x46\x33\x16\xca\x5c\xf0\x05\x2a\x2a\x18\x9a\x11\x3e\x69\xe4\xe6\xca\x51\xa7\x5b\x8c\x65\x00\x30\xa5\x7a\xb6\x98\xdc\x6e\xe6\xbd\xd1\xda\xec\xd0\x98\x40\x70\xa0\x76\x26\xdb\xcb\x1a\x9b\x6a\x73\x02\x96\xea\x2f\x18\x6d\x41\x37\x56\xba\x19\x22\x11\x79\x4e\xf5\x26\x9f\xe0\x82\xe3\x21\x7a\x39\x70\xdf\x3f\x09\x8e\xce\x30\x94\x58\xc8\x04\xdf\x94\x0e\xd7\x43\x1c\x93\xac\x94\x83\x22\x11\x29\xc7\x86\x42\x39\x70\x26\xd4\x23\xe7\x59\x13\x7c\xf7\x60\x57\xa0\xe0\x93\x96\xe4\x6d\x01\xb0\x25\x9b\x59\xa3\x73\x84\x7c\x7d\x87\xe3\xd8\x23\xa5\x0f\xdd\xd8\x8e\xf9\xea\x2e\xab\x87\xbf\x56\x2a\x0e\x2f\xda\x0e\x18\x7a\xec\x0e\xe0\xa6\x46\xf4\xc0\x03\x00\x17\xcd\xde\xcf\x31\xe9\x61\x46\x33\xa2\x66\xb7\xbe\x06\xa8\x8c\xcb\x94\x16\x8c\x2a\x5b\x8a\xac\x2f\xdb\x3c\x78\x87\xb4\xbf\xcd\xe0\x6a\x0c\x37\x66\xdb\xed\x42\xec\x90\xa0\xb7\x43\x0c\x5c\x0e\x4e\xf4\x42\x62\x4f\x25\x91\x0d\x1a\x30\xa3\x1c\x53\x4a\x3e\x78\x9f\xeb\xaf\x93\x96\x22\xac\xe7\xe6\xdc\x54\x29\x47\x05\x55\xbf\x5b\x3e\xcc\xd0\x01\xb9\x0b\x46\x64\x36\x2d\x2d\xd5\x02\x41\x79\xb4\x51\x7f\xfb\xc6\x29\x67\xa2\xe5\xb0\xa4\xb7\xa7\xde\xb6\x16\x6c\x8e\xdf\x16\x27\xaf\xfc\x26\xac\xd5\x82\x7d\x76\x62\xd6\xec\xc9\xdd\x2c\x68\x0a\x88\x37\xfb\x78\x62\x97\xa2\xf6\xa9\x53\x9b\x91\xb3\x05\xe6\x28\x21\xba\xb7\x7e\x4b\x15\x95\xfd\x29\xa7\xab\x3e\x17\xf1\xc6\x9b\xaf\x61\x61\x12\x8b\x2b\xd6\x71\x39\x8a\x9d\x7d\x97\x8e\xd3\x9e\x96\xdb\xd8\xef\xda\x1d\x18\x74\x5d\xa0\xd9\x79\xe9\x64\xd3\x34\x6a\x7b\x8c\x4a\x26\xef\x7c\x2a\xb6\x35\xa7\x25\x26\x6d\x43\x89\x3c\x41\x5b\x5c\xc3\xb0\x6a\x86\x32\x91\x10\x76\x5a\xa4\x42\x5d\xf9\x95\xb7\xc0\xf7\xc8\x30\xd1\x62\x5b\x57\xad\xd3\x60\x7b\xdd\xfa\xb6\x6c\x2b\xc4\xde\x77\xa3\xe5\x3e\x77\xed\x50\xb5\x1a\xd2\xe1\x4d\xd3\x96\x4e\x50\x3f\x5b\xde\x94\x28\xc2\x09\x90\x44\x2f\x08\x63\xab\x71\xc5\x29\xb6\x77\xcc\xe3\x08\xd4\x1c\x13\x4a\x98\x31\x4e\x2d\x69\x62\x99\xfc\xff\xe6\x96\x7b\x24\x8e\x4d\x67\x16\x1c\x9b\x89\x63\xa1\x50\xbe\x60\x6c\x87\xcc\xaf\x11\x52\xbd\xd7\x57\x57\x7f\xcf\x2a\xd3\x13\x50\x7d\x5b\xf8\x70\x62\xf1\xad\x0f\x57\x54\xce\x8b\xc6\xd7\x42\x69\xc8\x89\x4e\x66\x81\x1b\xa8\xb5\x90\xbd\x5e\x70\x32\x9b\xf2\x05\x53\x61\x06\xfd\x47\x0d\x53\xee\xca\xc5\xe0\x23\x45\x79\x47\xac\xba\x48\xdc\x21\xec\x5c\xd4\x5a\x13\x88\xce\x21\xc2\x27\x8d\x92\x13\x56\xd6\xf5\xff\x8b\x95\x96\x48\xe8\x9f\x99\xd8\xbd\xd4\xb2\xbb\xfb\x0b\x65\xa8\x56\x4a\x63\xde\x1f\xf7\xae\x8d\xe1\xb7\x8e\x0b\x22\xa1\x6f\x73\x32\x3d\xa8\xd7\x61\x87\xd4\x50\x79\xe7\x6f\xb6\xa3\x34\x41\xc2\x9e\x99\xb7\x94\x3a\x9b\x2d\x5d\xcd\x4a\x9d\x07\x6c\x8c\x91\x95\xf7\xb8\xc3\xf6\x03\x51\x21\x52\x04\x55\x3f\x2b\xeb\xaa\xc3\x6e\xcd\x06\xea\xa9\x82\x29\xc4\x72\xc2\x69\x86\x4a\x37\x2b\xb0\x06\xd3\x3d\xcb\x3c\xa7\x19\x17\x9a\x9d\xa3\x38\x09\x14\x68\xb1\x85\x63\xd3\x50\xd7\xd9\x39\x08\xcf\x4a\x13\x39\x45\x8d\x29\x24\x82\xeb\x32\xf9\xe9\x24\xaf\xe8\xaf\x1b\xf7\x62\xd6\x81\x72\x98\xac\x34\x2a\xcf\x63\x62\x94\xdd\xa4\xcb\x17\xf9\xc4\x1c\xe8\x00\xa0\xd3\x65\x0f\x30\x97\x8c\x32\xac\x6e\xc2\x43\x2d\xa6\x45\xc2\xca\x7a\x3c\xab\x2e\xbd\xf8\xf5\x50\x1d\xa0\x67\x44\x03\x55\x76\xef\x46\xfd\x94\xdb\xb5\x1f\xcc\xa2\xfa\x01\x52\x2a\x6d\xf6\xbc\xea\x3c\x0f\xaf\xb7\xbb\x23\xf9\xd7\x37\x50\xd8\x5d\xd3\xcf\x36\x1b\x67\xdd\x30\xad\xbf\xc3\x23\xd5\xb3\x42\x35\xc9\x42\x4a\xe4\xba\x4a\x50\xa0\x7a\x9c\xb1\x49\x4b\x3e\xb4\xbe\x2b\x72\x9e\x3e\x3a\xda\xf4\x1c\xa2\x4d\x89\x7f\x64\x3f\xdb\xef\x12\x7b\x18\xc7\x4c\x39\xba\xd2\x86\xe0\x42\xfd\x3e\xd7\xf8\x00\xa0\x6a\xf4\x1e\xe0\x8a\x0b\xff\x0d\xe7\xc0\x8b\xdb\x08\x53\x2a\x7a\xb1\xe1\x7b\xcd\x00\x60\x8a\x1c\x25\x4d\xfe\x8b\xdf\x5a\x0a\x09\xdc\xe7\x96\x62\xf0\xbd\x7d\xf6\x38\x8d\xcd\xdf\x99\x4f\x57\x07\xe7\xe6\xbf\x95\x4b\xd7\x4c\xf4\x7b\x25\xe6\xf5\xc7\x61\x7d\x2d\xf0\x9b\xd8\x53\xdf\xce\x98\xda\xd4\xdd\xae\x5f\xc1\xb6\xff\x93\xd1\xc4\x16\x94\xfe\x26\x2e\x32\x43\x33\x0c\xba\x64\xde\xbc\xf4\xbe\x3b\x2d\x3a\x10\x47\x2a\x89\x1b\x9f\x67\x83\x6f\xd0\x2e\x71\x3f\x12\x1f\x59\xaf\xac\xaa\x86\x4e\x7f\xfa\x6b\x95\xf2\x86\xa7\x1d\x55\xd3\x28\xda\x05\xa1\x99\xf2\xec\x84\xd4\x08\xb9\xd1\x60\xd0\x30\x97\xd0\xd2\x4d\xdc\x9c\xd3\x7f\x54\xb1\x35\x86\xe8\x17\xca\xd3\xe2\x67\xf8\xce\x34\x76\x66\x15\x0d\xea\x26\x50\xa1\xd7\x6c\x33\x34\xf5\xa0\x60\xcb\x2f\x1a\x4f\x75\xcb\x97\xb8\xe7\x6e\x59\x89\x4c\x3f\x12\x89\xd5\x82\xcd\x3a\x8d\x4c\x9d\xf4\x13\xc1\x95\x1e\x43\x54\x7e\xe1\x08\xf6\xe3\x77\xe0\x90\x3b\x1e\xf7\xb9\x0d\xae\xbd\xbc\xd9\xed\xf9\x64\xe3\xfc\xbb\x8f\x72\xed\x51\x50\x04\x27\x3e\x1b\x66\xab\x73\x78\x44\x10\x9c\xad\x8a\x87\x70\xb6\x68\x14\x1c\x6b\x8e\xdf\xee\x33\xc5\xd7\x88\xf2\xdb\xd8\x01\xcf\x3e\x4b\x1a\x51\xe3\xd3\xda\x01\x34\xdb\x3f\x3f\x45\xff\x09\x00\x00\xff\xff\x74\x46\x86\xd2\xc2\x2d\x00\x00")
+var _ResourcesComponentDescriptorOcmV3SchemaYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5b\x6f\xdb\x38\x16\x7e\xf7\xaf\x38\x98\x04\xa0\xd3\x44\x76\x92\x6e\x0b\xd4\x2f\x41\x36\xdd\x01\x8a\xdd\x99\x0c\xd2\xee\x3e\x6c\xea\x2d\x68\xe9\xc8\x66\x2b\x91\x5e\x92\x76\xe2\xe9\xf4\xbf\x2f\x48\x8a\xba\x59\xf2\x45\x4e\x8a\x2d\x30\x28\xd0\x58\xd4\xb9\x7e\xfc\x78\x74\x48\xe9\x98\x45\x23\x20\x33\xad\xe7\x6a\x34\x1c\x4e\xa9\x8c\x90\xa3\x1c\x84\x89\x58\x44\x43\x15\xce\x30\xa5\x6a\x18\x8a\x74\x2e\x38\x72\x1d\x44\xa8\x42\xc9\xe6\x5a\xc8\x40\x84\x69\xb0\x7c\x49\x93\xf9\x8c\x5e\x90\xde\xb1\x93\x2d\xd9\xfa\xac\x04\x0f\xdc\xe8\x40\xc8\xe9\x30\x92\x34\xd6\xc3\xcb\xf3\xcb\xf3\xe0\xe2\x32\x33\x4d\x7a\xde\x20\x13\x7c\x04\xe4\xf6\xe6\x17\xb8\xf1\xce\xe0\x6d\xee\x0c\x96\x2f\xa1\xd0\x88\x19\x67\x9a\x09\xae\x46\x3d\x80\x14\x35\x35\x7f\x01\xf4\x6a\x8e\x23\x20\x62\xf2\x19\x43\x4d\xec\x50\xd5\x7a\x9e\x06\x2c\x51\x2a\x26\xb8\x55\x8e\xa8\xa6\x4e\x5a\xe2\x7f\x17\x4c\x62\xe4\xcc\x01\x04\x40\x38\x4d\x91\x14\x97\x99\x9e\x1b\xa1\x51\x64\xc3\xa0\xc9\x6f\x52\xcc\x51\x6a\x86\x6a\x04\x31\x4d\x14\xda\xfb\xf3\x62\x34\xb3\x60\xac\xf9\xdf\x00\xc7\x12\xe3\x11\x90\xa3\x61\x29\xa3\x02\xea\x5f\x4b\x9e\x33\xb7\x5b\x54\x25\x26\xf4\x11\xa3\xf7\x98\x2e\x51\x7a\xd5\x84\x4e\x30\x51\x5b\x34\x9d\x90\x57\x99\x4b\xb1\x64\x11\xca\x2d\x4a\x5e\xcc\xab\x85\x12\xa9\x31\xf7\x81\x95\x93\x74\x93\xa2\xb4\x64\x7c\x9a\x0f\xc6\x42\xa6\x54\x8f\x20\xa2\x1a\x03\xcd\x52\xec\xd9\x89\x94\x53\x6c\x9d\xc9\x75\x30\x69\x32\x15\x92\xe9\x59\x5a\x38\x9b\x53\xad\x51\x9a\xa9\xfe\xcf\x3d\x0d\x7e\x1f\x9b\xff\xce\x83\x37\xc3\x4f\xc1\xf8\xf4\x38\x8f\x53\xf0\x98\x4d\x47\xf0\x15\xbe\xed\x30\x8d\x65\xfc\xb2\xb0\xa8\x94\x74\xe5\xac\x31\x8d\x69\x1e\x50\x3b\xb4\xc4\x1b\x6a\x4d\x6f\x07\xea\xd1\x64\x81\x6d\x58\x70\xba\x05\x73\xab\x3d\x82\xaf\xdf\xda\x18\x55\x82\x6e\x79\x7f\x1e\xbc\x29\x01\xa6\xd8\x94\x33\x3e\xad\xdb\x27\x13\x21\x12\xa4\xdc\x8b\x95\xe6\xaf\x15\x0d\x2b\xb3\x7d\xf5\xf4\x00\x2a\xeb\xa0\x02\x9b\xcb\xcb\x19\x49\xe9\xe3\x3f\x90\x4f\xf5\x6c\x04\x97\xaf\x5e\xf5\x1a\x39\x10\x38\x12\x8c\x5f\xf4\xef\x07\xe3\xda\xd0\xc9\x0b\x3f\xf6\xf5\xf2\xec\x5b\x7f\x58\xb9\xfd\xa9\x41\xe5\x93\xd1\x39\x31\xd8\xf4\x00\x58\x84\x5c\x33\xbd\xba\xd6\x5a\xb2\xc9\x42\xe3\xdf\x71\xe5\x42\x4d\x19\xcf\xe3\x6a\x8a\xca\x00\xdc\xbf\x0f\x3e\x9d\xfa\x40\xfc\xe0\xc9\x95\x33\x5d\x59\xcb\xce\xe6\x11\x68\xfa\x05\x39\xc4\x52\xa4\xa0\xec\x0d\x53\x57\x81\xf2\x08\x68\xf4\x79\xa1\x34\x46\xa0\x05\xd0\x24\x11\x0f\x40\x39\x08\x5b\xf7\x68\x02\x09\xd2\x88\xf1\x29\x90\x25\x39\x83\x94\x7e\x36\xc5\x9b\x27\xab\x33\xab\x6a\xaf\x07\x29\xe3\xd9\xa8\xf7\x35\x63\x0a\x52\xa4\x5c\x81\x9e\x21\xc4\xc2\x58\x35\x46\x1c\xfc\x0a\xa8\x44\xe3\x0a\x96\x34\x61\x51\x35\xde\x8c\x96\x47\x70\x31\xb8\x1c\xbc\x2c\xff\x0e\x62\x21\x4e\x27\x54\x66\x63\xcb\xb2\xc0\xb2\x49\xe2\x62\x70\xe9\x7f\x65\x7f\x97\xc5\x8f\xfc\xde\xf2\xa2\xa2\x56\x06\x7b\x39\xbe\xea\x9f\xff\x71\x7f\x11\xbc\x19\x7f\x8c\x5e\x9c\xf4\xaf\x46\x1f\x07\xe5\x81\x93\xab\xe6\xa1\xa0\xdf\xbf\x1a\x15\x83\x7f\x7c\x8c\xec\x1c\x5d\x07\xff\x0e\xc6\x66\x7d\xf8\xdf\xde\xe4\x8e\xc2\x27\xde\xe3\x69\xbf\x7c\xe3\xd4\x0c\x0d\x2a\x23\x56\xf2\x98\x34\x31\xbf\x89\x7a\xdb\x0a\xe7\xca\x3c\x4f\x94\xa9\x7a\x8d\x0b\xb3\x89\xca\x04\xbe\x39\x2a\xce\x85\x62\x5a\xc8\xd5\x8d\xe0\x1a\x1f\xf5\x3e\x45\xcc\x48\xb5\x15\x2d\x73\xcf\xff\x6e\xca\x51\x84\xec\xae\xd9\x37\x4d\x92\xdb\xd8\xab\x06\xcd\x19\xad\x85\xed\xeb\x54\xb0\x16\x67\x16\xeb\x84\x2a\xfc\xa7\x4c\xbc\x5c\x53\xc8\xe6\x5f\x26\x56\x1e\x5a\x8b\xde\x0f\xd7\xea\xd8\x2f\x74\x3e\xaf\x54\xd2\x8d\xaa\x00\xc8\x17\xe9\x08\xee\xc9\x42\x26\xbf\x51\x3d\x23\x67\x40\xd4\x8c\x5e\xbe\x7a\x1d\x44\x6c\x8a\x4a\x93\x71\x49\xbc\x0a\xe7\x8e\x96\x2d\xc6\x53\xa6\xb4\x5c\x19\xeb\xb7\x37\xef\xf2\xcb\xb1\x99\x03\x1a\x86\xa8\xb2\xf4\x1b\x66\xbc\xda\x5f\x19\x64\x2c\x12\x10\x0b\x99\xa9\xa2\x82\xbe\xb9\xc2\x47\x8d\xdc\xf4\x5c\xea\x64\x0b\x59\x7a\x00\x53\xa6\x67\x8b\xc9\xf5\x66\xdf\xad\x06\xf2\x4b\x43\x81\xd2\x84\xda\x91\xb8\x13\x1b\xeb\xb0\xb9\x00\x73\xf8\x33\x47\x5b\xd4\x0d\x4b\x37\x4b\x84\x22\x4d\x99\x6e\x15\xea\x01\x70\xc1\xf1\x10\x5c\x0e\xcc\xfb\x57\xc1\xd1\x11\x43\x89\x85\x0c\xf1\x6d\xbe\xe0\xf6\x08\xc7\x34\x2b\xf9\x45\xd6\x88\xe4\xd7\xc6\x42\x7e\xe1\x28\xb4\x47\xcf\xb3\x16\xf8\xee\xc5\x2e\x53\xc1\x47\x2d\xe9\xbb\x4c\x60\xb4\xa7\x1d\xd2\xd6\x5d\x35\xaa\x57\x9e\x99\x64\xf7\xe9\xe8\xd0\xd2\x97\x97\xb1\xf9\x47\xf9\xaa\x28\xa0\x1b\x8a\xa8\xd3\x23\xdb\x05\xcb\x2b\x76\x07\x71\xb3\xdf\xf4\xc2\x3d\x00\x57\xcd\xde\xcf\x31\xdc\x83\x46\x33\xaa\x66\xd7\x7e\x0f\x90\x8f\x72\xb3\xb5\x48\x98\xb2\x5b\x91\xf5\xdb\xb6\x0f\xde\xa1\xed\x6f\x22\x5c\xc5\x61\x7d\x8e\x2a\xdd\x76\x73\x10\x1b\x55\x6c\x60\x2d\x12\x66\xb9\xb1\x29\xa7\x7a\x21\x71\x4f\x90\xf2\x4d\x52\x03\x02\x06\x8f\x14\x23\x46\x3f\xac\xe6\x5d\x31\xa1\x07\x27\xe7\x37\x0f\x59\x1c\x85\x54\xf5\xd9\xf2\x61\x86\x4e\xc8\x6a\x83\x88\x6d\x5b\x9a\xc3\xe2\xd2\x22\xcd\x2e\x7a\x00\x66\x93\xa9\x34\x4d\xe7\x05\x7e\x55\xfb\x77\x3f\xdf\xc0\xcb\x8b\xd7\x17\x85\xe4\x46\x1f\x3b\x81\x7f\x08\xd9\x6a\x90\x55\x83\x35\x0f\xda\xd7\x7f\x01\xe4\xa1\x88\x30\x82\xb7\x7f\xbb\x2b\x85\xad\xc5\x17\xe4\x2d\x48\x64\x43\x1d\x77\xe8\x39\x12\x5d\x8b\xbd\x5b\xe7\xf9\x65\x6e\xaf\x23\x46\x5b\x77\xbd\xce\x5f\x21\xd2\x58\x8b\x8a\xe2\xe3\x41\xab\xe5\xd9\xaa\x99\xcb\x95\x95\xf3\x89\xd8\xa2\x9c\xcb\x39\x65\xf3\x6c\x47\xb3\x85\x7b\xdb\xa5\x16\xb6\xa1\xdc\x01\xb3\xb5\x67\x57\x83\xcc\x93\x3c\x24\xf7\x9e\x9c\x1c\xa2\xfc\x84\xd0\x61\xa5\xba\x02\x95\xa5\xda\x04\x9c\x91\x95\x98\x35\x39\x76\x58\x3d\x09\x49\x1b\x93\xac\xec\x11\x48\xdb\x3c\xec\xde\x43\x74\xa4\x7d\x2d\xdf\x42\x7f\xfd\xdc\x6b\xed\xec\xab\xd5\x4d\x9d\xd4\x96\xe9\x4a\x86\x77\x18\xb7\x4e\x5b\xb5\xd8\x51\x90\x18\xa3\x44\x1e\xa2\x3d\xde\x80\x7e\x8e\x57\x90\x88\x90\x26\x27\xe0\xe2\x6e\xeb\x70\x3d\x03\xdf\x63\x82\xa1\x16\xb2\x2b\x61\xf7\xea\xbb\x7a\x50\x84\xdd\x35\xd1\x3c\xcf\x5d\xcf\x08\x1b\x89\x74\xf8\xb1\x75\xc5\xec\x96\xfc\x1b\x43\xd8\xd4\xaa\xc3\x11\xd0\x50\x2f\x68\x92\xac\x46\x85\xa7\xc0\x08\xc1\xc3\x10\xd4\x1c\x43\x46\x13\x90\x68\x2a\x50\x68\x02\x57\x9b\x23\xf8\x7f\xee\xee\x3b\xb4\xee\xf5\xc5\x2c\x38\xd6\x5b\xf7\x0c\x50\xbe\x48\x92\x1d\x7a\xef\x5a\x49\xf5\xab\xbe\x68\xbe\xb6\x12\xb5\xba\xcf\xf7\x06\xd4\xae\x2c\xf5\x6c\x84\x23\x7b\x4e\x60\xd7\x70\x61\xe5\x2c\x3b\x7a\x5c\x28\x0d\x29\xd5\xe1\xac\x20\x05\x51\x1e\xfb\xa6\xad\x6d\xb6\xc1\x4f\x6c\xd3\x5d\x1a\x2a\xef\x61\xb6\x95\xe7\x2a\x35\x37\x4f\xd3\x0f\xbe\x8b\x74\x35\x58\xad\x49\x75\xaa\xf2\xce\x98\xd7\xf2\x93\xb0\x25\x82\xe2\x58\xc1\x52\xc0\x9c\x3f\x99\x53\x22\xc9\x69\x42\xc6\xdd\x17\xcc\xb3\xef\x75\x45\xc8\xfe\x9a\x88\xdd\x37\xbb\x36\xbb\x9f\x59\x82\x6a\xa5\x34\xa6\xfb\xeb\xde\x36\x39\x7c\xee\xba\x20\x42\xf6\x2e\xa5\xd3\x83\x4e\x9b\xec\x25\x33\x56\xee\xfc\x93\xad\x6d\x1d\xee\x75\x0c\x55\x3e\xb5\xf4\x4c\xa9\xba\x69\x35\xe5\x32\x2b\xe0\x3c\x20\xb1\x84\xae\x50\x3e\x45\x3e\x40\xb2\x90\x08\x8c\x9b\xce\x0a\xab\xd5\xf7\xda\x24\x50\x6d\x15\xcc\x36\x35\xa5\x9c\xc5\xe6\x4c\x78\xb3\xd3\x8e\x1b\x6d\x37\xe5\xae\x34\xbb\x85\xe2\x22\x50\xa0\xc5\x16\x8f\x75\xa2\xae\xbb\x73\x12\xde\x95\xa6\x72\x8a\xe6\x5d\x56\x68\x8e\xfc\xb9\xde\x62\x5e\xb1\xdf\x37\xe6\x62\xee\x03\xe3\x30\x59\x69\x54\xde\xc7\xc4\x80\x5d\xb7\xcb\x17\xe9\xc4\x4c\xa8\x79\x59\xdb\xb6\x64\x0f\xa0\x4b\xcc\x12\x2c\x9e\x84\x87\x32\xa6\x21\xc2\x82\x3d\xde\x55\x1b\x2e\xfe\x7e\x19\x0e\xd0\x33\xaa\x81\x29\x9b\xbb\x81\x9f\x71\x3b\xf3\x3f\x99\x9b\xea\x27\x88\x98\xb4\xdd\xf3\x8a\xb4\xc5\xe8\x71\xbb\x7d\xa2\xf5\xf5\x0c\x80\xdd\xd6\xd7\xd9\x66\x72\x56\x89\x69\xd7\x3b\x3c\x30\x3d\xcb\xa0\x09\x17\x52\x9a\xaf\x49\xf2\x06\x25\x57\x17\x92\xb4\x05\x56\x2a\xad\x77\x59\xcf\xb3\x0f\x46\x2d\xbd\x54\x2b\x88\x7f\x76\x3f\x8d\xdd\x4f\x4e\x0c\xe2\x27\xe3\x29\x5b\x8e\x46\x15\xef\xe8\x7b\x3e\xc6\x7b\x00\xc5\x51\xfb\x01\x4b\x71\x21\x93\x36\x0a\xed\x05\xb6\x09\x26\x07\x7a\xb1\xe1\x8d\x99\x79\x09\x68\x3e\x44\x63\xe1\x21\xb1\x1f\x18\x6d\x16\x01\x19\x97\xc2\xf9\xde\x6b\xf6\xd0\x03\xa7\x96\x14\x77\x5b\x8b\x3f\xea\x9a\x2e\x26\xee\x79\x97\x74\xe6\xe7\xfb\x36\xe6\xd5\xcf\xf3\xf6\x65\xe0\xb3\xf0\x69\x2f\x7c\xcd\xf9\xdf\xa6\xd3\xed\xea\x23\xd8\x9e\xff\xc4\x2c\xb4\xbb\x7a\xff\x24\xce\x3a\x43\x73\x59\x3a\x25\xf3\xf4\xd2\x5d\x33\xcd\x4e\x20\xd6\x92\xed\xb6\x25\xae\xbd\x20\xf7\xfa\x79\xe3\xfe\x44\x7e\x72\x7b\x85\x83\xae\x79\xac\xed\x94\x5b\x29\xe9\x7d\x7c\x28\x15\xae\x8d\x0a\xf5\x96\x67\x27\xa5\x5a\xc9\x25\xbd\x5e\x8d\x2e\x65\xa6\x9b\x5e\x67\xce\xfe\x55\xd4\xd6\x00\xc8\x17\xc6\xa3\xec\x67\xf9\x4b\xdf\xc0\xd1\x8a\xf4\xaa\x14\x28\xd4\x47\xbd\x16\xaa\x67\x05\x06\x88\x08\xd3\x41\xed\x63\xe9\xfc\x5b\xe8\x33\x77\x5b\x89\x58\x3f\x50\x89\xc5\x0d\xdb\x75\x9a\x98\x5a\xed\x87\x82\x2b\x3d\x02\x92\xbf\xe1\x28\xe5\xe3\x33\x70\xca\x8d\x80\x19\x11\xd2\xf4\xc9\x96\xaa\x78\x2c\xf1\xa0\xc2\x81\xda\xfc\xb7\x4f\xe5\xda\x67\x59\x04\x8e\x7c\x37\x6c\xbe\x2b\x7c\x40\x10\x3c\x59\x65\x9f\x22\xda\x4d\xa3\xe0\x58\x59\xf8\x8d\xa6\x55\xf6\x36\x22\x7f\xb1\xb6\x5b\xdc\x9b\x5f\xce\x91\xda\xab\xb5\x03\x6c\x36\xbf\x7e\x22\xff\x1b\x00\x8c\xd6\x6b\x5a\x44\x2f\x00\x00")
 
 func ResourcesComponentDescriptorOcmV3SchemaYamlBytes() ([]byte, error) {
 	return bindataRead(
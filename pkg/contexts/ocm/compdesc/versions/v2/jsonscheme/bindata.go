@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _ResourcesComponentDescriptorV2SchemaYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x6f\x1b\xb9\x11\x7f\xd7\x5f\x31\x38\x07\xa0\x1c\x7b\x2d\x47\x45\x0a\x44\x2f\x86\x9b\x43\x81\xa0\xbd\xf3\x21\x49\xfb\x50\xc7\x0d\xa8\xdd\x91\xc4\x94\x4b\xaa\x24\xa5\x58\x97\xf3\xff\x7e\x20\xb9\xdc\xef\x5d\x7d\xd9\xb9\x0b\x10\x3f\x24\x22\x77\x38\x33\x1c\xfe\xe6\x83\xb3\xfb\x8c\x25\x13\x20\x0b\x63\x96\x7a\x32\x1a\xcd\xa9\x4a\x50\xa0\xba\x88\xb9\x5c\x25\x23\x1d\x2f\x30\xa5\x7a\x14\xcb\x74\x29\x05\x0a\x13\x25\xa8\x63\xc5\x96\x46\xaa\x68\x3d\x26\x83\x67\x9e\xa2\xc4\xe1\x93\x96\x22\xf2\xb3\x17\x52\xcd\x47\x89\xa2\x33\x33\x1a\x5f\x8e\x2f\xa3\x17\xe3\x8c\x21\x19\x04\x36\x4c\x8a\x09\x90\x9b\x25\x0a\x78\x1d\x64\xc0\x4f\x32\x41\x0e\xeb\x31\x14\xd4\x33\x26\x98\x25\xd6\x93\x01\x40\x8a\x86\xda\xff\x01\xcc\x66\x89\x13\x20\x72\xfa\x09\x63\x43\xdc\x54\x95\x73\xae\x38\x14\x8a\xbb\xf5\x09\x35\xd4\x2f\x50\xf8\xff\x15\x53\x98\x78\x8e\x00\x11\x10\x2f\xf7\xdf\xa8\x34\x93\xc2\x53\x2d\x95\x5c\xa2\x32\x0c\x75\xa0\xab\x10\x85\xc9\x5c\x25\x6d\x14\x13\x73\x32\x70\xea\xaa\x39\x76\xea\xdb\x64\x4c\xf9\x5c\x2a\x66\x16\x69\xc1\x74\x49\x8d\x41\x65\x37\xf4\xdf\x5b\x1a\xfd\x7a\x67\xff\xb9\x8c\x5e\x8d\x3e\x46\x77\x67\xcf\x48\x46\x16\x4b\x31\x63\xf3\x09\x7c\x81\x07\x37\x43\x93\xc4\xd9\x8c\xf2\x5f\x0a\x19\x30\xa3\x5c\xe3\x00\x80\xd3\x29\xf2\x4e\xad\x5a\x8c\x22\x68\x8a\xa4\x18\xae\x29\x5f\x61\xd7\x16\x2c\x6d\xa7\x49\xfc\xa4\x5b\x3f\x81\x2f\x0f\x61\x5c\x37\x64\x69\xcf\xeb\xdb\xcb\xe8\x55\x69\xa7\x9a\xcd\x05\x13\xf3\x86\x84\xa9\x94\x1c\xa9\x08\x64\x25\xc3\xdb\xbf\x67\x0a\x67\x13\x20\x27\xa3\x12\x9c\x46\x8e\xc6\x1d\x53\x0e\x95\x9f\x73\xe5\x5b\x14\x4f\xe9\xfd\x3f\x51\xcc\xcd\x62\x02\xe3\x97\x2f\x07\xad\x87\x13\xf9\xd3\xb9\x7b\x3e\xbc\xbd\xb8\xab\x4d\x9d\x3e\x0f\x73\x5f\xc6\xe7\x0f\xc3\x51\xe5\xf1\xc7\x96\x25\x1f\xed\x9a\x53\xbb\xf7\x01\x00\x4b\x50\x18\x66\x36\xd7\xc6\x28\x36\x5d\x19\xfc\x07\x6e\xbc\xaa\x29\x13\xb9\x5e\x6d\x5a\x59\xe1\xc3\xdb\xe8\xe3\x59\x50\x24\x4c\x9e\x5e\x79\xd6\x0a\x39\xbd\xc7\xe4\x1d\xa6\x6b\x54\x9e\xe7\x09\x18\xfa\x3f\x14\x30\x53\x32\x05\xed\x1e\x58\x97\x06\x2a\x12\xa0\xc9\xa7\x95\x36\x98\x80\x91\x40\x39\x97\x9f\x81\x0a\x90\x4b\x8f\x37\xe0\x48\x13\x26\xe6\x40\xd6\xe4\x1c\x52\xfa\x49\xaa\x48\x0a\xbe\x39\x77\x4b\xdd\xf8\x22\x65\x22\x9b\x0d\xb2\x16\x4c\x43\x8a\x54\x68\x30\x0b\x84\x99\xb4\x5c\x2d\x13\x6f\x7e\x0d\x54\xa1\x15\x65\x91\xc3\x92\xaa\xbe\x3a\x28\xfc\xe2\x62\x7c\xf1\x97\xf2\xef\x68\x26\xe5\xd9\x94\xaa\x6c\x6e\x5d\x26\x58\xb7\x51\xbc\xb8\x18\x87\x5f\x39\x59\x89\x3e\xff\x59\x59\x56\x36\xf6\xfa\xee\x6a\x78\xf9\xdb\xed\x8b\xe8\xd5\xdd\x87\xe4\xf9\xe9\xf0\x6a\xf2\xe1\xa2\x3c\x71\x7a\xd5\x3e\x15\x0d\x87\x57\x93\x62\xf2\xb7\x0f\x89\x3b\xa3\xeb\xe8\x3f\xd1\x9d\xc5\x7f\xf8\x1d\x58\xee\x48\x7c\x1a\x24\x9e\x0d\xcb\x0f\xce\x1c\x93\xca\x8c\xa3\xcc\x7c\xac\x19\xc5\x1a\xd0\xdb\x16\xd1\x36\xd6\x8f\xb4\x0d\x47\xad\x8e\xd7\x06\x65\x02\x0f\x1e\x8a\x4b\xa9\x99\x91\x6a\xf3\x5a\x0a\x83\xf7\x66\x9f\x30\x65\xa9\xba\xc2\x92\xe3\xd0\x13\xa9\x65\xcc\xde\xb6\xcb\xa6\x9c\xdf\xcc\x0a\x29\xad\x3b\x6a\xa8\x5d\x44\xcb\xba\x9e\x99\xae\x53\xaa\xf1\x5f\x8a\x93\x22\xe6\x35\x54\xb6\x7f\x19\x59\x79\xaa\x23\xa8\xfa\x34\x50\x8a\x63\x3f\xd1\xe5\xb2\x12\x29\x7b\x97\x02\xa0\x58\xa5\x13\xb8\x25\x2b\xc5\x7f\xa1\x66\x41\xce\x81\xe8\x05\x1d\xbf\xfc\x6b\x94\xb0\x39\x6a\x43\xee\x06\x35\x3e\xfb\x72\x76\x36\x9e\x33\x6d\xd4\xc6\x72\xbf\x79\xfd\x26\x1f\xde\xd9\x33\xa0\x71\x8c\x5a\xef\x98\xde\xad\x65\x1c\x15\xcc\xa4\xca\x96\xa2\x86\xa1\x1d\xe1\xbd\x41\x61\x53\x8a\x3e\xdd\x02\x96\x01\xc0\x9c\x99\xc5\x6a\x7a\xdd\x2f\xbb\x17\x6d\x6e\x68\x21\x50\x3a\x50\x37\x33\x3b\x08\x8d\x75\xb3\x79\x05\x73\xf3\x67\x82\xb6\x2c\xb7\x28\xed\xa7\x88\x65\x9a\x32\xd3\xe7\x13\x42\x0a\x3c\xc6\x2e\x47\xee\xfb\x67\x29\xd0\x03\x43\xcb\x95\x8a\xf1\xc7\xdc\xe1\xf6\x50\xc7\x96\x23\xf9\x20\x2b\x34\xf2\xb1\xe5\x90\x0f\x3c\x84\x0e\xaf\x6a\x3a\xaa\x8c\xd6\x60\x97\x2d\xc1\x7b\xa3\xe8\x9b\x8c\x60\x4b\xb5\xd2\xe0\x43\xba\xaa\xa7\x8e\x08\x55\xca\x99\x64\xf7\xe3\x70\xb5\xa2\x6e\x10\x51\xa5\xe8\xa6\xd8\x39\x33\x98\x56\xe2\x56\xab\x0e\x8e\x57\x58\x54\x76\x76\x37\x16\x9b\x9b\x59\x35\x48\xb6\x32\xf1\xeb\xc8\x76\xc2\xb2\x5f\xef\x40\x6e\x2f\x31\x81\x78\x00\xe0\x63\xde\xbb\x25\xc6\x7b\x80\x6d\x41\xf5\xe2\x3a\x94\xf0\x05\x04\xa5\x4a\x29\x67\x9a\x5a\x41\xcd\xc7\xae\x1a\xee\x80\x5d\x85\x61\xfd\x10\xfc\x41\x05\x80\xb6\x0a\xe9\x5d\xe2\xcb\xf0\x76\x8a\x81\xaf\xb4\xa9\x59\x29\xdc\xd3\x08\xb4\x67\x87\x76\x94\x62\xc2\xe8\xfb\xe0\x79\x3b\xdd\x81\xf6\x54\xde\x4f\xe5\x72\x0a\xaa\x6a\x06\x79\xbf\x40\x4f\xe4\xd3\x88\x9c\xb9\xe2\x33\xdf\x36\x94\xae\x39\xbd\xf6\x39\x34\x1a\xe5\x0c\xf6\x88\x39\x95\x1d\x7a\x8c\x6e\x71\xfc\x02\xc8\xe5\x2b\x54\x49\xf1\xce\x95\x15\x00\xf8\x84\x80\xb6\xee\xff\xf1\x10\xd7\xc8\x77\x7e\xc0\x3e\x1b\x11\xae\x85\xe6\x51\x42\xe9\xde\x06\xcd\x6d\x92\x77\x30\xbc\x71\xf6\x49\x98\x9d\x19\x6a\x9b\xa5\x5a\xb5\xab\x94\x80\x8f\x90\x22\x0e\xc4\x98\xc2\x2c\x67\x97\xcd\x01\x47\xe6\x8f\x3a\xfc\x9c\xfd\xb5\x8a\xdf\x86\x5a\x67\x6b\xd1\x48\x6d\x5d\x84\x0a\x45\x8c\xee\xf6\x0a\xc3\xa2\xbd\xc5\x65\x4c\xf9\x69\x56\x6b\x74\x15\x30\x01\x3a\xef\x90\x63\x6c\xa4\x3a\x14\x69\x4f\x90\x56\xcb\x7d\x8c\xb7\x61\x97\x87\xda\x25\xe7\xb4\x6b\x4f\xa8\x15\x77\x11\x90\x75\x7f\x27\xad\xa5\xf3\xb2\x1f\xb4\xfb\x0a\x33\x38\x01\x1a\x9b\x15\xe5\x7c\x33\x29\x24\x45\x2e\xda\x7f\x1e\x81\x5e\x62\xcc\x28\xb7\x58\x35\x8a\xc5\x4e\xc8\xb7\x5b\xcb\x3d\x59\xa1\x56\x8f\x00\x52\x60\xbd\x50\xcb\x64\x89\x15\xe7\x3b\x54\x5a\xb5\x00\x1a\x42\x45\x91\xaa\xf7\xbc\xfb\x05\x06\x7a\xe7\xfe\x65\x86\x49\x38\x71\xeb\x9d\xe3\x17\x5c\xce\xb3\x76\xd4\x4a\x1b\x48\xa9\x89\x17\x25\x67\xd0\x8d\x2b\x44\xf3\x1a\xc8\x5d\x09\x56\x9a\x2a\x57\xac\xdf\x6f\x16\xf9\xae\x7c\xe0\x7e\x24\xc4\x7a\x66\x45\xf6\xf1\x87\xb0\xf3\x55\xd3\x41\x80\x9c\x03\xc1\x7b\x83\x4a\x50\x9e\xdf\xb6\xbf\xdd\xfb\x8f\x8c\xd9\xdf\xb8\xdc\xfd\x02\xe4\x6c\xf0\x77\xc6\x51\x6f\xb4\xc1\x74\xff\xb5\x37\x6d\x02\x9f\x3a\x7a\xc8\x98\xbd\x49\xe9\xfc\xa8\x3e\x85\x1b\x32\xcb\x25\xcf\x9b\x8f\xd2\xc0\x28\xf7\xbb\x02\x9e\xaa\x62\xb6\x74\x24\x0b\x73\x1e\xb1\x31\x4e\x37\xc1\x2f\x8f\xdb\x0f\x90\x4c\x25\x02\x45\x2f\x6a\xd6\x75\xbb\xba\xb6\x1b\xa8\x96\x15\xf6\x7a\x95\x52\xc1\x66\xa8\x4d\xfd\x5e\x55\x13\x7a\xe0\xe5\xcd\x5b\xc6\x07\x70\xef\x28\x5e\x03\x0d\x46\x6e\x91\x58\x07\x6a\x53\x9c\xa7\x08\xa2\x0c\x55\x73\x34\x98\x40\x2c\x85\xc9\x0b\xa5\x4e\xf6\x9a\xfd\xda\xbb\x17\xfb\x1c\x98\x80\xe9\xc6\xa0\x0e\x32\xa6\xd6\xd8\x75\xbe\x62\x95\x4e\xed\x81\x0e\x00\x3a\x5d\xf6\x08\xb8\xcc\x18\xc7\x22\x5f\x1e\x8b\x98\x16\x0d\x0b\xf4\x04\x51\x5d\x76\x09\xcf\xcb\xe6\x00\xb3\xa0\x06\x98\x76\x7b\xb7\xe6\x67\xc2\x3d\xfb\xc1\x3e\xd4\x3f\x40\xc2\x94\x2b\xcc\x37\x9d\xe7\x11\xec\x76\xf3\x48\xfe\xf5\x04\x06\xbb\xa9\xfb\x59\x3f\x38\xab\xc0\x74\xfe\x0e\x9f\x99\x59\x64\xa6\x89\x57\x4a\xa1\x30\xd0\xf6\x42\xbc\xcf\x4a\x21\xb4\xbe\xcd\x2a\xa3\x7d\x6c\xd4\x51\x71\x75\x1a\xf1\x7b\x8d\xb4\x3d\x97\xb8\xc3\xf8\xfa\x85\x49\x57\x71\x51\x4a\xbb\x5f\x27\xd9\x0f\x00\x8a\x26\xed\x11\x0e\xbb\x0a\x6f\x69\x8e\x4c\xef\x56\x99\xfc\x38\x56\x3d\x6f\x64\x06\x00\x73\x14\xa8\x58\xfc\x07\xbe\x4d\xc9\x34\xf0\x2f\x54\xb2\xc1\x77\xcf\xfe\x13\x78\x76\x71\x30\x7e\xfe\x8f\x75\xec\x0a\x50\xbf\x56\x11\x9f\x67\xa6\x9d\xdb\x55\x7b\xf7\xa7\x9a\x38\x6d\xbc\xb3\xd7\xa5\x87\x4b\x25\xd7\x2c\x29\x4e\x34\x02\x52\x69\x32\x54\x7b\x5e\x79\x3d\xaf\x2b\xfc\x2b\x2b\xfe\x14\xdd\xdc\x58\xa1\xbb\x18\xbf\x67\x4d\xb7\xbb\x0d\x08\x3d\xcf\x8e\xb1\x78\xdf\x3f\x93\x2a\xa5\x66\x02\x09\x35\x18\x19\x96\xf7\xab\x9b\x26\xdc\x1b\xb6\x8d\x6b\x6f\xdf\x7d\xb6\xf1\x85\x06\x81\x93\x50\xde\xf0\xcd\x39\x7c\x46\x90\x82\x6f\xb2\xaf\x92\xdc\x2d\x40\x8a\xa0\x6c\x38\xd2\x2d\x8e\xf9\x64\xee\x97\xa1\xe1\x91\xfa\x1d\xb5\x37\xe2\xf9\x01\x37\x21\xf9\x38\x02\x9b\x8c\xeb\xad\xfe\xa7\x3c\xfb\x72\x8f\x90\xec\x08\x96\x4a\xed\xba\xd3\xa2\x5a\x56\x74\xa1\xa9\xdd\xa4\xf0\xe5\x61\x30\x18\xd4\xe2\x54\x39\x08\x45\x40\x52\xf4\x9f\x99\x96\x03\x05\x19\x54\xc3\x40\xf1\x39\x6b\xc7\x17\x8a\x9e\x45\x2d\x3e\xf6\x1f\x10\x29\xbf\x9b\xac\xd6\x1a\xa5\x03\xa9\x1c\x46\xff\xeb\x3f\x52\x7b\xf3\x77\x04\xcf\xf6\x97\x65\xe4\xf7\x00\x00\x00\xff\xff\xa3\xb7\xcb\x40\x89\x2c\x00\x00")
+var _ResourcesComponentDescriptorV2SchemaYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x6f\xdb\x38\x12\x7f\xf7\x5f\x31\xd8\x14\xa0\xd3\x44\x71\xe2\xbd\x16\xa8\x5f\x82\x5c\x7b\x0b\x14\x77\xbb\x59\xa4\xbd\x7b\xb8\xd4\x57\xd0\xd2\xd8\x66\x57\x22\x7d\x24\xed\xc6\xdb\xed\xff\x7e\x20\x25\x52\x1f\xa6\xe4\xaf\xa4\xe8\x01\x8b\x2e\x36\x16\x35\x5f\x9c\xf9\xcd\x70\x48\xf1\x19\x4b\x46\x40\xe6\x5a\x2f\xd4\x68\x30\x98\x51\x99\x20\x47\x79\x11\xa7\x62\x99\x0c\x54\x3c\xc7\x8c\xaa\x41\x2c\xb2\x85\xe0\xc8\x75\x94\xa0\x8a\x25\x5b\x68\x21\xa3\xd5\x90\xf4\x9e\xe5\x14\x15\x09\x9f\x94\xe0\x51\x3e\x7a\x21\xe4\x6c\x90\x48\x3a\xd5\x83\xe1\xe5\xf0\x32\xba\x1a\x16\x02\x49\xcf\x89\x61\x82\x8f\x80\xdc\x2e\x90\xc3\x6b\xa7\x03\x7e\x16\x09\xa6\xb0\x1a\x42\x49\x3d\x65\x9c\x69\x26\xb8\x1a\xf5\x00\x32\xd4\xd4\xfc\x05\xd0\xeb\x05\x8e\x80\x88\xc9\x27\x8c\x35\xb1\x43\x75\xc9\xde\x70\x3f\x2e\xa4\xe5\x4f\xa8\xa6\x39\x83\xc4\xff\x2e\x99\xc4\x24\x97\x08\x10\x01\xc9\xf5\xfe\x0b\xa5\x62\x82\xe7\x54\x0b\x29\x16\x28\x35\x43\xe5\xe8\x6a\x44\x6e\xd0\x9b\xa4\xb4\x64\x7c\x46\x7a\xd6\x5c\x39\xc3\x56\x7b\x37\x05\xd3\x74\x26\x24\xd3\xf3\xac\x14\xba\xa0\x5a\xa3\x34\x13\xfa\xcf\x3d\x8d\x7e\x1f\x9b\xff\x5d\x46\xaf\x06\x1f\xa3\xf1\xd9\x33\x52\x90\xc5\x82\x4f\xd9\x6c\x04\x5f\xe0\xab\x1d\xa1\x49\x62\x7d\x46\xd3\x5f\x4b\x1d\x30\xa5\xa9\xc2\x1e\x40\x4a\x27\x98\xb6\x5a\x15\x70\x0a\xa7\x19\x3a\x55\x11\x90\x15\x4d\x97\xd8\x36\x05\x43\xeb\x7e\x6f\xb8\xc4\x0c\x01\x58\xfe\x11\x7c\xf9\x5a\x90\xad\x9a\x8e\xac\xcc\x79\x75\x7f\x19\xbd\xaa\xcc\x54\xb1\x19\x67\x7c\xb6\xa1\x61\x22\x44\x8a\xb4\x88\x58\xcd\xf1\xe6\xbf\x67\x12\xa7\x23\x20\x27\x83\x0a\x9c\x06\x96\xc6\x86\xc9\x43\xe5\x17\x6f\x7c\xc0\xf0\x8c\x3e\xfc\x03\xf9\x4c\xcf\x47\x30\x7c\xf1\xa2\x17\x0c\x4e\x94\x47\x67\xfc\xbc\x7f\x7f\x31\x6e\x0c\x9d\x3e\x77\x63\x5f\x86\xe7\x5f\xfb\x83\xda\xeb\x8f\x01\x96\x8f\x86\xe7\xd4\xcc\xbd\x07\xc0\x12\xe4\x9a\xe9\xf5\x8d\xd6\x92\x4d\x96\x1a\xff\x8e\xeb\xdc\x0b\x19\xe3\xde\xae\x90\x55\xc6\x81\xfd\xfb\xe8\xe3\x99\x33\xc4\x0d\x9e\x5e\xe7\xa2\x25\xa6\xf4\x01\x93\x77\x98\xad\x50\xe6\x32\x4f\x40\xd3\xdf\x90\xc3\x54\x8a\x0c\x94\x7d\x61\x52\x1a\x28\x4f\x80\x26\x9f\x96\x4a\x63\x02\x5a\x00\x4d\x53\xf1\x19\x28\x07\x61\xd3\x8e\xa6\x90\x22\x4d\x18\x9f\x01\x59\x91\x73\xc8\xe8\x27\x21\x23\xc1\xd3\xf5\xb9\x65\xb5\xcf\x17\x19\xe3\xc5\xa8\xd3\x35\x67\x0a\x32\xa4\x5c\x81\x9e\x23\x4c\x85\x91\x6a\x84\xe4\xa9\xa4\x80\x4a\x34\xaa\x0c\x72\x58\x52\xb7\xb7\x00\xde\x09\x5c\x5d\x0c\x2f\x7e\xac\xfe\x8e\xa6\x42\x9c\x4d\xa8\x2c\xc6\x56\x55\x82\x55\x88\xe2\xea\x62\xe8\x7e\x15\x7f\x57\xe5\x0f\xff\x6e\x75\x55\x63\xab\x3a\x7b\x35\xbe\xee\x5f\xfe\x71\x7f\x15\xbd\x1a\x7f\x48\x9e\x9f\xf6\xaf\x47\x1f\x2e\xaa\x03\xa7\xd7\xe1\xa1\xa8\xdf\xbf\x1e\x95\x83\x7f\x7c\x48\x6c\x8c\x6e\xa2\x7f\x47\x63\x83\x7f\xf7\xdb\x89\xdc\x91\xf8\xd4\x69\x3c\xeb\x57\x5f\x9c\x99\xa1\x8b\xda\x88\xa5\x7c\x46\x42\xc8\x0f\x41\x6f\x5b\x45\x5b\x9b\x3c\x52\xa6\x1c\x05\x13\x2f\x04\x65\x02\x5f\x73\x28\x2e\x84\x62\x5a\xc8\xf5\x6b\xc1\x35\x3e\xe8\x7d\xca\x94\xa1\x6a\x2b\x4b\xe6\x9d\xfb\x1d\x9a\xa3\x88\xd9\x5d\x58\x37\x4d\xd3\xdb\xa9\x63\x8d\xc2\x33\xda\x30\xdb\xd5\xa1\x68\xc3\xce\xc2\xd6\x09\x55\xf8\x4f\x99\x3a\xba\x90\xc9\xe6\x5f\x41\x56\x1d\xda\xb0\xde\x0d\x37\xea\xd8\xcf\x74\xb1\xa8\x55\xca\x4e\x56\x00\xe4\xcb\x6c\x04\xf7\x64\x29\xd3\x5f\xa9\x9e\x93\x73\x20\x6a\x4e\x87\x2f\x5e\x46\x09\x9b\xa1\xd2\x64\x5c\x21\xaf\xbb\x73\x47\xc9\xd6\xc7\x33\xa6\xb4\x5c\x1b\xe9\xb7\xaf\xdf\xfa\xc7\xb1\x89\x01\x8d\x63\x54\xc5\xf4\x03\x11\xaf\x2f\xef\xc6\x33\xd6\x13\x30\x15\xb2\x60\x45\x05\x7d\xf3\x84\x0f\x1a\xb9\x59\x52\xd4\xe9\x16\xb0\xf4\x00\x66\x4c\xcf\x97\x93\x9b\x6e\xdd\xad\x02\xfc\xa3\x81\x40\x25\xa0\x76\x64\x7a\x10\x1a\x9b\x6e\xcb\x0d\xf4\xee\x2f\x14\x6d\x61\x37\x28\xed\xa6\x88\x45\x96\x31\xdd\x4a\xd4\x03\xe0\x82\xe3\x31\x7e\x39\x72\xde\xbf\x08\x8e\x39\x30\x94\x58\xca\x18\xdf\xf8\x84\xdb\xc3\x1c\xd3\x8e\xf8\x87\xa2\xd1\xf0\xcf\x46\x82\x7f\xc8\x21\x74\x78\x57\x03\x7b\x14\xbb\x82\x05\x1f\xb4\xa4\x6f\x0b\x82\xd1\x9e\x72\x48\x5b\xf7\x14\x64\xaf\xad\x99\x64\xf7\x70\xd8\x5e\x51\x6d\x10\x51\x29\xa9\x9f\x06\x00\xd3\x98\x55\x88\x5a\x6c\xb0\xb2\x1c\x53\x35\xd9\xcd\x3f\xca\xd7\x65\x99\xed\x28\xb5\x39\x1f\xd9\x4e\x58\xcd\xeb\x1d\xc8\xcd\x36\xc8\x11\xf7\x00\xf2\x9a\xf7\x6e\x81\xf1\x1e\x60\x9b\x53\x35\xbf\x71\x2d\xbc\x1f\xe5\x42\x66\x34\x65\x8a\x9a\xb5\x62\xf3\xb5\xed\x86\x5b\x60\x57\x13\xd8\x0c\x42\x0e\xbf\x62\x30\xac\xa4\x93\xc5\x2a\x6e\xa1\x30\x49\xc7\x66\x9c\xea\xa5\xc4\x3d\x9d\xe0\xf7\x30\x81\x19\x9a\xf9\x66\x98\x30\xfa\x7e\xbd\x68\x9b\x33\x3d\xda\x78\xb7\x05\x28\xf4\x94\x54\xf5\x15\xe4\xfd\x1c\x73\x22\xcb\x0d\x62\x6a\x9b\x4f\x3f\x6d\xa8\x6c\x73\x36\x54\xf4\x00\x34\xcb\x50\x69\x9a\x2d\x4a\xff\xd4\xe5\xdf\xfd\xf4\x1a\x7e\xbc\x7a\x79\x55\x52\x76\xea\xd8\xc9\xb9\x5d\x60\x69\xb8\xa4\x6e\x8c\x59\x2e\x5f\xfe\x05\x90\xc7\x22\xc1\x04\xde\xfc\xed\xae\x62\x96\x16\xbf\x21\x6f\x99\x69\x31\xc4\x36\xeb\x5f\x8d\x00\xcc\x32\x9c\x51\x3d\x82\x84\x6a\x8c\x8c\xec\x1a\x88\x0e\x2d\xd9\x5e\xc0\x1e\x85\xb9\x66\x58\x9e\xc8\x25\x49\x30\xf9\xcb\x6c\x77\x5e\x68\x18\xde\xca\xe9\xe9\xaa\xcc\xde\xb3\x5b\x98\x3d\x5d\xce\x6c\x96\x5c\x34\x3b\xab\x37\x87\x14\x1f\xef\xb6\x03\x9c\xb4\xb1\x86\x04\x68\x1e\x65\xb1\xda\x3b\x1a\xde\x27\xfe\x8c\x28\x77\x8e\x3a\xc4\x33\xcd\x1e\x60\x9b\xa7\x82\xd6\xd5\x9a\x6c\xd2\xe6\xc0\xdd\x17\xe1\x03\x01\x2a\xb1\xe8\x8a\xaa\xee\x38\x76\x85\x6e\xc2\xcf\x62\x52\xc9\xf8\x0e\xa7\xad\xfe\xae\xd7\x19\x0a\x12\xa7\x28\x91\xc7\x68\xcf\x07\xa0\xef\xfd\x15\xa5\x22\xa6\xe9\x29\xe4\x76\xb7\xb5\x88\x0e\x3a\xef\x30\xc5\x58\x0b\xb9\xc5\x31\xad\x48\x7b\x82\xc6\xa5\x7a\x52\x74\xe7\x66\x79\xa8\x5f\xbc\xa4\x36\xd4\x36\x4f\xdd\x82\xb8\x33\xa7\x71\xdd\x67\x95\x35\xb6\x51\xaf\x73\x9e\x41\x15\x5d\xad\x2f\x9c\x00\x8d\xf5\x92\xa6\xe9\x7a\x54\x6a\x8a\x0c\x11\x7c\x1e\x80\x5a\x60\xcc\x68\x0a\x12\x4d\x25\x89\x8d\x2b\x54\xb7\x05\xdf\x73\xb7\xfc\x64\xad\x70\xb3\x02\x08\x8e\xcd\x56\xb8\xd0\xc5\x97\x69\xba\x43\x2f\xdb\x28\xa0\xae\x54\x94\xcd\xd0\x56\xb8\xd6\x77\xd7\x4e\x80\xda\x15\xab\x0e\x93\x70\x62\xda\x02\xb0\x89\x5f\x4a\x39\x2f\x0e\xfc\x96\x4a\x43\x46\x75\x3c\x2f\xa1\x43\x94\x8b\x50\x68\x43\x59\x6c\xab\x53\xdb\xe4\x56\x86\xaa\x7b\x82\x6d\x35\xbd\x0e\xe0\x5e\x67\x94\xfe\xcf\xf7\x6e\x79\xe1\x56\x1b\x54\x07\x21\x36\x17\xe6\xb8\x5c\x10\xb6\x58\x50\x6e\xe6\x2d\x04\xcc\xa9\x8f\x39\x9b\x91\x9c\xa6\x64\xfc\xd4\x69\xf5\xe4\x3b\x4c\x11\xb3\xbf\xa6\x62\xf7\x2d\xa6\xf5\xc1\x4f\x2c\x45\xb5\x56\x1a\xb3\xfd\x79\x6f\x43\x0a\x9f\xba\x7a\x88\x98\xbd\xcd\xe8\xec\xa8\x93\x20\xfb\xc8\x8c\x14\xbf\x6e\xb6\x65\xeb\x5e\x47\x44\xd5\x13\x45\x87\xa7\xba\x9a\x56\x51\xf9\xcc\x4a\x77\x1e\x31\xb1\x94\xae\x51\x3e\xc6\x7c\x80\x14\x26\x11\x18\x87\xce\xf1\xea\x35\xfa\xc6\x4c\xa0\xde\x56\x98\xcd\x65\x46\x39\x9b\x9a\xf3\xda\x6e\xa5\x07\x6e\x8f\xf3\x90\xe7\x05\x3c\x4f\x94\xdc\x02\x05\x5a\x6c\xd1\xd8\x04\xea\xa6\xba\x9c\xc2\xa9\xd2\x54\xce\xd0\x7c\x67\x8a\xcd\x71\x3c\xd7\x5b\xc4\x2b\xf6\x7b\xe7\x5c\xcc\x7b\x60\x1c\x26\x6b\x8d\xca\xe9\x98\x18\x67\x37\xe5\xf2\x65\x36\x31\x01\x35\x9f\x4a\xdb\x52\xf6\x08\xb8\x4c\x59\x8a\xe5\x7a\x79\x2c\x62\x02\x16\x96\xe8\x71\xaa\xda\xfc\xe2\xde\x57\xdd\x01\x7a\x4e\x35\x30\x65\xe7\x6e\xdc\xcf\xb8\x8d\xfc\x0f\xe6\xa5\xfa\x01\x12\x26\x6d\x63\xbe\x26\x6d\x36\x3a\xbf\xdd\x3e\x52\x7e\x3d\x81\xc3\x6e\x9b\x79\xd6\x0d\xce\x3a\x30\x6d\xbe\xc3\x67\xa6\xe7\x85\x6b\xe2\xa5\x94\xe6\xa2\x81\x6f\x63\x3c\xbb\x90\xa4\xcd\xb0\x4a\x69\xbd\x2b\x3a\xa3\x7d\x7c\xd4\xd2\x71\xb5\x3a\xf1\xcf\x1e\x29\xd8\x23\x79\x60\x10\x17\x8c\x6f\xdf\x98\x04\x39\x9c\x39\xdf\x72\xb1\xef\x01\x94\xc7\xe0\x47\x24\xec\x52\xa6\x6d\x40\xdb\x2b\x24\xc6\x18\x1f\x8e\x65\xc7\x37\x2f\xf3\x19\xcf\xdc\x5d\x62\xf1\x31\xb6\x1f\x69\x6d\x61\x01\x19\x57\xcc\xf9\x33\xb3\xbf\x83\xcc\x2e\x03\xf3\x3d\x24\x76\x61\xcd\xb7\x6d\xe2\xfd\xca\xd4\x0a\xc4\xfa\x82\x77\xc0\xf9\xd4\x26\x4e\x37\x6e\x45\xf8\xe9\x46\x40\x16\x52\xac\x58\x52\x46\xd4\xdc\xbd\xab\x1e\x32\xd4\xcf\xbc\x7c\x3f\x5f\x7d\xdb\x38\x96\xd8\x86\xfd\xa0\x9f\x82\x47\x5e\x47\x00\x33\x96\x68\x4f\x27\xde\x07\x3e\x98\xdc\x3b\x84\x9e\x17\x61\x1c\x77\x7c\x3c\x71\xed\x7f\xd3\x85\x7b\xc3\x76\x63\xdb\xdb\x8a\x99\xd0\x1d\x18\x02\x27\xae\xbd\x31\x97\xb8\x3e\x23\x98\xdb\x5c\xc5\xbd\x2f\xbb\x0b\x10\xdc\x19\xeb\x42\xba\x61\x62\x3d\x31\x9f\x2c\xfd\x0a\x34\x3c\x8e\xe4\xe6\x9d\x03\xc7\x1f\x80\xe4\xe3\x28\xdc\x14\xec\x24\x78\x9c\x3f\x61\xec\x9d\x8e\xf7\x95\xb5\x65\x1b\x58\x6a\xbd\xeb\x4e\x4c\x8d\x55\xd1\x36\xc1\x61\x97\x9a\xdb\xa1\xbd\x5e\xa3\x4e\x55\x8b\x50\x04\xc4\x5c\xe6\x25\xbd\x7a\xa1\x20\xbd\x7a\x19\x28\x2f\x0c\x07\x0d\x72\x22\x3c\x7f\x07\x6d\x45\x47\xe5\xfb\x60\xe1\xef\xcd\x80\xd4\x82\xd1\xfd\xed\x90\x34\xbe\xfc\x1d\x21\x33\xfc\xb1\x8c\xfc\x6f\x00\x95\x7d\xf1\x09\xeb\x2d\x00\x00")
 
 func ResourcesComponentDescriptorV2SchemaYamlBytes() ([]byte, error) {
 	return bindataRead(
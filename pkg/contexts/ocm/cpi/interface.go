@@ -49,6 +49,8 @@ type (
 	UniformRepositorySpec            = internal.UniformRepositorySpec
 	ComponentLister                  = internal.ComponentLister
 	ComponentAccess                  = internal.ComponentAccess
+	RepositoryVersionDeleter         = internal.RepositoryVersionDeleter
+	ComponentVersionDeleter          = internal.ComponentVersionDeleter
 	ComponentVersionAccess           = internal.ComponentVersionAccess
 	AccessSpec                       = internal.AccessSpec
 	AccessSpecDecoder                = internal.AccessSpecDecoder
@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
 )
 
@@ -49,3 +50,18 @@ type ComponentVersionContainer interface {
 
 	io.Closer
 }
+
+// DetachedSignatureContainer is the optional interface of a
+// ComponentVersionContainer able to store signatures separately
+// from the component descriptor.
+type DetachedSignatureContainer interface {
+	ComponentVersionContainer
+
+	// GetDetachedSignatures provides the signatures stored
+	// separately from the component descriptor.
+	GetDetachedSignatures() (metav1.Signatures, error)
+	// AddDetachedSignature stores a signature without modifying
+	// the component descriptor. A signature with the same name
+	// is replaced.
+	AddDetachedSignature(sig *metav1.Signature) error
+}
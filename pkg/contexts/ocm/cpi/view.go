@@ -171,6 +171,21 @@ func (r *repositoryView) AddVersion(cv ComponentVersionAccess, overrides ...bool
 	return c.AddVersion(cv, overrides...)
 }
 
+// DeleteVersion deletes a component version, if supported by the
+// component implementation.
+func (r *repositoryView) DeleteVersion(comp, vers string, gc ...bool) error {
+	c, err := refmgmt.ToLazy(r.LookupComponent(comp))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if d, ok := c.(ComponentVersionDeleter); ok {
+		return d.DeleteVersion(vers, gc...)
+	}
+	return errors.ErrNotSupported("component version deletion")
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 type _ComponentAccessView interface {
@@ -392,6 +407,21 @@ func (c *componentAccessView) NewVersion(version string, overrides ...bool) (acc
 	return acc, err
 }
 
+// DeleteVersion deletes a version, if supported by the
+// component implementation.
+func (c *componentAccessView) DeleteVersion(version string, gc ...bool) error {
+	return c.Execute(func() error {
+		d, ok := c.impl.(ComponentVersionDeleter)
+		if !ok {
+			return errors.ErrNotSupported("component version deletion")
+		}
+		if c.impl.IsReadOnly() {
+			return accessio.ErrReadOnly
+		}
+		return d.DeleteVersion(version, gc...)
+	})
+}
+
 func (c *componentAccessView) HasVersion(vers string) (ok bool, err error) {
 	err = c.Execute(func() error {
 		ok, err = c.impl.HasVersion(vers)
@@ -10,5 +10,6 @@ import (
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/download/handlers/dirtree"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/download/handlers/executable"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/download/handlers/helm"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/download/handlers/maven"
 	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/download/handlers/ocirepo"
 )
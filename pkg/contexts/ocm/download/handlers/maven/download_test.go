@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/download"
+	_ "github.com/open-component-model/ocm/pkg/contexts/ocm/download/handlers"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/env/builder"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const (
+	COMPONENT = "acme.org/maven"
+	VERSION   = "v1"
+	CONTENT   = "this is some jar content"
+)
+
+var _ = Describe("maven download", func() {
+	var env *builder.Builder
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		env = builder.NewBuilder()
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repo/com/example/lib/1.0.0/lib-1.0.0.jar" && r.URL.Path != "/repo/com/example/lib/1.0.0/lib-1.0.0-dist.tgz" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(CONTENT))
+		}))
+
+		env.OCMCommonTransport("ctf", accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENT, VERSION, func() {
+				env.Resource("lib", VERSION, resourcetypes.BLOB, metav1.ExternalRelation, func() {
+					env.Access(maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0"))
+				})
+				env.Resource("dist", VERSION, resourcetypes.BLOB, metav1.ExternalRelation, func() {
+					acc := maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0")
+					acc.Classifier = "dist"
+					acc.Extension = "tgz"
+					env.Access(acc)
+				})
+				env.Resource("evil", VERSION, resourcetypes.BLOB, metav1.ExternalRelation, func() {
+					env.Access(maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0"))
+				})
+				env.Resource("text", VERSION, resourcetypes.BLOB, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_OCTET, "text")
+				})
+			})
+		})
+		MustBeSuccessful(env.MkdirAll("target", 0o700))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		env.Cleanup()
+	})
+
+	It("downloads artifact with maven file name", func() {
+		repo := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, "ctf", 0, env))
+		defer Close(repo)
+		cv := Must(repo.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(cv)
+		res := Must(cv.GetResource(metav1.NewIdentity("lib")))
+
+		p, buf := common.NewBufferedPrinter()
+		accepted, path := Must2(download.For(env).Download(p, res, "", env))
+		Expect(accepted).To(BeTrue())
+		Expect(path).To(Equal("lib-1.0.0.jar"))
+		Expect(buf.String()).To(Equal("lib-1.0.0.jar: 24 byte(s) written\n"))
+		Expect(string(Must(vfs.ReadFile(env, path)))).To(Equal(CONTENT))
+
+		accepted, path = Must2(download.For(env).Download(p, res, "target", env))
+		Expect(accepted).To(BeTrue())
+		Expect(path).To(Equal("target/lib-1.0.0.jar"))
+		Expect(string(Must(vfs.ReadFile(env, path)))).To(Equal(CONTENT))
+	})
+
+	It("downloads archive artifact with maven file name", func() {
+		repo := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, "ctf", 0, env))
+		defer Close(repo)
+		cv := Must(repo.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(cv)
+		res := Must(cv.GetResource(metav1.NewIdentity("dist")))
+
+		accepted, path := Must2(download.For(env).Download(nil, res, "target", env))
+		Expect(accepted).To(BeTrue())
+		Expect(path).To(Equal("target/lib-1.0.0-dist.tgz"))
+	})
+
+	It("rejects coordinates escaping the target directory", func() {
+		repo := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, "ctf", 0, env))
+		defer Close(repo)
+		cv := Must(repo.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(cv)
+
+		// descriptors may carry unchecked access specifications
+		cd := cv.GetDescriptor()
+		idx := cd.GetResourceIndexByIdentity(metav1.NewIdentity("evil"))
+		acc := maven.New(server.URL+"/repo", "com.example", "lib", "1.0.0")
+		acc.ArtifactId = "../../lib"
+		cd.Resources[idx].Access = acc
+		res := Must(cv.GetResource(metav1.NewIdentity("evil")))
+
+		_, _, err := download.For(env).Download(nil, res, "target", env)
+		Expect(err).To(MatchError(ContainSubstring(`maven artifactId "../../lib" is invalid`)))
+	})
+
+	It("ignores other resources", func() {
+		repo := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, "ctf", 0, env))
+		defer Close(repo)
+		cv := Must(repo.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(cv)
+		res := Must(cv.GetResource(metav1.NewIdentity("text")))
+
+		accepted, path := Must2(download.For(env).Download(nil, res, "", env))
+		Expect(accepted).To(BeTrue())
+		Expect(path).To(Equal("text"))
+	})
+})
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maven
+
+import (
+	"io"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/maven"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/download"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+// Handler downloads resources described by a Maven access
+// specification using the file name of the Maven artifact file.
+type Handler struct{}
+
+func init() {
+	h := &Handler{}
+	download.Register(h, download.ForMimeType(mime.MIME_JAR))
+	download.Register(h, download.ForMimeType(mime.MIME_XML))
+	download.Register(h, download.ForMimeType(mime.MIME_TGZ))
+	download.Register(h, download.ForMimeType(mime.MIME_ZIP))
+	download.Register(h, download.ForMimeType(mime.MIME_OCTET))
+}
+
+func wrapErr(err error, racc cpi.ResourceAccess) error {
+	if err == nil {
+		return nil
+	}
+	m := racc.Meta()
+	return errors.Wrapf(err, "resource %s/%s%s", m.GetName(), m.GetVersion(), m.ExtraIdentity.String())
+}
+
+// coordinates provides the Maven coordinates for a resource, if
+// it is described by a Maven access specification (or a local blob
+// with a global Maven access specification).
+func coordinates(racc cpi.ResourceAccess) *maven.Coordinates {
+	if spec, err := racc.Access(); err == nil {
+		if m, ok := spec.(*maven.AccessSpec); ok {
+			return &m.Coordinates
+		}
+	}
+	if m, ok := racc.GlobalAccess().(*maven.AccessSpec); ok {
+		return &m.Coordinates
+	}
+	return nil
+}
+
+func (_ Handler) Download(p common.Printer, racc cpi.ResourceAccess, path string, fs vfs.FileSystem) (bool, string, error) {
+	coords := coordinates(racc)
+	if coords == nil {
+		return false, "", nil
+	}
+	if err := coords.Validate(); err != nil {
+		return true, "", wrapErr(err, racc)
+	}
+
+	if path == "" {
+		path = coords.FileName()
+	} else if ok, err := vfs.DirExists(fs, path); err == nil && ok {
+		path = vfs.Join(fs, path, coords.FileName())
+	}
+
+	rd, err := cpi.ResourceReader(racc)
+	if err != nil {
+		return true, "", wrapErr(err, racc)
+	}
+	defer rd.Close()
+
+	file, err := fs.OpenFile(path, vfs.O_TRUNC|vfs.O_CREATE|vfs.O_WRONLY, 0o660)
+	if err != nil {
+		return true, "", wrapErr(errors.Wrapf(err, "creating target file %q", path), racc)
+	}
+	defer file.Close()
+	n, err := io.Copy(file, rd)
+	if err == nil {
+		p.Printf("%s: %d byte(s) written\n", path, n)
+	}
+	return true, path, wrapErr(err, racc)
+}
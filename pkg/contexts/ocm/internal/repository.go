@@ -40,6 +40,15 @@ type Repository interface {
 	AddVersion(cv ComponentVersionAccess, overrides ...bool) error
 }
 
+// RepositoryVersionDeleter is an optional interface for repositories
+// supporting the deletion of component versions.
+type RepositoryVersionDeleter interface {
+	// DeleteVersion removes a component version from the repository.
+	// If the optional gc flag is set, local blobs not referenced
+	// anymore are removed, too, if supported by the repository.
+	DeleteVersion(comp, version string, gc ...bool) error
+}
+
 // ConsumerIdentityProvider is an interface for object requiring
 // credentials, which want to expose the ConsumerId they are
 // usingto request implicit credentials.
@@ -63,6 +72,15 @@ type ComponentAccessImpl interface {
 	Close() error
 }
 
+// ComponentVersionDeleter is an optional interface for component
+// implementations supporting the deletion of versions.
+type ComponentVersionDeleter interface {
+	// DeleteVersion removes a version of the component.
+	// If the optional gc flag is set, local blobs not referenced
+	// anymore are removed, too, if supported by the repository.
+	DeleteVersion(version string, gc ...bool) error
+}
+
 type ComponentAccess interface {
 	resource.ResourceView[ComponentAccess]
 
@@ -110,6 +110,13 @@ func (p *pluginImpl) GetValueMappingDescriptor(name string) *descriptor.ValueMer
 	return nil
 }
 
+func (p *pluginImpl) GetSigningHandlerDescriptor(name string) *descriptor.SigningHandlerDescriptor {
+	if !p.IsValid() {
+		return nil
+	}
+	return p.descriptor.SigningHandlers.Get(name)
+}
+
 func (p *pluginImpl) GetLabelMergeSpecification(name, version string) *descriptor.LabelMergeSpecification {
 	if !p.IsValid() {
 		return nil
@@ -153,6 +160,30 @@ func (p *pluginImpl) GetAccessMethodDescriptor(name, version string) *descriptor
 	return nil
 }
 
+func (p *pluginImpl) GetInputTypeDescriptor(name, version string) *descriptor.InputTypeDescriptor {
+	if !p.IsValid() {
+		return nil
+	}
+
+	var fallback descriptor.InputTypeDescriptor
+	fallbackFound := false
+	for _, t := range p.descriptor.InputTypes {
+		if t.Name == name {
+			if t.Version == version {
+				return &t
+			}
+			if t.Version == "" || t.Version == "v1" {
+				fallback = t
+				fallbackFound = true
+			}
+		}
+	}
+	if fallbackFound && (version == "" || version == "v1") {
+		return &fallback
+	}
+	return nil
+}
+
 func (p *pluginImpl) GetValueSetDescriptor(purpose, name, version string) *descriptor.ValueSetDescriptor {
 	if !p.IsValid() {
 		return nil
@@ -74,6 +74,16 @@ func DescribePluginDescriptorCapabilities(reg api.ActionTypeRegistry, d *descrip
 		out.Printf("Label Merge Specifications:\n")
 		DescribeLabelMergeSpecifications(d, out)
 	}
+	if len(d.SigningHandlers) > 0 {
+		out.Printf("\n")
+		out.Printf("Signing Handlers:\n")
+		DescribeSigningHandlers(d, out)
+	}
+	if len(d.InputTypes) > 0 {
+		out.Printf("\n")
+		out.Printf("Input Types:\n")
+		DescribeInputTypes(d, out)
+	}
 }
 
 type MethodInfo struct {
@@ -279,6 +289,24 @@ func DescribeValueMergeHandlers(d *descriptor.Descriptor, out common.Printer) {
 	}
 }
 
+func DescribeSigningHandlers(d *descriptor.Descriptor, out common.Printer) {
+	handlers := map[string]descriptor.SigningHandlerDescriptor{}
+	for _, h := range d.SigningHandlers {
+		handlers[h.GetName()] = h
+	}
+
+	for _, n := range utils2.StringMapKeys(handlers) {
+		a := handlers[n]
+		out.Printf("- Name: %s\n", n)
+		if a.ConsumerType != "" {
+			out.Printf("  Consumer Type: %s\n", a.ConsumerType)
+		}
+		if a.Description != "" {
+			out.Printf("%s\n", utils2.IndentLines(a.Description, "    "))
+		}
+	}
+}
+
 func DescribeLabelMergeSpecifications(d *descriptor.Descriptor, out common.Printer) {
 	handlers := map[string]descriptor.LabelMergeSpecification{}
 	for _, h := range d.LabelMergeSpecifications {
@@ -393,6 +421,40 @@ func DescribeValueSets(d *descriptor.Descriptor, out common.Printer) {
 	}
 }
 
+func GetInputTypeInfo(types []descriptor.InputTypeDescriptor) map[string]*ValueSetInfo {
+	var defs []descriptor.ValueSetDescriptor
+	for _, t := range types {
+		defs = append(defs, descriptor.ValueSetDescriptor{ValueSetDefinition: t.ValueSetDefinition})
+	}
+	return GetValueSetInfo(defs)
+}
+
+func DescribeInputTypes(d *descriptor.Descriptor, out common.Printer) {
+	types := GetInputTypeInfo(d.InputTypes)
+
+	for _, n := range utils2.StringMapKeys(types) {
+		out.Printf("- Name: %s\n", n)
+		m := types[n]
+		if m.Description != "" {
+			out.Printf("%s\n", utils2.IndentLines(m.Description, "    "))
+		}
+		out := out.AddGap("  ") //nolint: govet // just use always out
+		out.Printf("Versions:\n")
+		for _, vn := range utils2.StringMapKeys(m.Versions) {
+			out.Printf("- Version: %s\n", vn)
+			out := out.AddGap("  ") //nolint: govet // just use always out
+			v := m.Versions[vn]
+			if v.Format != "" {
+				out.Printf("%s\n", v.Format)
+			}
+			if len(v.Options) > 0 {
+				out.Printf("Command Line Options:")
+				out.Printf("%s\n", utils2.FormatMap("", v.Options))
+			}
+		}
+	}
+}
+
 type Describable interface {
 	Describe() string
 }
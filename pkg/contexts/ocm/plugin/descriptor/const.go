@@ -11,13 +11,15 @@ import (
 )
 
 const (
-	KIND_PLUGIN       = "plugin"
-	KIND_DOWNLOADER   = "downloader"
-	KIND_UPLOADER     = "uploader"
-	KIND_ACCESSMETHOD = errors.KIND_ACCESSMETHOD
-	KIND_ACTION       = action.KIND_ACTION
-	KIND_VALUESET     = "value set"
-	KIND_PURPOSE      = "purposet"
+	KIND_PLUGIN         = "plugin"
+	KIND_DOWNLOADER     = "downloader"
+	KIND_UPLOADER       = "uploader"
+	KIND_ACCESSMETHOD   = errors.KIND_ACCESSMETHOD
+	KIND_ACTION         = action.KIND_ACTION
+	KIND_VALUESET       = "value set"
+	KIND_PURPOSE        = "purposet"
+	KIND_SIGNINGHANDLER = "signing handler"
+	KIND_INPUTTYPE      = "input type"
 )
 
 var REALM = ocmlog.DefineSubRealm("OCM plugin handling", "plugins")
@@ -26,6 +26,8 @@ type Descriptor struct {
 	ValueMergeHandlers       List[ValueMergeHandlerDescriptor] `json:"valueMergeHandlers,omitempty"`
 	LabelMergeSpecifications List[LabelMergeSpecification]     `json:"labelMergeSpecifications,omitempty"`
 	ValueSets                List[ValueSetDescriptor]          `json:"valuesets,omitempty"`
+	SigningHandlers          List[SigningHandlerDescriptor]    `json:"signingHandlers,omitempty"`
+	InputTypes               List[InputTypeDescriptor]         `json:"inputTypes,omitempty"`
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -53,6 +55,12 @@ func (d *Descriptor) Capabilities() []string {
 	if len(d.LabelMergeSpecifications) > 0 {
 		caps = append(caps, "Label Merge Specs")
 	}
+	if len(d.SigningHandlers) > 0 {
+		caps = append(caps, "Signing Handlers")
+	}
+	if len(d.InputTypes) > 0 {
+		caps = append(caps, "Input Types")
+	}
 	return caps
 }
 
@@ -119,6 +127,12 @@ type AccessMethodDescriptor struct {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type InputTypeDescriptor struct {
+	ValueSetDefinition `json:",inline"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type ValueSetDescriptor struct {
 	ValueSetDefinition `json:",inline"`
 	Purposes           []string `json:"purposes"`
@@ -205,6 +219,22 @@ func (a ActionDescriptor) GetDescription() string {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type SigningHandlerDescriptor struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	ConsumerType string `json:"consumerType,omitempty"`
+}
+
+func (a SigningHandlerDescriptor) GetName() string {
+	return a.Name
+}
+
+func (a SigningHandlerDescriptor) GetDescription() string {
+	return a.Description
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type CLIOption struct {
 	Name        string `json:"name"`
 	Type        string `json:"type,omitempty"`
@@ -14,6 +14,9 @@ const (
 	KIND_UPLOADER     = descriptor.KIND_UPLOADER
 	KIND_ACCESSMETHOD = descriptor.KIND_ACCESSMETHOD
 	KIND_ACTION       = descriptor.KIND_ACTION
+
+	KIND_SIGNINGHANDLER = descriptor.KIND_SIGNINGHANDLER
+	KIND_INPUTTYPE      = descriptor.KIND_INPUTTYPE
 )
 
 var TAG = descriptor.REALM
@@ -30,7 +33,11 @@ type (
 	UploaderKeySet              = descriptor.UploaderKeySet
 	ValueSetDefinition          = descriptor.ValueSetDefinition
 	ValueSetDescriptor          = descriptor.ValueSetDescriptor
+	SigningHandlerDescriptor    = descriptor.SigningHandlerDescriptor
+	InputTypeDescriptor         = descriptor.InputTypeDescriptor
 
 	AccessSpecInfo       = internal.AccessSpecInfo
 	UploadTargetSpecInfo = internal.UploadTargetSpecInfo
+	SignatureSpec        = internal.SignatureSpec
+	InputSpecInfo        = internal.InputSpecInfo
 )
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+// SignatureSpec is the exchange format for signatures created or verified
+// by a plugin signing handler.
+type SignatureSpec struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+	MediaType string `json:"mediaType"`
+	Issuer    string `json:"issuer,omitempty"`
+}
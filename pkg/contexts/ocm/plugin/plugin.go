@@ -6,6 +6,7 @@ package plugin
 
 import (
 	"bytes"
+	"crypto"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,8 +28,15 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/action"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/action/execute"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/download"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input"
+	inpcompose "github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input/compose"
+	inpget "github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input/get"
+	inpval "github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input/validate"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/mergehandler"
 	merge "github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/mergehandler/execute"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/signing"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/signing/sign"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/signing/verify"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/upload"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/upload/put"
 	uplval "github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/upload/validate"
@@ -154,6 +162,58 @@ func (p *pluginImpl) Action(spec ppi.ActionSpec, creds json.RawMessage) (ppi.Act
 	return info, nil
 }
 
+func (p *pluginImpl) Sign(name string, digest string, hash crypto.Hash, issuer string, key []byte, creds json.RawMessage) (*ppi.SignatureSpec, error) {
+	if p.GetSigningHandlerDescriptor(name) == nil {
+		return nil, errors.ErrNotSupported(KIND_SIGNINGHANDLER, name, KIND_PLUGIN, p.Name())
+	}
+
+	args := []string{signing.Name, sign.Name, name, digest, "--" + sign.OptHash, hash.String()}
+	if issuer != "" {
+		args = append(args, "--"+sign.OptIssuer, issuer)
+	}
+	if creds != nil {
+		args = append(args, "--"+sign.OptCreds, string(creds))
+	}
+
+	result, err := p.Exec(bytes.NewReader(key), nil, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "plugin %s", p.Name())
+	}
+
+	var sig ppi.SignatureSpec
+	err = json.Unmarshal(result, &sig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "plugin %s: cannot unmarshal signature", p.Name())
+	}
+	return &sig, nil
+}
+
+func (p *pluginImpl) Verify(name string, digest string, hash crypto.Hash, sig *ppi.SignatureSpec, key []byte) error {
+	if p.GetSigningHandlerDescriptor(name) == nil {
+		return errors.ErrNotSupported(KIND_SIGNINGHANDLER, name, KIND_PLUGIN, p.Name())
+	}
+
+	data, err := json.Marshal(sig)
+	if err != nil {
+		return errors.Wrapf(err, "cannot marshal signature")
+	}
+
+	result, err := p.Exec(bytes.NewReader(key), nil, signing.Name, verify.Name, name, digest, string(data), "--"+verify.OptHash, hash.String())
+	if err != nil {
+		return errors.Wrapf(err, "plugin %s", p.Name())
+	}
+
+	var r verify.Result
+	err = json.Unmarshal(result, &r)
+	if err != nil {
+		return errors.Wrapf(err, "plugin %s: cannot unmarshal verification result", p.Name())
+	}
+	if !r.Verified {
+		return errors.Newf("plugin %s: signature not verified", p.Name())
+	}
+	return nil
+}
+
 func (p *pluginImpl) ValidateAccessMethod(spec []byte) (*ppi.AccessSpecInfo, error) {
 	result, err := p.Exec(nil, nil, accessmethod.Name, accval.Name, string(spec))
 	if err != nil {
@@ -200,6 +260,68 @@ func (p *pluginImpl) ComposeAccessMethod(name string, opts flagsets.ConfigOption
 	return nil
 }
 
+func (p *pluginImpl) ValidateInputSpec(spec []byte, dir string) (*ppi.InputSpecInfo, error) {
+	args := []string{input.Name, inpval.Name, string(spec)}
+	if dir != "" {
+		args = append(args, "--"+inpval.OptDir, dir)
+	}
+	result, err := p.Exec(nil, nil, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "plugin %s", p.Name())
+	}
+
+	var info ppi.InputSpecInfo
+	err = json.Unmarshal(result, &info)
+	if err != nil {
+		return nil, errors.Wrapf(err, "plugin %s: cannot unmarshal input spec info", p.Name())
+	}
+	return &info, nil
+}
+
+func (p *pluginImpl) ComposeInputSpec(name string, opts flagsets.ConfigOptions, base flagsets.Config) error {
+	cfg := flagsets.Config{}
+	for _, o := range opts.Options() {
+		cfg[o.GetName()] = o.Value()
+	}
+	optsdata, err := json.Marshal(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "cannot marshal option values")
+	}
+	basedata, err := json.Marshal(base)
+	if err != nil {
+		return errors.Wrapf(err, "cannot marshal input specification base value")
+	}
+	result, err := p.Exec(nil, nil, input.Name, inpcompose.Name, name, string(optsdata), string(basedata))
+	if err != nil {
+		return err
+	}
+	var r flagsets.Config
+	err = json.Unmarshal(result, &r)
+	if err != nil {
+		return errors.Wrapf(err, "cannot unmarshal composition result")
+	}
+
+	for k := range base {
+		delete(base, k)
+	}
+	for k, v := range r {
+		base[k] = v
+	}
+	return nil
+}
+
+func (p *pluginImpl) GetInputBlob(w io.Writer, creds, spec json.RawMessage, dir string) error {
+	args := []string{input.Name, inpget.Name, string(spec)}
+	if creds != nil {
+		args = append(args, "--"+inpget.OptCreds, string(creds))
+	}
+	if dir != "" {
+		args = append(args, "--"+inpget.OptDir, dir)
+	}
+	_, err := p.Exec(nil, w, args...)
+	return err
+}
+
 func (p *pluginImpl) ValidateUploadTarget(name string, spec []byte) (*ppi.UploadTargetSpecInfo, error) {
 	result, err := p.Exec(nil, nil, upload.Name, uplval.Name, name, string(spec))
 	if err != nil {
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package input
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input/compose"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input/get"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/input/validate"
+)
+
+const Name = "input"
+
+func New(p ppi.Plugin) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "input type operations",
+		Long: `This command group provides all commands used to implement an input type
+described by an input type descriptor (<CMD>` + p.Name() + ` descriptor</CMD>.`,
+	}
+
+	cmd.AddCommand(validate.New(p))
+	cmd.AddCommand(get.New(p))
+	cmd.AddCommand(compose.New(p))
+	return cmd
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package get
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/pkg/cobrautils/flag"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/descriptor"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi"
+	commonppi "github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/common"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const (
+	Name     = "get"
+	OptCreds = commonppi.OptCreds
+	OptDir   = "dir"
+)
+
+func New(p ppi.Plugin) *cobra.Command {
+	opts := Options{}
+
+	cmd := &cobra.Command{
+		Use:   Name + " [<flags>] <input spec>",
+		Short: "get blob",
+		Long: `
+Evaluate the given input specification and return the described blob on
+*stdout*. Relative file paths used by the specification are interpreted
+relative to the directory given by option <code>--dir</code>.`,
+		Args: cobra.ExactArgs(1),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Complete(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Command(p, cmd, &opts)
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+type Options struct {
+	Credentials   credentials.DirectCredentials
+	Specification json.RawMessage
+	Dir           string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	flag.YAMLVarP(fs, &o.Credentials, OptCreds, "c", nil, "credentials")
+	flag.StringToStringVarPFA(fs, &o.Credentials, "credential", "C", nil, "dedicated credential value")
+	fs.StringVarP(&o.Dir, OptDir, "d", "", "base directory for relative file paths")
+}
+
+func (o *Options) Complete(args []string) error {
+	if err := runtime.DefaultYAMLEncoding.Unmarshal([]byte(args[0]), &o.Specification); err != nil {
+		return errors.Wrapf(err, "invalid input specification")
+	}
+	return nil
+}
+
+func Command(p ppi.Plugin, cmd *cobra.Command, opts *Options) error {
+	spec, err := p.DecodeInputSpecification(opts.Specification)
+	if err != nil {
+		return errors.Wrapf(err, "input specification")
+	}
+
+	t := p.GetInputType(runtime.KindVersion(spec.GetType()))
+	if t == nil {
+		return errors.ErrUnknown(descriptor.KIND_INPUTTYPE, spec.GetType())
+	}
+	_, err = t.ValidateSpecification(p, opts.Dir, spec)
+	if err != nil {
+		return err
+	}
+	r, err := t.Reader(p, opts.Dir, spec, opts.Credentials)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(os.Stdout, r)
+	r.Close()
+	return err
+}
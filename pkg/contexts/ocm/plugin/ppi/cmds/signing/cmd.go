@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/signing/sign"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/signing/verify"
+)
+
+const Name = "signing"
+
+func New(p ppi.Plugin) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "signing handler operations",
+		Long:  `This command group provides all commands used to implement a signing handler.`,
+	}
+
+	cmd.AddCommand(sign.New(p))
+	cmd.AddCommand(verify.New(p))
+	return cmd
+}
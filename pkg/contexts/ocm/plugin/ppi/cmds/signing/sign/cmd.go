@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sign
+
+import (
+	"crypto"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/pkg/cobrautils/flag"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/descriptor"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi/cmds/common"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+const (
+	Name      = "sign"
+	OptCreds  = common.OptCreds
+	OptHash   = "hash"
+	OptIssuer = "issuer"
+)
+
+func New(p ppi.Plugin) *cobra.Command {
+	opts := Options{}
+
+	cmd := &cobra.Command{
+		Use:   Name + " [<flags>] <name> <digest>",
+		Short: "sign a digest",
+		Long: `
+This command signs a digest with the signing handler given by name.
+The private key data is provided on *stdin*. It may be empty, if the
+handler manages its keys on its own.
+
+The command has to provide the signature as JSON string on *stdout*. It has
+the following fields:
+
+- **<code>algorithm</code>** *string*
+
+  The finally used signature algorithm.
+
+- **<code>value</code>** *string*
+
+  The signature value.
+
+- **<code>mediaType</code>** *string*
+
+  The media type of the signature value.
+
+- **<code>issuer</code>** *string*
+
+  The optional issuer of the signature.
+`,
+		Args: cobra.ExactArgs(2),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Complete(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Command(p, cmd, &opts)
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+type Options struct {
+	Name        string
+	Digest      string
+	Hash        crypto.Hash
+	Issuer      string
+	Credentials credentials.DirectCredentials
+
+	hash string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.hash, OptHash, "H", crypto.SHA256.String(), "hash function used for the digest")
+	fs.StringVarP(&o.Issuer, OptIssuer, "I", "", "issuer of the signature")
+	flag.YAMLVarP(fs, &o.Credentials, OptCreds, "c", nil, "credentials")
+	flag.StringToStringVarPFA(fs, &o.Credentials, "credential", "C", nil, "dedicated credential value")
+}
+
+func (o *Options) Complete(args []string) error {
+	o.Name = args[0]
+	o.Digest = args[1]
+	o.Hash = signing.HashFunction(o.hash)
+	if o.Hash == 0 {
+		return errors.ErrUnknown("hash function", o.hash)
+	}
+	return nil
+}
+
+func Command(p ppi.Plugin, cmd *cobra.Command, opts *Options) error {
+	h := p.GetSigningHandler(opts.Name)
+	if h == nil {
+		return errors.ErrUnknown(descriptor.KIND_SIGNINGHANDLER, opts.Name)
+	}
+
+	key, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	sig, err := h.Sign(p, opts.Digest, opts.Hash, opts.Issuer, key, opts.Credentials)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(sig)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verify
+
+import (
+	"crypto"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/descriptor"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin/ppi"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+const (
+	Name    = "verify"
+	OptHash = "hash"
+)
+
+func New(p ppi.Plugin) *cobra.Command {
+	opts := Options{}
+
+	cmd := &cobra.Command{
+		Use:   Name + " [<flags>] <name> <digest> <signature>",
+		Short: "verify a signature",
+		Long: `
+This command verifies the signature of a digest with the signing handler given
+by name. The signature is given as JSON string with the fields
+<code>algorithm</code>, <code>value</code>, <code>mediaType</code> and
+<code>issuer</code>. The public key or certificate data is provided on *stdin*.
+
+The command fails with an error if the signature cannot be verified.
+Otherwise, it has to provide a verification result as JSON string on
+*stdout*. It has the following fields:
+
+- **<code>verified</code>** *bool*
+
+  Whether the signature could be verified.
+`,
+		Args: cobra.ExactArgs(3),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return opts.Complete(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Command(p, cmd, &opts)
+		},
+	}
+	opts.AddFlags(cmd.Flags())
+	return cmd
+}
+
+type Result struct {
+	Verified bool `json:"verified"`
+}
+
+type Options struct {
+	Name      string
+	Digest    string
+	Hash      crypto.Hash
+	Signature ppi.SignatureSpec
+
+	hash string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.hash, OptHash, "H", crypto.SHA256.String(), "hash function used for the digest")
+}
+
+func (o *Options) Complete(args []string) error {
+	o.Name = args[0]
+	o.Digest = args[1]
+	if err := json.Unmarshal([]byte(args[2]), &o.Signature); err != nil {
+		return errors.Wrapf(err, "invalid signature")
+	}
+	o.Hash = signing.HashFunction(o.hash)
+	if o.Hash == 0 {
+		return errors.ErrUnknown("hash function", o.hash)
+	}
+	return nil
+}
+
+func Command(p ppi.Plugin, cmd *cobra.Command, opts *Options) error {
+	h := p.GetSigningHandler(opts.Name)
+	if h == nil {
+		return errors.ErrUnknown(descriptor.KIND_SIGNINGHANDLER, opts.Name)
+	}
+
+	key, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	err = h.Verify(p, opts.Digest, opts.Hash, &opts.Signature, key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(Result{Verified: true})
+	if err != nil {
+		return err
+	}
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
@@ -111,6 +111,18 @@ following fields:
 
   The list of assignments of label merge specification to labels.
 
+- **<code>signingHandlers</code>** *[]SigningHandlerDescriptor*
+
+  The list of supported signing handlers. Signing handlers are registered
+  as signing algorithms in the signing registry of an OCM context and can
+  be used to sign and verify component versions.
+
+- **<code>inputTypes</code>** *[]InputTypeDescriptor*
+
+  The list of supported resource input types. Input types are offered by
+  the command <code>ocm add resources</code> (and similar commands) and
+  are used to compose blobs from a local environment.
+
 #### Access Method Descriptor
 
 An access method descriptor describes a dedicated supported access method.
@@ -295,6 +307,32 @@ The descriptor for a label merge specification has the following fields:
 
   The configuration settings used for the algorithm. It may contain nested
   merge specifications.
+
+### Signing Handler Descriptor
+
+The descriptor for a signing handler has the following fields:
+
+- **<code>name</code>** *string*
+
+  The name of the signing handler. It is used as signing algorithm name.
+
+- **<code>description</code>** *string*
+
+  A short description of the signing handler.
+
+- **<code>consumerType</code>** *string* (optional)
+
+  A consumer type used to look up credentials for a signing operation.
+  The consumer identity additionally contains the attribute
+  <code>issuer</code>, if an issuer is specified.
+
+### Input Type Descriptor
+
+The descriptor for an input type has the same fields as an
+access method descriptor (<code>name</code>, <code>version</code>,
+<code>description</code>, <code>format</code> and <code>options</code>).
+If options are given, the plugin must support the command
+<CMD>plugin input compose</CMD>.
 `,
 	}
 }
@@ -5,6 +5,7 @@
 package ppi
 
 import (
+	"crypto"
 	"encoding/json"
 	"io"
 
@@ -17,18 +18,22 @@ import (
 )
 
 type (
-	Descriptor             = descriptor.Descriptor
-	UploaderKey            = descriptor.UploaderKey
-	UploaderDescriptor     = descriptor.UploaderDescriptor
-	DownloaderKey          = descriptor.DownloaderKey
-	DownloaderDescriptor   = descriptor.DownloaderDescriptor
-	AccessMethodDescriptor = descriptor.AccessMethodDescriptor
-	CLIOption              = descriptor.CLIOption
+	Descriptor               = descriptor.Descriptor
+	UploaderKey              = descriptor.UploaderKey
+	UploaderDescriptor       = descriptor.UploaderDescriptor
+	DownloaderKey            = descriptor.DownloaderKey
+	DownloaderDescriptor     = descriptor.DownloaderDescriptor
+	AccessMethodDescriptor   = descriptor.AccessMethodDescriptor
+	CLIOption                = descriptor.CLIOption
+	SigningHandlerDescriptor = descriptor.SigningHandlerDescriptor
+	InputTypeDescriptor      = descriptor.InputTypeDescriptor
 
 	ActionSpecInfo       = internal.ActionSpecInfo
 	AccessSpecInfo       = internal.AccessSpecInfo
 	ValueSetInfo         = internal.ValueSetInfo
 	UploadTargetSpecInfo = internal.UploadTargetSpecInfo
+	SignatureSpec        = internal.SignatureSpec
+	InputSpecInfo        = internal.InputSpecInfo
 )
 
 var REALM = descriptor.REALM
@@ -68,6 +73,13 @@ type Plugin interface {
 	DecodeValueSet(purpose string, data []byte) (runtime.TypedObject, error)
 	GetValueSet(purpose, name, version string) ValueSet
 
+	RegisterSigningHandler(h SigningHandler) error
+	GetSigningHandler(name string) SigningHandler
+
+	RegisterInputType(t InputType) error
+	DecodeInputSpecification(data []byte) (InputSpec, error)
+	GetInputType(name, version string) InputType
+
 	GetOptions() *Options
 	GetConfig() (interface{}, error)
 }
@@ -101,6 +113,35 @@ type ContentVersionIdentityProvider interface {
 
 type AccessSpec = runtime.TypedObject
 
+// InputType provides a resource input type for the CLI
+// command <code>ocm add resources</code>. It is used to generate
+// a blob from a plugin specific input specification.
+type InputType interface {
+	runtime.TypedObjectDecoder[InputSpec]
+
+	Name() string
+	Version() string
+
+	// Options provides the list of CLI options supported to compose the input
+	// specification.
+	Options() []options.OptionType
+
+	// Description provides a general description for the input type.
+	Description() string
+	// Format describes the attributes of the dedicated version.
+	Format() string
+
+	// ValidateSpecification validates an input specification. Relative
+	// file paths used by the specification are interpreted relative to
+	// the given directory.
+	ValidateSpecification(p Plugin, dir string, spec InputSpec) (info *InputSpecInfo, err error)
+	// Reader provides the blob described by the input specification.
+	Reader(p Plugin, dir string, spec InputSpec, creds credentials.Credentials) (io.ReadCloser, error)
+	ComposeSpecification(p Plugin, opts Config, config Config) error
+}
+
+type InputSpec = runtime.TypedObject
+
 type AccessSpecProvider func() AccessSpec
 
 type UploadFormats runtime.KnownTypes[runtime.TypedObject, runtime.TypedObjectDecoder[runtime.TypedObject]]
@@ -182,3 +223,22 @@ type ValueSet interface {
 	ValidateSpecification(p Plugin, spec runtime.TypedObject) (info *ValueSetInfo, err error)
 	ComposeSpecification(p Plugin, opts Config, config Config) error
 }
+
+// SigningHandler implements a signature algorithm. The name
+// is used as algorithm name to register the handler in the
+// signing registry of an OCM context.
+type SigningHandler interface {
+	Name() string
+	Description() string
+	// ConsumerType optionally describes the credential consumer type
+	// used to look up credentials for the signing operation.
+	ConsumerType() string
+
+	// Sign creates a signature for the given digest. The private key is
+	// provided as (typically PEM encoded) key data and may be empty,
+	// if the handler manages its keys on its own.
+	Sign(p Plugin, digest string, hash crypto.Hash, issuer string, key []byte, creds credentials.Credentials) (*SignatureSpec, error)
+	// Verify checks a signature for the given digest. It returns an error
+	// on verification failure.
+	Verify(p Plugin, digest string, hash crypto.Hash, sig *SignatureSpec, key []byte) error
+}
@@ -43,6 +43,11 @@ type plugin struct {
 	valuesets map[string]map[string]ValueSet
 	setScheme map[string]runtime.Scheme[runtime.TypedObject, runtime.TypedObjectDecoder[runtime.TypedObject]]
 
+	signers map[string]SigningHandler
+
+	inputs      map[string]InputType
+	inputScheme runtime.Scheme[runtime.TypedObject, runtime.TypedObjectDecoder[runtime.TypedObject]]
+
 	configParser func(message json.RawMessage) (interface{}, error)
 }
 
@@ -68,6 +73,11 @@ func NewPlugin(name string, version string) Plugin {
 		valuesets: map[string]map[string]ValueSet{},
 		setScheme: map[string]runtime.Scheme[runtime.TypedObject, runtime.TypedObjectDecoder[runtime.TypedObject]]{},
 
+		signers: map[string]SigningHandler{},
+
+		inputs:      map[string]InputType{},
+		inputScheme: runtime.MustNewDefaultScheme[runtime.TypedObject, runtime.TypedObjectDecoder[runtime.TypedObject]](&runtime.UnstructuredVersionedTypedObject{}, false, nil),
+
 		descriptor: descriptor.Descriptor{
 			Version:       descriptor.VERSION,
 			PluginName:    name,
@@ -365,6 +375,65 @@ func (p *plugin) GetValueMergeHandler(name string) ValueMergeHandler {
 	return p.mergehandlers[name]
 }
 
+func (p *plugin) RegisterSigningHandler(h SigningHandler) error {
+	if p.GetSigningHandler(h.Name()) != nil {
+		return errors.ErrAlreadyExists(descriptor.KIND_SIGNINGHANDLER, h.Name())
+	}
+
+	hd := descriptor.SigningHandlerDescriptor{
+		Name:         h.Name(),
+		Description:  h.Description(),
+		ConsumerType: h.ConsumerType(),
+	}
+	p.descriptor.SigningHandlers = append(p.descriptor.SigningHandlers, hd)
+	p.signers[h.Name()] = h
+	return nil
+}
+
+func (p *plugin) GetSigningHandler(name string) SigningHandler {
+	return p.signers[name]
+}
+
+func (p *plugin) RegisterInputType(t InputType) error {
+	n := t.Name()
+	if t.Version() != "" {
+		n += runtime.VersionSeparator + t.Version()
+	}
+	if p.GetInputType(t.Name(), t.Version()) != nil {
+		return errors.ErrAlreadyExists(descriptor.KIND_INPUTTYPE, n)
+	}
+
+	optlist, err := cliOptions(t.Options())
+	if err != nil {
+		return err
+	}
+	desc := descriptor.InputTypeDescriptor{
+		ValueSetDefinition: descriptor.ValueSetDefinition{
+			Name:        t.Name(),
+			Version:     t.Version(),
+			Description: t.Description(),
+			Format:      t.Format(),
+			CLIOptions:  optlist,
+		},
+	}
+	p.descriptor.InputTypes = append(p.descriptor.InputTypes, desc)
+	p.inputScheme.RegisterByDecoder(n, t)
+	p.inputs[n] = t
+	return nil
+}
+
+func (p *plugin) DecodeInputSpecification(data []byte) (InputSpec, error) {
+	return p.inputScheme.Decode(data, nil)
+}
+
+func (p *plugin) GetInputType(name string, version string) InputType {
+	n := name
+	if version != "" {
+		n += runtime.VersionSeparator + version
+	}
+	return p.inputs[n]
+}
+
 func (p *plugin) RegisterLabelMergeSpecification(name, version string, spec *metav1.MergeAlgorithmSpecification, desc string) error {
 	e := descriptor.LabelMergeSpecification{
 		Name:                        name,
@@ -503,3 +572,25 @@ func (p *plugin) RegisterValueSet(s ValueSet) error {
 	}
 	return nil
 }
+
+func cliOptions(opts []options.OptionType) ([]CLIOption, error) {
+	var optlist []CLIOption
+	for _, o := range opts {
+		known := options.DefaultRegistry.GetOptionType(o.GetName())
+		if known != nil {
+			if o.ValueType() != known.ValueType() {
+				return nil, fmt.Errorf("option type %s[%s] conflicts with standard option type using value type %s", o.GetName(), o.ValueType(), known.ValueType())
+			}
+			optlist = append(optlist, CLIOption{
+				Name: o.GetName(),
+			})
+		} else {
+			optlist = append(optlist, CLIOption{
+				Name:        o.GetName(),
+				Type:        o.ValueType(),
+				Description: o.GetDescriptionText(),
+			})
+		}
+	}
+	return optlist, nil
+}
@@ -52,6 +52,14 @@ func (b *AccessMethodBase) Format() string {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type InputTypeBase = AccessMethodBase
+
+func MustNewInputTypeBase(name, version string, proto InputSpec, desc string, format string) InputTypeBase {
+	return MustNewAccessMethodBase(name, version, proto, desc, format)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type UploaderBase = nameDescription
 
 func MustNewUploaderBase(name, desc string) UploaderBase {
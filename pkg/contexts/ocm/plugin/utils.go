@@ -30,3 +30,23 @@ func (d *AccessDataWriter) WriteTo(w accessio.Writer) (int64, digest.Digest, err
 	}
 	return dw.Size(), dw.Digest(), nil
 }
+
+type InputDataWriter struct {
+	plugin Plugin
+	creds  json.RawMessage
+	spec   json.RawMessage
+	dir    string
+}
+
+func NewInputDataWriter(p Plugin, creds, spec json.RawMessage, dir string) *InputDataWriter {
+	return &InputDataWriter{p, creds, spec, dir}
+}
+
+func (d *InputDataWriter) WriteTo(w accessio.Writer) (int64, digest.Digest, error) {
+	dw := accessio.NewDefaultDigestWriter(accessio.NopWriteCloser(w))
+	err := d.plugin.GetInputBlob(dw, d.creds, d.spec, d.dir)
+	if err != nil {
+		return accessio.BLOB_UNKNOWN_SIZE, accessio.BLOB_UNKNOWN_DIGEST, err
+	}
+	return dw.Size(), dw.Digest(), nil
+}
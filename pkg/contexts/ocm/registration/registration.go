@@ -13,6 +13,7 @@ import (
 	pluginaccess "github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/plugin"
 	pluginaction "github.com/open-component-model/ocm/pkg/contexts/ocm/actionhandler/plugin"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/plugincacheattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
 	pluginupload "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/handlers/generic/plugin"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/download"
@@ -24,6 +25,8 @@ import (
 	pluginmerge "github.com/open-component-model/ocm/pkg/contexts/ocm/valuemergehandler/handlers/plugin"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/valuemergehandler/hpi"
 	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/signing"
+	pluginsigning "github.com/open-component-model/ocm/pkg/signing/handlers/plugin"
 )
 
 // RegisterExtensions registers all the extension provided by the found plugin.
@@ -32,6 +35,7 @@ func RegisterExtensions(ctx ocm.Context) error {
 
 	logger := Logger(ctx)
 	vmreg := valuemergehandler.For(ctx)
+	var sigreg signing.HandlerRegistry
 	for _, n := range pi.PluginNames() {
 		p := pi.Get(n)
 		if !p.IsValid() {
@@ -61,6 +65,21 @@ func RegisterExtensions(ctx ocm.Context) error {
 			}
 		}
 
+		for _, s := range p.GetDescriptor().SigningHandlers {
+			h, err := pluginsigning.New(p, s.Name)
+			if err != nil {
+				logger.Error("cannot create signing handler for plugin", "plugin", p.Name(), "handler", s.Name)
+			} else {
+				logger.Info("registering signing handler",
+					"plugin", p.Name(),
+					"algorithm", s.Name)
+				if sigreg == nil {
+					sigreg = signing.NewHandlerRegistry(signingattr.Get(ctx).HandlerRegistry())
+				}
+				sigreg.RegisterSignatureHandler(h)
+			}
+		}
+
 		for _, m := range p.GetDescriptor().AccessMethods {
 			name := m.Name
 			if m.Version != "" {
@@ -139,5 +158,8 @@ func RegisterExtensions(ctx ocm.Context) error {
 			}
 		}
 	}
+	if sigreg != nil {
+		return signingattr.SetHandlerRegistry(ctx, sigreg)
+	}
 	return nil
 }
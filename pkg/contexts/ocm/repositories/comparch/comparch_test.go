@@ -225,4 +225,25 @@ var _ = Describe("Repository", func() {
 			Value:                  D_OTHERDATA,
 		}))
 	})
+
+	It("deletes component archive", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		env := env.NewEnvironment(env.ModifiableTestData())
+		octx := env.OCMContext()
+		spec := Must(comparch.NewRepositorySpec(accessobj.ACC_WRITABLE, TAR_COMPARCH, accessio.PathFileSystem(env)))
+		repo := Must(spec.Repository(octx, nil))
+		finalize.Close(repo, "repo")
+
+		deleter, ok := repo.(ocm.RepositoryVersionDeleter)
+		Expect(ok).To(BeTrue())
+		Expect(deleter.DeleteVersion(COMPONENT_NAME, "0.1.0")).To(MatchError(ContainSubstring("not found")))
+		MustBeSuccessful(deleter.DeleteVersion(COMPONENT_NAME, COMPONENT_VERSION))
+		Expect(repo.ExistsComponentVersion(COMPONENT_NAME, COMPONENT_VERSION)).To(BeFalse())
+		Expect(Must(vfs.Exists(env, TAR_COMPARCH))).To(BeTrue())
+
+		MustBeSuccessful(finalize.Finalize())
+		Expect(Must(vfs.Exists(env, TAR_COMPARCH))).To(BeFalse())
+	})
 })
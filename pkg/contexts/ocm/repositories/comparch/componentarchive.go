@@ -104,6 +104,8 @@ type componentArchiveContainer struct {
 	base *accessobj.FileSystemBlobAccess
 	spec *RepositorySpec
 	repo cpi.Repository
+
+	deleted bool
 }
 
 var _ support.ComponentVersionContainer = (*componentArchiveContainer)(nil)
@@ -117,10 +119,29 @@ func (c *componentArchiveContainer) GetParentViewManager() cpi.ComponentAccessVi
 }
 
 func (c *componentArchiveContainer) Close() error {
+	if c.deleted {
+		err := c.base.Close()
+		if err != nil {
+			return err
+		}
+		return c.spec.GetPathFileSystem().RemoveAll(c.spec.FilePath)
+	}
 	c.Update()
 	return c.base.Close()
 }
 
+// Delete marks the archive to be removed from the filesystem on close.
+func (c *componentArchiveContainer) Delete() error {
+	if c.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	if c.spec == nil || c.spec.FilePath == "" || c.spec.GetPathFileSystem() == nil {
+		return errors.ErrNotSupported("component version deletion", "component archive without file path")
+	}
+	c.deleted = true
+	return nil
+}
+
 func (c *componentArchiveContainer) GetContext() cpi.Context {
 	return c.ctx
 }
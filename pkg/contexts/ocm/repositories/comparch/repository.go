@@ -114,7 +114,7 @@ func (r *RepositoryImpl) ExistsComponentVersion(name string, ref string) (bool,
 	if r.arch == nil {
 		return false, accessio.ErrClosed
 	}
-	return r.arch.GetName() == name && r.arch.GetVersion() == ref, nil
+	return r.arch.GetName() == name && r.arch.GetVersion() == ref && !r.arch.container.deleted, nil
 }
 
 func (r *RepositoryImpl) LookupComponentVersion(name string, version string) (cpi.ComponentVersionAccess, error) {
@@ -212,6 +212,17 @@ func (c *ComponentAccessImpl) AddVersion(access cpi.ComponentVersionAccess) erro
 	return nil
 }
 
+// DeleteVersion deletes the component version provided by the
+// component archive. Because an archive always describes exactly
+// one component version, the archive itself is removed from the
+// filesystem when it is closed.
+func (c *ComponentAccessImpl) DeleteVersion(version string, gc ...bool) error {
+	if ok, _ := c.HasVersion(version); !ok || c.repo.arch.container.deleted {
+		return cpi.ErrComponentVersionNotFound(c.GetName(), version)
+	}
+	return c.repo.arch.container.Delete()
+}
+
 func (c *ComponentAccessImpl) NewVersion(version string, overrides ...bool) (cpi.ComponentVersionAccess, error) {
 	if version != c.repo.arch.GetVersion() {
 		return nil, errors.ErrNotSupported(cpi.KIND_COMPONENTVERSION, version, fmt.Sprintf("component archive %s:%s", c.GetName(), c.repo.arch.GetVersion()))
@@ -98,8 +98,8 @@ func (c *componentAccessImpl) ListVersions() ([]string, error) {
 	}
 	result := make([]string, 0, len(tags))
 	for _, t := range tags {
-		// omit reported digests (typically for ctf)
-		if ok, _ := artdesc.IsDigest(t); !ok {
+		// omit reported digests (typically for ctf) and referrers
+		if ok, _ := artdesc.IsDigest(t); !ok && !IsReferrersTag(t) {
 			result = append(result, toVersion(t))
 		}
 	}
@@ -112,8 +112,8 @@ func (c *componentAccessImpl) HasVersion(vers string) (bool, error) {
 		return false, err
 	}
 	for _, t := range tags {
-		// omit reported digests (typically for ctf)
-		if ok, _ := artdesc.IsDigest(t); !ok {
+		// omit reported digests (typically for ctf) and referrers
+		if ok, _ := artdesc.IsDigest(t); !ok && !IsReferrersTag(t) {
 			if vers == t {
 				return true, nil
 			}
@@ -167,6 +167,45 @@ func (c *componentAccessImpl) AddVersion(access cpi.ComponentVersionAccess) erro
 	return mine.impl.Update(!mine.impl.UseDirectAccess())
 }
 
+func (c *componentAccessImpl) DeleteVersion(version string, gc ...bool) error {
+	if _, err := semver.NewVersion(version); err != nil {
+		return errors.ErrInvalidWrap(err, cpi.KIND_COMPONENTVERSION, c.name+"/"+version)
+	}
+	deleter, ok := c.namespace.(oci.ArtifactDeleter)
+	if !ok {
+		return errors.ErrNotSupported("component version deletion", c.repo.ocirepo.GetSpecification().GetKind())
+	}
+	acc, err := c.namespace.GetArtifact(toTag(version))
+	if err != nil {
+		if errors.IsErrNotFound(err) {
+			return cpi.ErrComponentVersionNotFoundWrap(err, c.name, version)
+		}
+		return err
+	}
+	digest := acc.Digest()
+	acc.Close()
+
+	err = deleter.DeleteArtifact(digest)
+	if err != nil {
+		return errors.Wrapf(err, "cannot delete component version %s/%s", c.name, version)
+	}
+	err = deleteReferrers(c.namespace, deleter, digest)
+	if err != nil {
+		return errors.Wrapf(err, "cannot delete detached signatures of component version %s/%s", c.name, version)
+	}
+	if utils.Optional(gc...) {
+		// registries typically remove unreferenced blobs on their own,
+		// only repositories explicitly supporting it are cleaned up here.
+		if g, ok := c.repo.ocirepo.(oci.BlobGarbageCollector); ok {
+			_, err = g.CollectGarbage()
+			if err != nil {
+				return errors.Wrapf(err, "cannot remove unreferenced blobs")
+			}
+		}
+	}
+	return nil
+}
+
 func (c *componentAccessImpl) NewVersion(version string, overrides ...bool) (cpi.ComponentVersionAccess, error) {
 	v, err := c.View(false)
 	if err != nil {
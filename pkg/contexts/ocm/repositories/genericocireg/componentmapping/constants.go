@@ -48,6 +48,10 @@ var ComponentDescriptorMimeType = []string{
 // ComponentDescriptorConfigMimeType is the mimetype for component-descriptor-oci-cfg-blobs.
 const ComponentDescriptorConfigMimeType = "application/vnd.ocm.software.component.config.v1+json"
 
+// SignatureMimeType is the artifact type and layer mimetype used for
+// detached signatures of component versions.
+const SignatureMimeType = "application/vnd.ocm.software.signature.v1+json"
+
 // LegacyComponentDescriptorConfigMimeType is the mimetype for the legacy component-descriptor-oci-cfg-blobs.
 const (
 	LegacyComponentDescriptorConfigMimeType  = "application/vnd.gardener.cloud.cnudie.component.config.v1+json"
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/opencontainers/go-digest"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/compositionmodeattr"
 	ocihdlr "github.com/open-component-model/ocm/pkg/contexts/ocm/blobhandler/handlers/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi/support"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -54,7 +56,12 @@ type ComponentVersionContainer struct {
 	version  string
 	access   oci.ArtifactAccess
 	manifest oci.ManifestAccess
-	state    accessobj.State
+	// layerLock is shared by all storage contexts for the manifest.
+	layerLock sync.Mutex
+	state     accessobj.State
+	// signatures are detached signatures to be stored
+	// together with the next update.
+	signatures metav1.Signatures
 }
 
 var _ support.ComponentVersionContainer = (*ComponentVersionContainer)(nil)
@@ -210,12 +217,28 @@ func (c *ComponentVersionContainer) Update() error {
 			return fmt.Errorf("failed to update state: %w", err)
 		}
 
+		// detached signatures refer to the digest of the manifest,
+		// they must be re-attached to the new manifest.
+		old, sigs, err := c.getReferringSignatures()
+		if err != nil {
+			return fmt.Errorf("unable to get detached signatures: %w", err)
+		}
+
 		logger.Debug("add oci artifact")
 		if _, err := c.comp.namespace.AddArtifact(c.manifest, toTag(c.version)); err != nil {
 			return fmt.Errorf("unable to add artifact: %w", err)
 		}
+		if err := c.reattachSignatures(old, sigs); err != nil {
+			return fmt.Errorf("unable to re-attach detached signatures: %w", err)
+		}
 	}
 
+	for len(c.signatures) > 0 {
+		if err := c.addDetachedSignature(&c.signatures[0]); err != nil {
+			return fmt.Errorf("unable to add detached signature: %w", err)
+		}
+		c.signatures = c.signatures[1:]
+	}
 	return nil
 }
 
@@ -262,7 +285,7 @@ func (c *ComponentVersionContainer) GetBlobData(name string) (cpi.DataAccess, er
 }
 
 func (c *ComponentVersionContainer) GetStorageContext(cv cpi.ComponentVersionAccess) cpi.StorageContext {
-	return ocihdlr.New(cv, c.comp.repo.ocirepo.GetSpecification().GetKind(), c.comp.repo.ocirepo, c.comp.namespace, c.manifest)
+	return ocihdlr.New(cv, c.comp.repo.ocirepo.GetSpecification().GetKind(), c.comp.repo.ocirepo, c.comp.namespace, c.manifest, &c.layerLock)
 }
 
 func (c *ComponentVersionContainer) AddBlobFor(storagectx cpi.StorageContext, blob cpi.BlobAccess, refName string, global cpi.AccessSpec) (cpi.AccessSpec, error) {
@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package genericocireg
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/annotations"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi/support"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg/componentmapping"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// Detached signatures are stored as OCI artifacts referring to the
+// manifest of the component version by their subject.
+// To be found without a registry supporting the referrers API, they are
+// additionally listed by an index tagged according to the referrers tag
+// schema of the OCI distribution spec (<alg>-<hex digest>). For transport
+// archives this index acts as sidecar artifact.
+// Because the subject must be stored, signatures for new or modified
+// component versions are kept until the next update.
+
+const KIND_SIGNATURE_ARTIFACT = "signature artifact"
+
+var _ support.DetachedSignatureContainer = (*ComponentVersionContainer)(nil)
+
+// ReferrersTag provides the tag used to list the referrers of
+// the artifact with the given digest.
+func ReferrersTag(d digest.Digest) string {
+	return d.Algorithm().String() + "-" + d.Encoded()
+}
+
+// IsReferrersTag checks whether the given tag is a referrers tag.
+// Such tags do not describe component versions.
+func IsReferrersTag(t string) bool {
+	i := strings.Index(t, "-")
+	if i < 0 {
+		return false
+	}
+	return digest.NewDigestFromEncoded(digest.Algorithm(t[:i]), t[i+1:]).Validate() == nil
+}
+
+func (c *ComponentVersionContainer) GetDetachedSignatures() (metav1.Signatures, error) {
+	sigs, err := c.getDetachedSignatures()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range c.signatures {
+		sigs.Set(s)
+	}
+	return sigs, nil
+}
+
+func (c *ComponentVersionContainer) AddDetachedSignature(sig *metav1.Signature) error {
+	if c.IsReadOnly() {
+		return accessio.ErrReadOnly
+	}
+	if c.state.HasChanged() {
+		c.signatures.Set(*sig)
+		return nil
+	}
+	err := c.addDetachedSignature(sig)
+	if errors.IsErrNotFoundKind(err, oci.KIND_OCIARTIFACT) {
+		// version not yet stored
+		c.signatures.Set(*sig)
+		return nil
+	}
+	return err
+}
+
+func (c *ComponentVersionContainer) getDetachedSignatures() (metav1.Signatures, error) {
+	subject, err := c.subject()
+	if err != nil {
+		if errors.IsErrNotFoundKind(err, oci.KIND_OCIARTIFACT) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	idx, err := c.comp.namespace.GetArtifact(ReferrersTag(subject.Digest))
+	if err != nil {
+		if errors.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer idx.Close()
+
+	index := idx.IndexAccess()
+	if index == nil {
+		return nil, errors.ErrInvalid(oci.KIND_OCIARTIFACT, ReferrersTag(subject.Digest))
+	}
+	var sigs metav1.Signatures
+	for _, d := range index.GetDescriptor().Manifests {
+		if d.ArtifactType != componentmapping.SignatureMimeType {
+			continue
+		}
+		sig, err := readSignature(idx, d.Digest)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, *sig)
+	}
+	return sigs, nil
+}
+
+func (c *ComponentVersionContainer) addDetachedSignature(sig *metav1.Signature) error {
+	subject, err := c.subject()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(sig)
+	if err != nil {
+		return err
+	}
+
+	art, err := c.comp.namespace.NewArtifact()
+	if err != nil {
+		return err
+	}
+	defer art.Close()
+	m := art.ManifestAccess()
+	err = m.SetConfigBlob(blobaccess.ForString(artdesc.MediaTypeEmptyJSON, "{}"), nil)
+	if err != nil {
+		return err
+	}
+	_, err = m.AddLayer(blobaccess.ForData(componentmapping.SignatureMimeType, data), nil)
+	if err != nil {
+		return err
+	}
+	desc := m.GetDescriptor()
+	desc.ArtifactType = componentmapping.SignatureMimeType
+	desc.Subject = subject
+	desc.Annotations = map[string]string{annotations.SIGNATURE_ANNOTATION: sig.Name}
+
+	tag := ReferrersTag(subject.Digest)
+	idx, err := c.comp.namespace.GetArtifact(tag)
+	if err != nil {
+		if !errors.IsErrNotFound(err) {
+			return err
+		}
+		idx, err = c.comp.namespace.NewArtifact(artdesc.NewIndexArtifact())
+		if err != nil {
+			return err
+		}
+	}
+	defer idx.Close()
+
+	index := idx.IndexAccess()
+	if index == nil {
+		return errors.ErrInvalid(oci.KIND_OCIARTIFACT, tag)
+	}
+	list := index.GetDescriptor()
+	// replace signature with the same name
+	manifests := list.Manifests[:0]
+	for _, d := range list.Manifests {
+		if d.ArtifactType != componentmapping.SignatureMimeType || d.Annotations[annotations.SIGNATURE_ANNOTATION] != sig.Name {
+			manifests = append(manifests, d)
+		}
+	}
+	list.Manifests = manifests
+
+	_, err = idx.AddArtifact(art, nil)
+	if err != nil {
+		return errors.Wrapf(err, "cannot add %s", KIND_SIGNATURE_ARTIFACT)
+	}
+	entry := &list.Manifests[len(list.Manifests)-1]
+	entry.ArtifactType = desc.ArtifactType
+	entry.Annotations = desc.Annotations
+	_, err = c.comp.namespace.AddArtifact(idx, tag)
+	return err
+}
+
+// getReferringSignatures provides the digest of the stored manifest of the
+// component version together with the detached signatures referring to it.
+func (c *ComponentVersionContainer) getReferringSignatures() (digest.Digest, metav1.Signatures, error) {
+	subject, err := c.subject()
+	if err != nil {
+		if errors.IsErrNotFoundKind(err, oci.KIND_OCIARTIFACT) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	sigs, err := c.getDetachedSignatures()
+	if err != nil {
+		return "", nil, err
+	}
+	return subject.Digest, sigs, nil
+}
+
+// reattachSignatures keeps the detached signatures of a replaced manifest
+// with the given digest. Signatures with the same name already
+// scheduled for storing take precedence. The referrers of the replaced
+// manifest are deleted, if supported by the namespace.
+func (c *ComponentVersionContainer) reattachSignatures(old digest.Digest, sigs metav1.Signatures) error {
+	if len(sigs) == 0 {
+		return nil
+	}
+	subject, err := c.subject()
+	if err != nil {
+		return err
+	}
+	if subject.Digest == old {
+		return nil
+	}
+	for _, s := range sigs {
+		if c.signatures.GetByName(s.Name) == nil {
+			c.signatures = append(c.signatures, s)
+		}
+	}
+	if deleter, ok := c.comp.namespace.(oci.ArtifactDeleter); ok {
+		err := deleteReferrers(c.comp.namespace, deleter, old)
+		if err != nil && !errors.IsErrNotSupported(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// subject provides the descriptor of the stored manifest
+// of the component version.
+func (c *ComponentVersionContainer) subject() (*artdesc.Descriptor, error) {
+	art, err := c.comp.namespace.GetArtifact(toTag(c.version))
+	if err != nil {
+		return nil, err
+	}
+	defer art.Close()
+	blob, err := art.Blob()
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+	return &artdesc.Descriptor{
+		MediaType: blob.MimeType(),
+		Digest:    blob.Digest(),
+		Size:      blob.Size(),
+	}, nil
+}
+
+// deleteReferrers deletes the signature artifacts referring to the
+// artifact with the given digest together with the index listing them.
+func deleteReferrers(ns oci.NamespaceAccess, deleter oci.ArtifactDeleter, subject digest.Digest) error {
+	idx, err := ns.GetArtifact(ReferrersTag(subject))
+	if err != nil {
+		if errors.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	d := idx.Digest()
+	var list []artdesc.Descriptor
+	if index := idx.IndexAccess(); index != nil {
+		list = index.GetDescriptor().Manifests
+	}
+	idx.Close()
+
+	if err := deleter.DeleteArtifact(d); err != nil && !errors.IsErrNotFound(err) {
+		return err
+	}
+	for _, m := range list {
+		if m.ArtifactType != componentmapping.SignatureMimeType {
+			continue
+		}
+		if err := deleter.DeleteArtifact(m.Digest); err != nil && !errors.IsErrNotFound(err) {
+			return errors.Wrapf(err, "cannot delete %s %s", KIND_SIGNATURE_ARTIFACT, m.Digest)
+		}
+	}
+	return nil
+}
+
+func readSignature(idx oci.ArtifactAccess, d digest.Digest) (*metav1.Signature, error) {
+	art, err := idx.GetArtifact(d)
+	if err != nil {
+		return nil, err
+	}
+	defer art.Close()
+
+	m := art.ManifestAccess()
+	if m == nil || len(m.GetDescriptor().Layers) != 1 {
+		return nil, errors.ErrInvalid(KIND_SIGNATURE_ARTIFACT, d.String())
+	}
+	blob, err := m.GetBlob(m.GetDescriptor().Layers[0].Digest)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+	data, err := blob.Get()
+	if err != nil {
+		return nil, err
+	}
+	var sig metav1.Signature
+	err = json.Unmarshal(data, &sig)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, KIND_SIGNATURE_ARTIFACT, d.String())
+	}
+	return &sig, nil
+}
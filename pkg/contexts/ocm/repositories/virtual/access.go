@@ -32,6 +32,12 @@ type Access interface {
 	Close() error
 }
 
+// VersionDeleter is an optional interface for Access implementations
+// supporting the deletion of component versions.
+type VersionDeleter interface {
+	DeleteComponentVersion(comp, version string) error
+}
+
 type RepositorySpecProvider interface {
 	GetSpecification() cpi.RepositorySpec
 }
@@ -89,6 +89,21 @@ func (c *componentAccessImpl) AddVersion(access cpi.ComponentVersionAccess) erro
 	return mine.impl.Update(!mine.impl.UseDirectAccess())
 }
 
+func (c *componentAccessImpl) DeleteVersion(version string, gc ...bool) error {
+	d, ok := c.repo.access.(VersionDeleter)
+	if !ok {
+		return errors.ErrNotSupported("component version deletion")
+	}
+	ok, err := c.HasVersion(version)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return cpi.ErrComponentVersionNotFound(c.name, version)
+	}
+	return d.DeleteComponentVersion(c.name, version)
+}
+
 func (c *componentAccessImpl) NewVersion(version string, overrides ...bool) (cpi.ComponentVersionAccess, error) {
 	v, err := c.View(false)
 	if err != nil {
@@ -38,8 +38,14 @@ func SignComponentVersion(cv ocm.ComponentVersionAccess, name string, optlist ..
 
 func VerifyComponentVersion(cv ocm.ComponentVersionAccess, name string, optlist ...Option) (*metav1.DigestSpec, error) {
 	var opts Options
-	if len(cv.GetDescriptor().Signatures) == 1 && name == "" {
-		name = cv.GetDescriptor().Signatures[0].Name
+	if name == "" {
+		sigs, err := GetSignatures(cv)
+		if err != nil {
+			return nil, err
+		}
+		if len(sigs) == 1 {
+			name = sigs[0].Name
+		}
 	}
 
 	opts.Eval(
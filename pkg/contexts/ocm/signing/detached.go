@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi/support"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+const KIND_DETACHED_SIGNATURE = "detached signature"
+
+// SupportsDetachedSignatures checks whether the repository implementation
+// of a component version is able to store detached signatures.
+func SupportsDetachedSignatures(cv ocm.ComponentVersionAccess) bool {
+	_, err := support.GetComponentVersionContainer[support.DetachedSignatureContainer](cv)
+	return err == nil
+}
+
+// GetDetachedSignatures provides the signatures stored for a component
+// version separately from its component descriptor.
+// For repositories without support for detached signatures the list is empty.
+func GetDetachedSignatures(cv ocm.ComponentVersionAccess) (metav1.Signatures, error) {
+	c, err := support.GetComponentVersionContainer[support.DetachedSignatureContainer](cv)
+	if err != nil {
+		return nil, nil
+	}
+	return c.GetDetachedSignatures()
+}
+
+// AddDetachedSignature stores a signature for a component version without
+// modifying its component descriptor.
+func AddDetachedSignature(cv ocm.ComponentVersionAccess, sig *metav1.Signature) error {
+	c, err := support.GetComponentVersionContainer[support.DetachedSignatureContainer](cv)
+	if err != nil {
+		return errors.ErrNotSupported(KIND_DETACHED_SIGNATURE, sig.Name, cv.Repository().GetSpecification().GetKind())
+	}
+	return c.AddDetachedSignature(sig)
+}
+
+// GetSignatures provides the signatures of a component version, embedded
+// in the component descriptor and detached ones. If both variants
+// use the same name, the embedded one is provided.
+func GetSignatures(cv ocm.ComponentVersionAccess) (metav1.Signatures, error) {
+	cd := cv.GetDescriptor().Copy()
+	if err := addDetachedSignatures(cv, cd); err != nil {
+		return nil, err
+	}
+	return cd.Signatures, nil
+}
+
+// TransferDetachedSignatures copies the detached signatures of a component
+// version to another component version. If the target does not support
+// detached signatures, nothing is done.
+func TransferDetachedSignatures(src, tgt ocm.ComponentVersionAccess) error {
+	if !SupportsDetachedSignatures(tgt) {
+		return nil
+	}
+	sigs, err := GetDetachedSignatures(src)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return nil
+	}
+	found, err := GetDetachedSignatures(tgt)
+	if err != nil {
+		return err
+	}
+	for i := range sigs {
+		if f := found.GetByName(sigs[i].Name); f != nil && f.Signature == sigs[i].Signature {
+			continue
+		}
+		if err := AddDetachedSignature(tgt, &sigs[i]); err != nil {
+			return errors.Wrapf(err, "%s %q", KIND_DETACHED_SIGNATURE, sigs[i].Name)
+		}
+	}
+	return nil
+}
+
+// addDetachedSignatures adds the detached signatures of a component version
+// not shadowed by embedded ones to the given descriptor.
+func addDetachedSignatures(cv ocm.ComponentVersionAccess, cd *compdesc.ComponentDescriptor) error {
+	sigs, err := GetDetachedSignatures(cv)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get %ss", KIND_DETACHED_SIGNATURE)
+	}
+	for _, s := range sigs {
+		if cd.GetSignatureIndex(s.Name) < 0 {
+			cd.Signatures = append(cd.Signatures, s)
+		}
+	}
+	return nil
+}
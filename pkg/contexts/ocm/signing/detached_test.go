@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/genericocireg/componentmapping"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const DETACHED_TARGET = "/tmp/detached"
+
+var _ = Describe("detached signatures", func() {
+	var env *Builder
+
+	lookup := func(path string, f func(repo ocm.Repository, cv ocm.ComponentVersionAccess)) {
+		repo := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, path, 0, env))
+		defer Close(repo, "repo")
+		cv := Must(repo.LookupComponentVersion(COMPONENTA, VERSION))
+		defer Close(cv, "cv")
+		f(repo, cv)
+	}
+
+	apply := func(path string, opts ...Option) error {
+		var err error
+		lookup(path, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			opts = append([]Option{Resolver(repo), VerifyDigests()}, opts...)
+			_, err = Apply(nil, nil, cv, NewOptions(opts...))
+		})
+		return err
+	}
+
+	tags := func(repo ocm.Repository) []string {
+		ns := Must(genericocireg.GetOCIRepository(repo).LookupNamespace(componentmapping.ComponentDescriptorNamespace + "/" + COMPONENTA))
+		defer Close(ns, "namespace")
+		return Must(ns.ListTags())
+	}
+
+	sign := func(name string, opts ...Option) Option {
+		return NewOptions(append([]Option{Sign(signingattr.Get(env.OCMContext()).GetSigner(SIGN_ALGO), name)}, opts...)...)
+	}
+
+	BeforeEach(func() {
+		env = NewBuilder()
+		env.RSAKeyPair(SIGNATURE, SIGNATURE2)
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENTA, VERSION, func() {
+				env.Provider(PROVIDER)
+				env.Resource("text", "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, "test data")
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("stores signature without updating descriptor", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			Expect(cv.GetDescriptor().Signatures).To(BeEmpty())
+			sigs := Must(GetDetachedSignatures(cv))
+			Expect(sigs).To(HaveLen(1))
+			Expect(sigs[0].Name).To(Equal(SIGNATURE))
+
+			comp := Must(repo.LookupComponent(COMPONENTA))
+			defer Close(comp, "component")
+			Expect(comp.ListVersions()).To(ConsistOf(VERSION))
+		})
+
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE)))
+	})
+
+	It("verifies embedded and detached signatures", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Update())))
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE2, Detached())))
+
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			Expect(cv.GetDescriptor().Signatures).To(HaveLen(1))
+			sigs := Must(GetSignatures(cv))
+			Expect(sigs).To(HaveLen(2))
+			Expect(sigs[1].Name).To(Equal(SIGNATURE2))
+		})
+
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE)))
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE2)))
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE, SIGNATURE2)))
+	})
+
+	It("replaces detached signature", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			Expect(GetDetachedSignatures(cv)).To(HaveLen(1))
+		})
+	})
+
+	It("rejects invalid detached signature", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			sig := Must(GetDetachedSignatures(cv))[0]
+			sig.Name = SIGNATURE2
+			sig.Digest.Value = "0a835d52867572bdaf7da7fb35ee59ad45c3db2dacdeeca62178edd5d07ef08c"
+			MustBeSuccessful(AddDetachedSignature(cv, &sig))
+		})
+		Expect(apply(ARCH, VerifySignature(SIGNATURE2))).To(MatchError(ContainSubstring("signature digest (0a835d52867572bdaf7da7fb35ee59ad45c3db2dacdeeca62178edd5d07ef08c) does not match found digest")))
+	})
+
+	It("transfers detached signatures", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+
+		target := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE, DETACHED_TARGET, 0o700, accessio.FormatDirectory, env))
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			MustBeSuccessful(transfer.TransferVersion(nil, nil, cv, target, nil))
+		})
+		MustBeSuccessful(target.Close())
+
+		MustBeSuccessful(apply(DETACHED_TARGET, VerifySignature(SIGNATURE)))
+
+		// countersigning is transferred for already existing versions
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE2, Detached())))
+		target = Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, DETACHED_TARGET, 0, env))
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			MustBeSuccessful(transfer.TransferVersion(nil, nil, cv, target, nil))
+		})
+		MustBeSuccessful(target.Close())
+
+		MustBeSuccessful(apply(DETACHED_TARGET, VerifySignature(SIGNATURE, SIGNATURE2)))
+	})
+	It("keeps detached signatures when descriptor is updated", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE2, Update())))
+
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			Expect(cv.GetDescriptor().Signatures).To(HaveLen(1))
+			Expect(cv.GetDescriptor().Signatures[0].Name).To(Equal(SIGNATURE2))
+			sigs := Must(GetDetachedSignatures(cv))
+			Expect(sigs).To(HaveLen(1))
+			Expect(sigs[0].Name).To(Equal(SIGNATURE))
+			// only the referrers of the actual manifest are kept
+			Expect(tags(repo)).To(HaveLen(2))
+		})
+
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE)))
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE2)))
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE, SIGNATURE2)))
+	})
+
+	It("embeds signature with the name of a detached one", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached(), Issuer("detached"))))
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Update())))
+
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			embedded := cv.GetDescriptor().Signatures
+			Expect(embedded).To(HaveLen(1))
+			Expect(embedded[0].Signature.Issuer).To(BeEmpty())
+			sigs := Must(GetDetachedSignatures(cv))
+			Expect(sigs).To(HaveLen(1))
+			Expect(sigs[0].Signature.Issuer).To(Equal("detached"))
+		})
+		MustBeSuccessful(apply(ARCH, VerifySignature(SIGNATURE)))
+	})
+
+	It("deletes detached signatures together with the version", func() {
+		MustBeSuccessful(apply(ARCH, sign(SIGNATURE, Detached())))
+
+		lookup(ARCH, func(repo ocm.Repository, cv ocm.ComponentVersionAccess) {
+			Expect(tags(repo)).To(HaveLen(2))
+			MustBeSuccessful(repo.(ocm.RepositoryVersionDeleter).DeleteVersion(COMPONENTA, VERSION))
+			Expect(tags(repo)).To(BeEmpty())
+		})
+	})
+})
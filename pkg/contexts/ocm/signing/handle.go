@@ -150,6 +150,11 @@ func _apply(state WalkingState, nv common.NameVersion, cv ocm.ComponentVersionAc
 		prefix = "re"
 		ctx = vi.CreateContext(cv.GetDescriptor(), state.Context)
 	}
+	// signatures stored separately are verified like embedded ones,
+	// but never written to the component descriptor.
+	if err := addDetachedSignatures(cv, ctx.Descriptor); err != nil {
+		return nil, err
+	}
 
 	if ctx.IsRoot() {
 		ctx.RootContextInfo.Sign = opts.DoSign()
@@ -261,13 +266,25 @@ func _apply(state WalkingState, nv common.NameVersion, cv ocm.ComponentVersionAc
 			spec = dig
 		}
 	}
+	if len(opts.Policies) > 0 {
+		if err := checkPolicies(cv, cd, opts); err != nil {
+			return nil, err
+		}
+	}
 	err := ctx.Propagate(spec)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed propagating digest context")
 	}
 
+	// the descriptor includes detached signatures, an existing signature
+	// is only kept, if it is stored the requested way (embedded or detached).
 	found := cd.GetSignatureIndex(opts.SignatureName())
-	if opts.DoSign() && (!opts.DoVerify() || found == -1) {
+	stored := found
+	if found >= 0 && (cv.GetDescriptor().GetSignatureIndex(opts.SignatureName()) >= 0) == opts.Detached {
+		stored = -1
+	}
+	var created *metav1.Signature
+	if opts.DoSign() && (!opts.DoVerify() || stored == -1) {
 		priv, err := opts.PrivateKey()
 		if err != nil {
 			return nil, err
@@ -293,11 +310,25 @@ func _apply(state WalkingState, nv common.NameVersion, cv ocm.ComponentVersionAc
 				Issuer:    sig.Issuer,
 			},
 		}
+		if opts.TSAURL != "" {
+			state.Logger.Debug("timestamp signature", "cv", nv, "tsa", opts.TSAURL)
+			if err := Timestamp(cv.GetContext().CredentialsContext(), opts.TSAURL, &signature); err != nil {
+				return nil, err
+			}
+		}
 		if found >= 0 {
 			cd.Signatures[found] = signature
 		} else {
 			cd.Signatures = append(cd.Signatures, signature)
 		}
+		created = &signature
+		if opts.Detached {
+			state.Logger.Debug("store detached signature", "cv", nv)
+			if err := AddDetachedSignature(cv, &signature); err != nil {
+				return nil, errors.Wrapf(err, "failed storing detached signature")
+			}
+			ctx.Signed = true
+		}
 	}
 	state.Closure[nv] = vi
 
@@ -314,9 +345,13 @@ func _apply(state WalkingState, nv common.NameVersion, cv ocm.ComponentVersionAc
 		} else {
 			orig.NestedDigests = ctx.GetDigests()
 		}
-		if opts.DoSign() {
-			state.Logger.Debug("update signature", "cv", nv)
-			orig.Signatures = cd.Signatures
+		if opts.DoSign() && !opts.Detached {
+			// only signatures created in this run are embedded,
+			// the descriptor used for signing includes detached ones.
+			if created != nil {
+				state.Logger.Debug("update signature", "cv", nv)
+				orig.Signatures.Set(*created)
+			}
 			ctx.Signed = true
 		}
 		err := cv.Update()
@@ -371,6 +406,15 @@ func doVerify(cd *compdesc.ComponentDescriptor, state WalkingState, signatureNam
 			}
 		}
 		sig := &cd.Signatures[f]
+		if pub != nil {
+			t, err := SignatureTime(sig, opts.RootCerts)
+			if err != nil {
+				return nil, err
+			}
+			if err := opts.checkCertAt(pub, n, t); err != nil {
+				return nil, fmt.Errorf("public key not valid: %w", err)
+			}
+		}
 		verifier := opts.Registry.GetVerifier(sig.Signature.Algorithm)
 		if verifier == nil {
 			if opts.SignatureConfigured(n) {
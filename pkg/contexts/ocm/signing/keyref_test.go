@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keystoreattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider/file"
+)
+
+const KEYSTORE = "release"
+
+var _ = Describe("key references", func() {
+	var env *Builder
+
+	apply := func(opts ...Option) error {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENTA, VERSION))
+		defer Close(cv, "source cv")
+		opts = append([]Option{Resolver(src), VerifyDigests()}, opts...)
+		_, err := Apply(nil, nil, cv, NewOptions(opts...))
+		return err
+	}
+
+	BeforeEach(func() {
+		env = NewBuilder()
+
+		key := Must(rsa.GenerateKey(rand.Reader, 2048))
+		//nolint: staticcheck // legacy PEM encryption is supported for key bundles
+		block := Must(x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), []byte("secret"), x509.PEMCipherAES256))
+		block.Headers[file.HEADER_ALIAS] = "signing"
+		MustBeSuccessful(vfs.WriteFile(env, "/keys.pem", pem.EncodeToMemory(block), 0o600))
+
+		MustBeSuccessful(keystoreattr.Set(env.OCMContext(), keystoreattr.Stores{
+			KEYSTORE: {Type: file.TYPE, Path: "/keys.pem"},
+		}))
+		env.CredentialsContext().SetCredentialsForConsumer(keyprovider.GetConsumerId(KEYSTORE),
+			credentials.NewCredentials(common.Properties{keyprovider.ATTR_PASSWORD: "secret"}))
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENTA, VERSION, func() {
+				env.Provider(PROVIDER)
+				env.Resource("text", "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, "test data")
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("signs and verifies with key from key store", func() {
+		MustBeSuccessful(apply(
+			Sign(signingattr.Get(env.OCMContext()).GetSigner(SIGN_ALGO), SIGNATURE),
+			KeyRef(SIGNATURE, KEYSTORE+":signing"),
+			Update(),
+		))
+		MustBeSuccessful(apply(
+			VerifySignature(SIGNATURE),
+			KeyRef(SIGNATURE, KEYSTORE+":signing"),
+		))
+	})
+
+	It("fails for unknown key", func() {
+		err := apply(
+			Sign(signingattr.Get(env.OCMContext()).GetSigner(SIGN_ALGO), SIGNATURE),
+			KeyRef(SIGNATURE, KEYSTORE+":other"),
+		)
+		Expect(err).To(MatchError(`key reference "release:other": key "other" not found in release`))
+	})
+
+	It("fails for unknown key store", func() {
+		err := apply(
+			VerifySignature(SIGNATURE),
+			KeyRef(SIGNATURE, "hsm:signing"),
+		)
+		Expect(err).To(MatchError(ContainSubstring(`key store "hsm" is unknown`)))
+	})
+})
@@ -8,14 +8,19 @@ import (
 	"crypto/x509"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/keystoreattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/tsaattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/signing"
 	"github.com/open-component-model/ocm/pkg/signing/hasher/sha256"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
 	"github.com/open-component-model/ocm/pkg/utils"
 )
 
@@ -81,6 +86,23 @@ func (o *recursive) ApplySigningOption(opts *Options) {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type detached struct {
+	flag bool
+}
+
+// Detached provides an option requesting to store a created signature
+// separately from the component descriptor. The stored component
+// descriptor is not modified for this.
+func Detached(flags ...bool) Option {
+	return &detached{utils.GetOptionFlag(flags...)}
+}
+
+func (o *detached) ApplySigningOption(opts *Options) {
+	opts.Detached = o.flag
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type update struct {
 	flag bool
 }
@@ -380,6 +402,45 @@ func (o *pubkey) ApplySigningOption(opts *Options) {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type keyref struct {
+	name string
+	ref  string
+}
+
+// KeyRef provides an option requesting to use a key held by a configured
+// key store for a dedicated signature name. The reference has the form
+// <store>:<alias>. For signing operations the private key is used, otherwise
+// the public key is taken from the store.
+func KeyRef(name string, ref string) Option {
+	return &keyref{name, ref}
+}
+
+func (o *keyref) ApplySigningOption(opts *Options) {
+	if opts.KeyRefs == nil {
+		opts.KeyRefs = map[string]string{}
+	}
+	opts.KeyRefs[o.name] = o.ref
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type tsaurl struct {
+	url string
+}
+
+// TimestampAuthority provides an option requesting to timestamp created
+// signatures by the RFC 3161 time stamping authority with the given URL.
+// By default, the authority configured for the OCM context is used.
+func TimestampAuthority(url string) Option {
+	return &tsaurl{url}
+}
+
+func (o *tsaurl) ApplySigningOption(opts *Options) {
+	opts.TSAURL = o.url
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type Options struct {
 	Printer           common.Printer
 	Update            bool
@@ -400,6 +461,19 @@ type Options struct {
 	SignatureNames    []string
 	NormalizationAlgo string
 	Keyless           bool
+	// Policies are the verification policies to enforce.
+	// If not set, the policies configured for the context are used.
+	Policies        verificationpolicyattr.Policies
+	EnforcePolicies bool
+	PolicyReport    *PolicyReport
+	// KeyRefs maps signature names to references of keys held by key stores.
+	KeyRefs map[string]string
+	// Detached requests to store created signatures separately
+	// from the component descriptor.
+	Detached bool
+	// TSAURL is the URL of the time stamping authority used to
+	// timestamp created signatures.
+	TSAURL string
 
 	effectiveRegistry signing.Registry
 }
@@ -460,6 +534,29 @@ func (o *Options) ApplySigningOption(opts *Options) {
 	if o.NormalizationAlgo != "" {
 		opts.NormalizationAlgo = o.NormalizationAlgo
 	}
+	if o.Policies != nil {
+		opts.Policies = o.Policies
+	}
+	if o.EnforcePolicies {
+		opts.EnforcePolicies = o.EnforcePolicies
+	}
+	if o.PolicyReport != nil {
+		opts.PolicyReport = o.PolicyReport
+	}
+	if o.Detached {
+		opts.Detached = o.Detached
+	}
+	if o.TSAURL != "" {
+		opts.TSAURL = o.TSAURL
+	}
+	if o.KeyRefs != nil {
+		if opts.KeyRefs == nil {
+			opts.KeyRefs = map[string]string{}
+		}
+		for k, v := range o.KeyRefs {
+			opts.KeyRefs[k] = v
+		}
+	}
 }
 
 // Complete takes either nil, an ocm.ContextProvider or a signing.Registry.
@@ -486,6 +583,16 @@ func (o *Options) Complete(ctx interface{}) error {
 		o.Registry = reg
 	}
 
+	if len(o.KeyRefs) > 0 {
+		p, ok := ctx.(ocm.ContextProvider)
+		if !ok {
+			return errors.Newf("key references require an OCM context")
+		}
+		if err := o.resolveKeyRefs(p.OCMContext()); err != nil {
+			return err
+		}
+	}
+
 	o.effectiveRegistry = o.Registry
 	if o.Keys != nil && o.Keys.HasKeys() {
 		o.effectiveRegistry = signing.RegistryWithPreferredKeys(o.Registry, o.Keys)
@@ -515,6 +622,11 @@ func (o *Options) Complete(ctx interface{}) error {
 		if o.DigestMode == "" {
 			o.DigestMode = DIGESTMODE_LOCAL
 		}
+		if o.TSAURL == "" {
+			if p, ok := ctx.(ocm.ContextProvider); ok {
+				o.TSAURL = tsaattr.Get(p.OCMContext())
+			}
+		}
 	}
 	if !o.Keyless {
 		if o.Signer != nil && !o.VerifySignature {
@@ -530,7 +642,9 @@ func (o *Options) Complete(ctx interface{}) error {
 				if pub == nil {
 					return errors.ErrNotFound(compdesc.KIND_PUBLIC_KEY, n)
 				}
-				if err := o.checkCert(pub, n); err != nil {
+				// the validity period is checked during the verification
+				// as of the timestamped time of the signature.
+				if err := o.checkCertAt(pub, n, time.Time{}); err != nil {
 					return fmt.Errorf("public key not valid: %w", err)
 				}
 			}
@@ -549,15 +663,66 @@ func (o *Options) Complete(ctx interface{}) error {
 	if o.Hasher == nil {
 		o.Hasher = o.Registry.GetHasher(sha256.Algorithm)
 	}
+
+	// verification policies are enforced for all verifications
+	if o.DoSign() || !(o.VerifySignature || o.EnforcePolicies) {
+		o.Policies = nil
+	} else if o.Policies == nil {
+		if p, ok := ctx.(ocm.ContextProvider); ok {
+			o.Policies = verificationpolicyattr.Get(p.OCMContext())
+		}
+	}
+	return nil
+}
+
+// resolveKeyRefs resolves the configured key references with the key stores
+// configured for the context. For signing operations the private key is
+// requested from the store, otherwise the public key.
+func (o *Options) resolveKeyRefs(ctx ocm.Context) error {
+	keys := signing.NewKeyRegistry(o.Keys)
+	for name, r := range o.KeyRefs {
+		ref, err := keyprovider.ParseKeyRef(r)
+		if err != nil {
+			return err
+		}
+		p, err := keystoreattr.GetProvider(ctx, ref.Store)
+		if err != nil {
+			return err
+		}
+		if o.Signer != nil || o.SignAlgo != "" {
+			priv, err := p.GetPrivateKey(ctx.CredentialsContext(), ref.Alias)
+			if err != nil {
+				return errors.Wrapf(err, "key reference %q", r)
+			}
+			keys.RegisterPrivateKey(name, priv)
+		} else {
+			pub, err := p.GetPublicKey(ctx.CredentialsContext(), ref.Alias)
+			if err != nil {
+				return errors.Wrapf(err, "key reference %q", r)
+			}
+			keys.RegisterPublicKey(name, pub)
+		}
+	}
+	o.Keys = keys
 	return nil
 }
 
 func (o *Options) checkCert(data interface{}, name string) error {
+	return o.checkCertAt(data, name, time.Now())
+}
+
+// checkCertAt validates a public key given as certificate as of the
+// given time. For the zero time, only the trust chain is validated.
+// Other keys are accepted as they are.
+func (o *Options) checkCertAt(data interface{}, name string, t time.Time) error {
 	cert, err := signing.GetCertificate(data)
 	if err != nil {
 		return nil
 	}
-	err = signing.VerifyCert(nil, o.RootCerts, "", cert)
+	if t.IsZero() {
+		t = cert.NotBefore
+	}
+	err = signing.VerifyCertAt(nil, o.RootCerts, "", cert, t)
 	if err != nil {
 		return errors.Wrapf(err, "public key %q", name)
 	}
@@ -565,7 +730,7 @@ func (o *Options) checkCert(data interface{}, name string) error {
 }
 
 func (o *Options) DoUpdate() bool {
-	return o.Update || o.DoSign()
+	return o.Update || (o.DoSign() && !o.Detached)
 }
 
 func (o *Options) DoSign() bool {
@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+// PolicyResult describes the result of the evaluation of a verification
+// policy for a component version.
+type PolicyResult struct {
+	ComponentVersion common.NameVersion
+	Policy           string
+	Passed           bool
+	Message          string
+}
+
+func (r PolicyResult) String() string {
+	if r.Passed {
+		return fmt.Sprintf("%s: policy %q passed", r.ComponentVersion, r.Policy)
+	}
+	return fmt.Sprintf("%s: policy %q failed: %s", r.ComponentVersion, r.Policy, r.Message)
+}
+
+// PolicyReport gathers the results of all verification policies
+// evaluated during a signing/verification operation.
+type PolicyReport struct {
+	lock    sync.Mutex
+	results []PolicyResult
+}
+
+func NewPolicyReport() *PolicyReport {
+	return &PolicyReport{}
+}
+
+// Add adds a result. A former result for the same component version
+// and policy is replaced.
+func (r *PolicyReport) Add(res PolicyResult) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for i, e := range r.results {
+		if e.ComponentVersion == res.ComponentVersion && e.Policy == res.Policy {
+			r.results[i] = res
+			return
+		}
+	}
+	r.results = append(r.results, res)
+}
+
+// Results provides the gathered results in the order of evaluation.
+func (r *PolicyReport) Results() []PolicyResult {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]PolicyResult(nil), r.results...)
+}
+
+// Failed reports whether any policy has failed.
+func (r *PolicyReport) Failed() bool {
+	for _, e := range r.Results() {
+		if !e.Passed {
+			return true
+		}
+	}
+	return false
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type policies struct {
+	policies verificationpolicyattr.Policies
+}
+
+// VerificationPolicies provides an option requesting to enforce dedicated
+// verification policies instead of the ones configured for the context.
+func VerificationPolicies(p ...verificationpolicyattr.Policy) Option {
+	return &policies{p}
+}
+
+func (o *policies) ApplySigningOption(opts *Options) {
+	opts.Policies = o.policies
+	opts.EnforcePolicies = true
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type enforcePolicies struct {
+	flag bool
+}
+
+// EnforcePolicies provides an option requesting to enforce the
+// verification policies configured for the context, even if no
+// signature is verified explicitly.
+func EnforcePolicies(flag ...bool) Option {
+	return &enforcePolicies{utils.GetOptionFlag(flag...)}
+}
+
+func (o *enforcePolicies) ApplySigningOption(opts *Options) {
+	opts.EnforcePolicies = o.flag
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type policyReport struct {
+	report *PolicyReport
+}
+
+// WithPolicyReport provides an option requesting to gather the results
+// of the evaluated verification policies in the given report.
+func WithPolicyReport(r *PolicyReport) Option {
+	return &policyReport{r}
+}
+
+func (o *policyReport) ApplySigningOption(opts *Options) {
+	opts.PolicyReport = o.report
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// VerifyPolicies verifies a component version according to the verification
+// policies configured for its context. If no policy is relevant for the
+// component version, nothing is done.
+func VerifyPolicies(cv ocm.ComponentVersionAccess, optlist ...Option) (*PolicyReport, error) {
+	var opts Options
+
+	report := NewPolicyReport()
+	opts.Eval(
+		VerifyDigests(),
+		EnforcePolicies(),
+		Resolver(cv.Repository()),
+		WithPolicyReport(report),
+	)
+	opts.Eval(optlist...)
+
+	if opts.Signer != nil {
+		return nil, errors.Newf("impossible signer option set for verification")
+	}
+	err := opts.Complete(cv.GetContext())
+	if err != nil {
+		return nil, errors.Wrapf(err, "inconsistent options for verification")
+	}
+	if len(opts.Policies.ForComponent(cv.GetName())) == 0 {
+		return opts.PolicyReport, nil
+	}
+	_, err = Apply(nil, nil, cv, &opts)
+	return opts.PolicyReport, err
+}
+
+// CheckPolicies enforces the verification policies of the given completed
+// options for a single component version. In contrast to VerifyPolicies
+// referenced component versions are not evaluated. It is intended for
+// operations walking the component version graph on their own, like a
+// transfer, which call it once per visited component version.
+func CheckPolicies(cv ocm.ComponentVersionAccess, opts *Options) error {
+	if len(opts.Policies.ForComponent(cv.GetName())) == 0 {
+		return nil
+	}
+	cd := cv.GetDescriptor().Copy()
+	if err := addDetachedSignatures(cv, cd); err != nil {
+		return err
+	}
+	return errors.Wrapf(checkPolicies(cv, cd, opts), "%s", common.VersionedElementKey(cv))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func checkPolicies(cv ocm.ComponentVersionAccess, cd *compdesc.ComponentDescriptor, opts *Options) error {
+	nv := common.VersionedElementKey(cv)
+	list := errors.ErrListf("verification policies")
+	for _, p := range opts.Policies.ForComponent(nv.GetName()) {
+		err := checkPolicy(cd, &p, opts)
+		res := PolicyResult{
+			ComponentVersion: nv,
+			Policy:           p.GetName(),
+			Passed:           err == nil,
+		}
+		if err != nil {
+			res.Message = err.Error()
+			list.Add(errors.Wrapf(err, "policy %q", p.GetName()))
+		}
+		opts.PolicyReport.Add(res)
+	}
+	return list.Result()
+}
+
+func checkPolicy(cd *compdesc.ComponentDescriptor, p *verificationpolicyattr.Policy, opts *Options) error {
+	for i := range p.Signatures {
+		req := &p.Signatures[i]
+		maxAge, err := req.GetMaxAge()
+		if err != nil {
+			return err
+		}
+		if maxAge > 0 {
+			if cd.CreationTime == nil {
+				return errors.Newf("creation time required to check maximum age")
+			}
+			if age := time.Since(cd.CreationTime.Time()); age > maxAge {
+				return errors.Newf("component version older than %s", req.MaxAge)
+			}
+		}
+		roots, err := req.GetRootCertificates()
+		if err != nil {
+			return err
+		}
+
+		var reasons []string
+		found := false
+		for j := range cd.Signatures {
+			sig := &cd.Signatures[j]
+			if req.Name != "" && sig.Name != req.Name {
+				continue
+			}
+			err := checkSignature(cd, sig, req, roots, opts)
+			if err == nil {
+				found = true
+				break
+			}
+			reasons = append(reasons, fmt.Sprintf("%s: %s", sig.Name, err))
+		}
+		if !found {
+			if len(reasons) == 0 {
+				if req.Name != "" {
+					return errors.ErrNotFound(compdesc.KIND_SIGNATURE, req.Name)
+				}
+				return errors.Newf("no signature found")
+			}
+			return errors.Newf("no valid signature meets requirement %d (%s)", i, strings.Join(reasons, ", "))
+		}
+	}
+	return nil
+}
+
+func checkSignature(cd *compdesc.ComponentDescriptor, sig *metav1.Signature, req *verificationpolicyattr.SignatureRequirement, roots *x509.CertPool, opts *Options) error {
+	if !req.AcceptsAlgorithm(sig.Signature.Algorithm) {
+		return errors.Newf("algorithm %q not accepted", sig.Signature.Algorithm)
+	}
+	pub := opts.PublicKey(sig.Name)
+	if pub == nil && !opts.Keyless {
+		return errors.ErrNotFound(compdesc.KIND_PUBLIC_KEY, sig.Name)
+	}
+	issuers := []string{sig.Signature.Issuer}
+	cert, certerr := signing.GetCertificate(pub)
+	if certerr == nil {
+		issuers = append(issuers, cert.Subject.String())
+	}
+	if !req.MatchesIssuer(issuers...) {
+		return errors.Newf("issuer %q does not match %q", sig.Signature.Issuer, req.Issuer)
+	}
+	if roots != nil {
+		if certerr != nil {
+			return errors.Newf("certificate required to validate trust roots")
+		}
+		t, err := SignatureTime(sig, roots)
+		if err != nil {
+			return err
+		}
+		if err := signing.VerifyCertAt(nil, roots, "", cert, t); err != nil {
+			return errors.Wrapf(err, "untrusted certificate")
+		}
+	}
+
+	verifier := opts.Registry.GetVerifier(sig.Signature.Algorithm)
+	if verifier == nil {
+		return errors.ErrUnknown(compdesc.KIND_VERIFY_ALGORITHM, sig.Signature.Algorithm)
+	}
+	hasher := opts.Registry.GetHasher(sig.Digest.HashAlgorithm)
+	if hasher == nil {
+		return errors.ErrUnknown(compdesc.KIND_HASH_ALGORITHM, sig.Digest.HashAlgorithm)
+	}
+	digest, err := compdesc.Hash(cd, sig.Digest.NormalisationAlgorithm, hasher.Create())
+	if err != nil {
+		return errors.Wrapf(err, "failed hashing component descriptor")
+	}
+	if sig.Digest.Value != digest {
+		return errors.Newf("signature digest (%s) does not match found digest (%s)", sig.Digest.Value, digest)
+	}
+	err = verifier.Verify(sig.Digest.Value, hasher.Crypto(), sig.ConvertToSigning(), pub)
+	if err != nil {
+		return errors.ErrInvalidWrap(err, compdesc.KIND_SIGNATURE, sig.Signature.Algorithm)
+	}
+	return nil
+}
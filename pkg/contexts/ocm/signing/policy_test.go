@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+)
+
+const (
+	COMPONENT_PROD = "acme.org/prod/a"
+	COMPONENT_DEV  = "acme.org/dev/b"
+	RELEASE_TEAM   = "CN=Release-Team,O=acme"
+)
+
+func policy(req verificationpolicyattr.SignatureRequirement) verificationpolicyattr.Policy {
+	return verificationpolicyattr.Policy{
+		Name:       "production",
+		Components: []string{"acme.org/prod/*"},
+		Signatures: []verificationpolicyattr.SignatureRequirement{req},
+	}
+}
+
+var _ = Describe("verification policies", func() {
+	var env *Builder
+
+	sign := func(comp string, opts ...Option) {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(comp, VERSION))
+		defer Close(cv, "source cv")
+		opts = append([]Option{
+			Sign(signingattr.Get(env.OCMContext()).GetSigner(SIGN_ALGO), SIGNATURE),
+			Resolver(src),
+			Update(), VerifyDigests(),
+		}, opts...)
+		_, err := Apply(nil, nil, cv, NewOptions(opts...))
+		MustBeSuccessful(err)
+	}
+
+	verify := func(comp string, opts ...Option) (*PolicyReport, error) {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(comp, VERSION))
+		defer Close(cv, "source cv")
+
+		report := NewPolicyReport()
+		opts = append([]Option{
+			Resolver(src),
+			VerifyDigests(),
+			EnforcePolicies(),
+			WithPolicyReport(report),
+		}, opts...)
+		_, err := Apply(nil, nil, cv, NewOptions(opts...))
+		return report, err
+	}
+
+	BeforeEach(func() {
+		env = NewBuilder()
+		env.RSAKeyPair(SIGNATURE)
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENT_PROD, VERSION, func() {
+				env.Provider(PROVIDER)
+				env.Resource("text", "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, "production data")
+				})
+			})
+			env.ComponentVersion(COMPONENT_DEV, VERSION, func() {
+				env.Provider(PROVIDER)
+				env.Resource("text", "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, "development data")
+				})
+				env.Reference("prod", COMPONENT_PROD, VERSION)
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("passes configured policy", func() {
+		sign(COMPONENT_PROD, Issuer(RELEASE_TEAM))
+		MustBeSuccessful(env.ConfigContext().ApplyConfig(verificationpolicyattr.New(policy(verificationpolicyattr.SignatureRequirement{
+			Issuer:     "CN=Release-Team",
+			Algorithms: []string{rsa.Algorithm},
+		})), "test"))
+
+		report := Must(verify(COMPONENT_PROD))
+		Expect(report.Results()).To(Equal([]PolicyResult{
+			{ComponentVersion: common.NewNameVersion(COMPONENT_PROD, VERSION), Policy: "production", Passed: true},
+		}))
+	})
+
+	It("checks nested component versions", func() {
+		sign(COMPONENT_PROD, Issuer(RELEASE_TEAM))
+		MustBeSuccessful(verificationpolicyattr.Set(env.OCMContext(), verificationpolicyattr.Policies{
+			policy(verificationpolicyattr.SignatureRequirement{Issuer: "Release-Team"}),
+		}))
+
+		report := Must(verify(COMPONENT_DEV))
+		Expect(report.Results()).To(Equal([]PolicyResult{
+			{ComponentVersion: common.NewNameVersion(COMPONENT_PROD, VERSION), Policy: "production", Passed: true},
+		}))
+	})
+
+	It("fails for unsigned component version", func() {
+		MustBeSuccessful(verificationpolicyattr.Set(env.OCMContext(), verificationpolicyattr.Policies{
+			policy(verificationpolicyattr.SignatureRequirement{}),
+		}))
+
+		report, err := verify(COMPONENT_PROD)
+		Expect(err).To(MatchError(ContainSubstring(`policy "production": no signature found`)))
+		Expect(report.Failed()).To(BeTrue())
+	})
+
+	It("fails for wrong issuer", func() {
+		sign(COMPONENT_PROD, Issuer("CN=Dev-Team"))
+
+		report, err := verify(COMPONENT_PROD, VerificationPolicies(policy(verificationpolicyattr.SignatureRequirement{Issuer: "CN=Release-Team"})))
+		Expect(err).To(MatchError(ContainSubstring(`no valid signature meets requirement 0 (test: issuer "CN=Dev-Team" does not match "CN=Release-Team")`)))
+		Expect(report.Results()[0].String()).To(ContainSubstring(`acme.org/prod/a:v1: policy "production" failed:`))
+	})
+
+	It("fails for wrong algorithm", func() {
+		sign(COMPONENT_PROD)
+
+		_, err := verify(COMPONENT_PROD, VerificationPolicies(policy(verificationpolicyattr.SignatureRequirement{Algorithms: []string{"RSASSA-PSS"}})))
+		Expect(err).To(MatchError(ContainSubstring(`algorithm "RSASSA-PKCS1-V1_5" not accepted`)))
+	})
+
+	It("fails for wrong signature name", func() {
+		sign(COMPONENT_PROD)
+
+		_, err := verify(COMPONENT_PROD, VerificationPolicies(policy(verificationpolicyattr.SignatureRequirement{Name: "release"})))
+		Expect(err).To(MatchError(ContainSubstring(`signature "release" not found`)))
+	})
+
+	It("ignores components without policy", func() {
+		sign(COMPONENT_DEV)
+
+		report := Must(verify(COMPONENT_DEV, VerificationPolicies(verificationpolicyattr.Policy{
+			Components: []string{"acme.org/test/*"},
+		})))
+		Expect(report.Results()).To(BeEmpty())
+	})
+
+	It("validates certificates against trust roots", func() {
+		capriv, capub := Must2(rsa.Handler{}.CreateKeyPair())
+		ca := Must(x509.ParseCertificate(Must(signing.CreateCertificate(pkix.Name{CommonName: "ca-authority"}, nil, 10*time.Hour, capub, nil, capriv, true))))
+		priv, pub := Must2(rsa.Handler{}.CreateKeyPair())
+		cert := Must(x509.ParseCertificate(Must(signing.CreateCertificate(pkix.Name{CommonName: "Release-Team"}, nil, 10*time.Hour, pub, ca, capriv, false))))
+		pool := x509.NewCertPool()
+		pool.AddCert(ca)
+
+		sign(COMPONENT_PROD, PrivateKey(SIGNATURE, priv), PublicKey(SIGNATURE, cert), RootCertificates(pool))
+
+		req := verificationpolicyattr.SignatureRequirement{
+			Issuer:           "CN=Release-Team",
+			RootCertificates: []signingattr.KeySpec{{Parsed: ca}},
+		}
+		report := Must(verify(COMPONENT_PROD, PublicKey(SIGNATURE, cert), RootCertificates(pool), VerificationPolicies(policy(req))))
+		Expect(report.Failed()).To(BeFalse())
+
+		otherpriv, otherpub := Must2(rsa.Handler{}.CreateKeyPair())
+		other := Must(x509.ParseCertificate(Must(signing.CreateCertificate(pkix.Name{CommonName: "other-authority"}, nil, 10*time.Hour, otherpub, nil, otherpriv, true))))
+		req.RootCertificates = []signingattr.KeySpec{{Parsed: other}}
+		_, err := verify(COMPONENT_PROD, PublicKey(SIGNATURE, cert), RootCertificates(pool), VerificationPolicies(policy(req)))
+		Expect(err).To(MatchError(ContainSubstring("untrusted certificate")))
+	})
+
+	It("enforces policies during transfer", func() {
+		sign(COMPONENT_PROD, Issuer("CN=Dev-Team"))
+		MustBeSuccessful(verificationpolicyattr.Set(env.OCMContext(), verificationpolicyattr.Policies{
+			policy(verificationpolicyattr.SignatureRequirement{Issuer: "CN=Release-Team"}),
+		}))
+
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT_PROD, VERSION))
+		defer Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env))
+		defer Close(tgt, "target")
+
+		p, buf := common.NewBufferedPrinter()
+		err := transfer.Transfer(cv, tgt, transfer.WithPrinter(p))
+		Expect(err).To(MatchError(`acme.org/prod/a:v1: verification policies: policy "production": no valid signature meets requirement 0 (test: issuer "CN=Dev-Team" does not match "CN=Release-Team")`))
+		Expect(buf.String()).To(ContainSubstring(`verification policy "production" failed`))
+		Expect(Must(tgt.ComponentLister().GetComponents("", true))).To(BeEmpty())
+	})
+})
+
+var _ = Describe("verification policy options", func() {
+	It("uses policies configured for the context", func() {
+		ctx := ocm.New()
+		p := verificationpolicyattr.Policies{policy(verificationpolicyattr.SignatureRequirement{})}
+		MustBeSuccessful(verificationpolicyattr.Set(ctx, p))
+
+		opts := NewOptions(EnforcePolicies())
+		MustBeSuccessful(opts.Complete(ctx))
+		Expect(opts.Policies).To(Equal(p))
+
+		opts = NewOptions()
+		MustBeSuccessful(opts.Complete(ctx))
+		Expect(opts.Policies).To(BeNil())
+	})
+})
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+)
+
+// Timestamp requests an RFC 3161 timestamp for the value of a signature
+// from the time stamping authority with the given URL and stores it
+// along with the signature.
+func Timestamp(cctx credentials.Context, url string, sig *metav1.Signature) error {
+	ts, err := tsa.Request(cctx, url, []byte(sig.Signature.Value))
+	if err != nil {
+		return errors.Wrapf(err, "cannot timestamp signature %q", sig.Name)
+	}
+	sig.Timestamp = &metav1.TimestampSpec{
+		Value: base64.StdEncoding.EncodeToString(ts.Token),
+		Time:  metav1.NewTimestampPFor(ts.Time),
+	}
+	return nil
+}
+
+// SignatureTime determines the time certificates used for a signature
+// have to be valid at. For timestamped signatures, this is the time
+// attested by the verified timestamp, otherwise the actual time.
+func SignatureTime(sig *metav1.Signature, roots *x509.CertPool) (time.Time, error) {
+	if sig.Timestamp == nil {
+		return time.Now(), nil
+	}
+	token, err := base64.StdEncoding.DecodeString(sig.Timestamp.Value)
+	if err != nil {
+		return time.Time{}, errors.ErrInvalidWrap(err, tsa.KIND_TIMESTAMP, sig.Name)
+	}
+	ts, err := tsa.Verify(token, []byte(sig.Signature.Value), roots)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "timestamp of signature %q", sig.Name)
+	}
+	return ts.Time, nil
+}
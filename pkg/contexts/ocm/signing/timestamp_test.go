@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signing_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/tsa/testtsa"
+)
+
+var _ = Describe("timestamps", func() {
+	var env *Builder
+	var server *testtsa.Server
+	var priv interface{}
+	var cert *x509.Certificate
+	var roots *x509.CertPool
+
+	now := time.Now()
+
+	apply := func(opts ...Option) error {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENTA, VERSION))
+		defer Close(cv, "source cv")
+		opts = append([]Option{Resolver(src), VerifyDigests()}, opts...)
+		_, err := Apply(nil, nil, cv, NewOptions(opts...))
+		return err
+	}
+
+	sign := func(opts ...Option) error {
+		return apply(append([]Option{
+			Sign(signingattr.Get(env.OCMContext()).GetSigner(SIGN_ALGO), SIGNATURE),
+			PrivateKey(SIGNATURE, priv),
+			Update(),
+		}, opts...)...)
+	}
+
+	verify := func() error {
+		return apply(VerifySignature(SIGNATURE), PublicKey(SIGNATURE, cert), RootCertificates(roots))
+	}
+
+	signature := func() *metav1.Signature {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENTA, VERSION))
+		defer Close(cv, "source cv")
+		return cv.GetDescriptor().Signatures[0].Copy()
+	}
+
+	BeforeEach(func() {
+		env = NewBuilder()
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENTA, VERSION, func() {
+				env.Provider(PROVIDER)
+				env.Resource("text", "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, "test data")
+				})
+			})
+		})
+
+		// the signing certificate expired an hour ago
+		from := now.Add(-3 * time.Hour)
+		capriv, capub := Must2(rsa.Handler{}.CreateKeyPair())
+		ca := Must(x509.ParseCertificate(Must(signing.CreateCertificate(pkix.Name{CommonName: "ca-authority"}, &from, 10*time.Hour, capub, nil, capriv, true))))
+		var pub interface{}
+		priv, pub = Must2(rsa.Handler{}.CreateKeyPair())
+		from = now.Add(-2 * time.Hour)
+		cert = Must(x509.ParseCertificate(Must(signing.CreateCertificate(pkix.Name{CommonName: "Release-Team"}, &from, time.Hour, pub, ca, capriv, false))))
+
+		server = Must(testtsa.New(now.Add(-3*time.Hour), 10*time.Hour))
+		server.SetTime(now.Add(-90 * time.Minute))
+
+		roots = x509.NewCertPool()
+		roots.AddCert(ca)
+		roots.AddCert(server.Root)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		env.Cleanup()
+	})
+
+	It("verifies expired certificate as of timestamp", func() {
+		MustBeSuccessful(sign(TimestampAuthority(server.URL)))
+		Expect(server.Requests()).To(Equal(1))
+
+		sig := signature()
+		Expect(sig.Timestamp).NotTo(BeNil())
+		Expect(sig.Timestamp.Time.Time().Unix()).To(Equal(now.Add(-90 * time.Minute).Unix()))
+		MustBeSuccessful(verify())
+	})
+
+	It("rejects expired certificate without timestamp", func() {
+		MustBeSuccessful(sign())
+		Expect(signature().Timestamp).To(BeNil())
+		Expect(verify()).To(MatchError(ContainSubstring("certificate has expired or is not yet valid")))
+	})
+
+	It("rejects timestamp of untrusted authority", func() {
+		MustBeSuccessful(sign(TimestampAuthority(server.URL)))
+
+		roots = x509.NewCertPool()
+		roots.AddCert(cert)
+		Expect(verify()).To(MatchError(ContainSubstring("untrusted time stamping authority")))
+	})
+
+	It("rejects timestamp outside certificate validity", func() {
+		server.SetTime(now.Add(-30 * time.Minute))
+		MustBeSuccessful(sign(TimestampAuthority(server.URL)))
+		Expect(verify()).To(MatchError(ContainSubstring("certificate has expired or is not yet valid")))
+	})
+
+	It("uses configured time stamping authority", func() {
+		server.RequireBasicAuth("user", "pass")
+		cfg := signingattr.New()
+		cfg.SetTimestampAuthority(server.URL, common.Properties{"username": "user", "password": "pass"})
+		MustBeSuccessful(env.ConfigContext().ApplyConfig(cfg, "test"))
+
+		MustBeSuccessful(sign())
+		Expect(server.Requests()).To(Equal(1))
+		Expect(signature().Timestamp).NotTo(BeNil())
+		MustBeSuccessful(verify())
+	})
+})
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"sync"
+
+	"github.com/go-test/deep"
+	"github.com/mandelsoft/logging"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/semverutils"
+)
+
+func isDelta(handler TransferHandler) bool {
+	if h, ok := handler.(transferhandler.DeltaHandler); ok {
+		return h.IsDelta()
+	}
+	return false
+}
+
+type reusableArtifact struct {
+	access  compdesc.AccessSpec
+	version string
+}
+
+// reusableArtifacts provides artifacts already stored in the target
+// for a transported component version, which can be reused
+// for resources with identical digests in delta mode.
+type reusableArtifacts struct {
+	lock      sync.Mutex
+	log       logging.Logger
+	tgt       ocm.ComponentVersionAccess
+	src       ocm.ComponentVersionAccess
+	artifacts map[metav1.DigestSpec]*reusableArtifact
+	loaded    bool
+}
+
+func newReusableArtifacts(log logging.Logger, src, tgt ocm.ComponentVersionAccess, cur *compdesc.ComponentDescriptor) *reusableArtifacts {
+	r := &reusableArtifacts{
+		log:       log,
+		src:       src,
+		tgt:       tgt,
+		artifacts: map[metav1.DigestSpec]*reusableArtifact{},
+	}
+	// local accesses of the current target version can be reused,
+	// because this version is updated in place.
+	r.add(cur, "", false)
+	return r
+}
+
+func (r *reusableArtifacts) add(cd *compdesc.ComponentDescriptor, version string, globalOnly bool) {
+	ctx := r.tgt.GetContext()
+	for _, res := range cd.Resources {
+		if res.Digest == nil || res.Access == nil {
+			continue
+		}
+		key := *res.Digest
+		if r.artifacts[key] != nil {
+			continue
+		}
+		acc, err := ctx.AccessSpecForSpec(res.Access)
+		if err != nil {
+			continue
+		}
+		if acc.IsLocal(ctx) {
+			// local accesses are only valid in the context of
+			// their component version.
+			if globalOnly {
+				continue
+			}
+		} else if r.isSourceAccess(acc) {
+			// accesses still referring to the source location
+			// do not describe artifacts stored in the target.
+			continue
+		}
+		r.artifacts[key] = &reusableArtifact{access: res.Access, version: version}
+	}
+}
+
+func (r *reusableArtifacts) isSourceAccess(acc ocm.AccessSpec) bool {
+	ctx := r.src.GetContext()
+	for _, res := range r.src.GetDescriptor().Resources {
+		sacc, err := ctx.AccessSpecForSpec(res.Access)
+		if err == nil && len(deep.Equal(sacc, acc)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// loadVersions adds the global accesses found in
+// other versions of the target component, latest versions first.
+func (r *reusableArtifacts) loadVersions() {
+	comp, err := r.tgt.Repository().LookupComponent(r.tgt.GetName())
+	if err != nil {
+		r.log.Debug("cannot lookup target component for delta transfer", "error", err)
+		return
+	}
+	defer comp.Close()
+
+	vers, err := comp.ListVersions()
+	if err != nil {
+		r.log.Debug("cannot list target versions for delta transfer", "error", err)
+		return
+	}
+	// non-semver versions are ignored
+	sorted, _ := semverutils.MatchVersionStrings(vers)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		v := sorted[i].Original()
+		if v == r.tgt.GetVersion() {
+			continue
+		}
+		cv, err := comp.LookupVersion(v)
+		if err != nil {
+			r.log.Debug("cannot lookup target version for delta transfer", "version", v, "error", err)
+			continue
+		}
+		r.add(cv.GetDescriptor(), v, true)
+		accessio.Close(cv)
+	}
+}
+
+// Get returns a reusable artifact for the given resource, if present.
+// Other versions of the target component are only evaluated
+// if required.
+func (r *reusableArtifacts) Get(res *compdesc.ResourceMeta) *reusableArtifact {
+	if res.Digest == nil {
+		return nil
+	}
+	key := *res.Digest
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if a := r.artifacts[key]; a != nil || r.loaded {
+		return a
+	}
+	r.loaded = true
+	r.loadVersions()
+	return r.artifacts[key]
+}
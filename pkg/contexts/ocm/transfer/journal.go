@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	ocmcpi "github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+func getJournal(handler TransferHandler) *journal.Journal {
+	if h, ok := handler.(transferhandler.JournalHandler); ok {
+		return h.GetJournal()
+	}
+	return nil
+}
+
+// resumeResource restores a resource of the target version
+// based on the access recorded in the journal. Local blobs are
+// taken from the target repository instead of the original source.
+func resumeResource(t ocm.ComponentVersionAccess, meta *compdesc.ResourceMeta, acc compdesc.AccessSpec, hint string) error {
+	ctx := t.GetContext()
+	spec, err := ctx.AccessSpecForSpec(acc)
+	if err != nil {
+		return err
+	}
+	if !spec.IsLocal(ctx) {
+		return t.SetResource(meta, spec, ocm.ModifyResource(), ocm.SkipVerify())
+	}
+	m, err := ocmcpi.AccessMethodViewForSpec(spec, t)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+	blob, err := ocmcpi.BlobAccessForAccessMethod(m)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+	return t.SetResourceBlob(meta, blob, hint, spec.GlobalAccessSpec(ctx), ocm.SkipVerify())
+}
+
+// recordResources records the transferred resources of a target
+// version in the journal.
+func recordResources(jrnl *journal.Journal, nv common.NameVersion, t ocm.ComponentVersionAccess, srccd *compdesc.ComponentDescriptor, transferred []bool) error {
+	if jrnl == nil {
+		return nil
+	}
+	var entries []journal.ResourceEntry
+	for i, ok := range transferred {
+		if !ok {
+			continue
+		}
+		id := srccd.Resources[i].GetIdentity(srccd.Resources)
+		r, err := t.GetDescriptor().GetResourceByIdentity(id)
+		if err != nil || r.Digest == nil || r.Access == nil {
+			continue
+		}
+		e, err := journal.NewResourceEntry(id, r.Digest, r.Access)
+		if err != nil {
+			return errors.Wrapf(err, "resource %d", i)
+		}
+		entries = append(entries, *e)
+	}
+	return jrnl.AddResources(nv, entries...)
+}
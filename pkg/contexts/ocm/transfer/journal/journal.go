@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package journal provides a persistent record of the work already
+// done by a transfer. It is used to resume an interrupted transfer
+// without repeating already completed steps.
+package journal
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+)
+
+const KIND_JOURNAL = "transfer journal"
+
+// VersionEntry describes the state of a component version.
+type VersionEntry struct {
+	// Completed is set, if the component version including
+	// all its references has completely been transferred.
+	Completed bool            `json:"completed,omitempty"`
+	Resources []ResourceEntry `json:"resources,omitempty"`
+}
+
+// ResourceEntry describes a resource already stored in the target.
+type ResourceEntry struct {
+	Identity metav1.Identity                  `json:"identity"`
+	Digest   *metav1.DigestSpec               `json:"digest"`
+	Access   *runtime.UnstructuredTypedObject `json:"access"`
+}
+
+type journalData struct {
+	// Sources and Target describe the repositories of the transfer
+	// the journal has been created for.
+	Sources  []*runtime.UnstructuredTypedObject `json:"sources,omitempty"`
+	Target   *runtime.UnstructuredTypedObject   `json:"target,omitempty"`
+	Versions map[string]*VersionEntry           `json:"componentVersions"`
+}
+
+// Journal is a file based record of completed component versions
+// and resource blobs of a transfer. Every modification is persisted
+// immediately.
+type Journal struct {
+	lock sync.Mutex
+	fs   vfs.FileSystem
+	path string
+	// resumed is set for journals read from an existing file.
+	resumed bool
+	data    journalData
+}
+
+// Open opens the journal stored at the given path. If the file does
+// not exist, a new empty journal is provided, which is written with
+// the first recorded entry.
+func Open(fs vfs.FileSystem, path string) (*Journal, error) {
+	j := &Journal{
+		fs:   fs,
+		path: path,
+		data: journalData{Versions: map[string]*VersionEntry{}},
+	}
+	data, err := vfs.ReadFile(fs, path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return j, nil
+		}
+		return nil, errors.Wrapf(err, "cannot read %s %q", KIND_JOURNAL, path)
+	}
+	err = json.Unmarshal(data, &j.data)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, KIND_JOURNAL, path)
+	}
+	j.resumed = true
+	if j.data.Versions == nil {
+		j.data.Versions = map[string]*VersionEntry{}
+	}
+	return j, nil
+}
+
+func (j *Journal) Path() string {
+	return j.path
+}
+
+// Bind binds the journal to a source and the target repository of a
+// transfer. The entries are only keyed by component name and version,
+// therefore a journal created for another transfer would be wrongly
+// applied. A journal is rejected, if it has been created for another
+// target repository. A resumed journal is additionally rejected for
+// source repositories not used by the original transfer.
+func (j *Journal) Bind(source, target runtime.TypedObject) error {
+	if j == nil {
+		return nil
+	}
+	src, err := runtime.ToUnstructuredTypedObject(source)
+	if err != nil {
+		return errors.Wrapf(err, "source repository specification")
+	}
+	tgt, err := runtime.ToUnstructuredTypedObject(target)
+	if err != nil {
+		return errors.Wrapf(err, "target repository specification")
+	}
+	src, tgt = normalizeSpec(src), normalizeSpec(tgt)
+
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.data.Target == nil {
+		if len(j.data.Versions) > 0 {
+			return errors.Newf("%s %q does not describe the repositories of its transfer", KIND_JOURNAL, j.path)
+		}
+		j.data.Target = tgt
+	} else if !equalSpec(j.data.Target, tgt) {
+		return errors.Newf("%s %q was created for another target repository (%s)", KIND_JOURNAL, j.path, describeSpec(j.data.Target))
+	}
+	for _, s := range j.data.Sources {
+		if equalSpec(s, src) {
+			return nil
+		}
+	}
+	if j.resumed {
+		return errors.Newf("%s %q was created for other source repositories (%s)", KIND_JOURNAL, j.path, describeSpecs(j.data.Sources))
+	}
+	j.data.Sources = append(j.data.Sources, src)
+	return j.write()
+}
+
+// normalizeSpec removes the access mode used by file based
+// repositories, because it does not describe the repository itself.
+func normalizeSpec(s *runtime.UnstructuredTypedObject) *runtime.UnstructuredTypedObject {
+	if _, ok := s.Object["accessMode"]; !ok {
+		return s
+	}
+	n := &runtime.UnstructuredTypedObject{ObjectType: s.ObjectType, Object: s.Object.FlatCopy()}
+	delete(n.Object, "accessMode")
+	return n
+}
+
+func equalSpec(a, b *runtime.UnstructuredTypedObject) bool {
+	return runtime.UnstructuredTypesEqual(a, b)
+}
+
+func describeSpecs(list []*runtime.UnstructuredTypedObject) string {
+	var descs []string
+	for _, s := range list {
+		descs = append(descs, describeSpec(s))
+	}
+	return strings.Join(descs, ", ")
+}
+
+func describeSpec(s *runtime.UnstructuredTypedObject) string {
+	if s == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return s.GetType()
+	}
+	return string(data)
+}
+
+// IsCompleted checks whether a component version is already completely
+// transferred.
+func (j *Journal) IsCompleted(nv common.NameVersion) bool {
+	if j == nil {
+		return false
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	e := j.data.Versions[nv.String()]
+	return e != nil && e.Completed
+}
+
+// Complete records a completely transferred component version.
+// Resource entries of this version are not required anymore.
+func (j *Journal) Complete(nv common.NameVersion) error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	j.data.Versions[nv.String()] = &VersionEntry{Completed: true}
+	return j.write()
+}
+
+// GetResource provides the access specification of an already
+// transferred resource of a component version with the given identity
+// and digest.
+func (j *Journal) GetResource(nv common.NameVersion, id metav1.Identity, digest *metav1.DigestSpec) compdesc.AccessSpec {
+	if j == nil || digest == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	e := j.data.Versions[nv.String()]
+	if e == nil {
+		return nil
+	}
+	for _, r := range e.Resources {
+		if r.Identity.Equals(id) && r.Digest.Equal(digest) {
+			return compdesc.GenericAccessSpec(r.Access)
+		}
+	}
+	return nil
+}
+
+// AddResources records resources of a component version already stored
+// in the target.
+func (j *Journal) AddResources(nv common.NameVersion, res ...ResourceEntry) error {
+	if j == nil || len(res) == 0 {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	e := j.data.Versions[nv.String()]
+	if e == nil {
+		e = &VersionEntry{}
+		j.data.Versions[nv.String()] = e
+	}
+outer:
+	for _, n := range res {
+		for i, r := range e.Resources {
+			if r.Identity.Equals(n.Identity) {
+				e.Resources[i] = n
+				continue outer
+			}
+		}
+		e.Resources = append(e.Resources, n)
+	}
+	return j.write()
+}
+
+// NewResourceEntry provides a journal entry for a resource.
+func NewResourceEntry(id metav1.Identity, digest *metav1.DigestSpec, acc compdesc.AccessSpec) (*ResourceEntry, error) {
+	if digest == nil {
+		return nil, errors.ErrInvalid("resource digest", "<none>")
+	}
+	u, err := runtime.ToUnstructuredTypedObject(acc)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceEntry{Identity: id.Copy(), Digest: digest.Copy(), Access: u}, nil
+}
+
+// Remove deletes the journal file. It should be called after
+// a successfully completed transfer.
+func (j *Journal) Remove() error {
+	if j == nil {
+		return nil
+	}
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	j.data = journalData{Versions: map[string]*VersionEntry{}}
+	err := j.fs.Remove(j.path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "cannot remove %s %q", KIND_JOURNAL, j.path)
+	}
+	return nil
+}
+
+// write persists the journal. The content is written to a temporary
+// file, first, to keep a consistent journal if the process is
+// interrupted.
+func (j *Journal) write() error {
+	data, err := json.Marshal(&j.data)
+	if err != nil {
+		return err
+	}
+	tmp := j.path + ".tmp"
+	err = vfs.WriteFile(j.fs, tmp, data, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "cannot write %s %q", KIND_JOURNAL, j.path)
+	}
+	err = j.fs.Rename(tmp, j.path)
+	if err != nil {
+		return errors.Wrapf(err, "cannot write %s %q", KIND_JOURNAL, j.path)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/verificationpolicyattr"
+	ocmcpi "github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+// policyOptions provides the signing options used to enforce the
+// verification policies configured for the given context during a transfer.
+// The options are completed once per transfer. If no policy is configured
+// nil is returned.
+func policyOptions(ctx ocmcpi.Context) (*signing.Options, error) {
+	if len(verificationpolicyattr.Get(ctx)) == 0 {
+		return nil, nil
+	}
+	opts := &signing.Options{}
+	opts.Eval(
+		signing.EnforcePolicies(),
+		signing.WithPolicyReport(signing.NewPolicyReport()),
+	)
+	if err := opts.Complete(ctx); err != nil {
+		return nil, errors.Wrapf(err, "inconsistent options for verification policies")
+	}
+	return opts, nil
+}
+
+// verifyPolicies enforces the verification policies for a single
+// component version. Referenced versions are checked by the
+// transfer when they are visited.
+func verifyPolicies(printer common.Printer, opts *signing.Options, src ocmcpi.ComponentVersionAccess) error {
+	if opts == nil || len(opts.Policies.ForComponent(src.GetName())) == 0 {
+		return nil
+	}
+	err := signing.CheckPolicies(src, opts)
+	for _, r := range opts.PolicyReport.Results() {
+		if r.ComponentVersion == common.VersionedElementKey(src) {
+			if r.Passed {
+				printer.Printf("  verification policy %q passed\n", r.Policy)
+			} else {
+				printer.Printf("  verification policy %q failed: %s\n", r.Policy, r.Message)
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+func getConcurrency(handler TransferHandler) int {
+	if h, ok := handler.(transferhandler.ConcurrencyHandler); ok {
+		return h.GetConcurrency()
+	}
+	return 1
+}
+
+// transferState keeps track of the component versions handled by
+// a transfer. It is shared among all (potentially parallel) transfer
+// branches of a transport closure.
+// A component version is transferred only once. Branches requesting a
+// version currently transferred by another branch wait for its
+// completion, so that referenced versions are always complete before the
+// referencing version is stored. If the transfer of such a version fails,
+// the waiting branches get the same error.
+type transferState struct {
+	lock    sync.Mutex
+	closure TransportClosure
+	limiter limiter
+	// policies are the completed options used to enforce
+	// verification policies, if configured.
+	policies *signing.Options
+	// pending keeps the state of all versions transferred by this transfer.
+	pending map[common.NameVersion]*pendingVersion
+	deps    map[common.NameVersion]map[common.NameVersion]struct{}
+}
+
+type pendingVersion struct {
+	done chan struct{}
+	err  error
+}
+
+func newTransferState(closure TransportClosure, concurrency int) *transferState {
+	return &transferState{
+		closure: closure,
+		limiter: newLimiter(concurrency),
+		pending: map[common.NameVersion]*pendingVersion{},
+		deps:    map[common.NameVersion]map[common.NameVersion]struct{}{},
+	}
+}
+
+// Add adds a component version to the given history.
+// If the version still has to be transferred a function is returned,
+// which must be called with the result once the transfer is done.
+// If the version is already handled, nil is returned together with
+// the error of the transfer of this version, if it has been done
+// concurrently.
+func (s *transferState) Add(hist *common.History, nv common.NameVersion) (func(error), error) {
+	if err := hist.Add(ocm.KIND_COMPONENTVERSION, nv); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	if len(*hist) > 1 {
+		parent := (*hist)[len(*hist)-2]
+		deps := s.deps[parent]
+		if deps == nil {
+			deps = map[common.NameVersion]struct{}{}
+			s.deps[parent] = deps
+		}
+		deps[nv] = struct{}{}
+		if p := s.pending[nv]; p != nil {
+			select {
+			case <-p.done:
+				s.lock.Unlock()
+				return nil, p.err
+			default:
+			}
+			// the version is transferred by another branch, wait for it,
+			// but only if this branch is not required by the other one.
+			if s.reaches(nv, parent, map[common.NameVersion]bool{}) {
+				s.lock.Unlock()
+				return nil, errors.ErrRecusion(ocm.KIND_COMPONENTVERSION, nv, *hist)
+			}
+			s.lock.Unlock()
+			<-p.done
+			return nil, p.err
+		}
+	}
+	defer s.lock.Unlock()
+	if !s.closure.Add(nv) {
+		return nil, nil
+	}
+	p := &pendingVersion{done: make(chan struct{})}
+	s.pending[nv] = p
+	return func(err error) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		p.err = err
+		close(p.done)
+	}, nil
+}
+
+func (s *transferState) reaches(from, to common.NameVersion, visited map[common.NameVersion]bool) bool {
+	if from == to {
+		return true
+	}
+	if visited[from] {
+		return false
+	}
+	visited[from] = true
+	for d := range s.deps[from] {
+		if s.reaches(d, to, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// limiter limits the number of additional goroutines used for
+// a transfer. It is shared by all nesting levels, so that the
+// configured concurrency is never exceeded.
+// A nil limiter does not allow any additional goroutine.
+type limiter chan struct{}
+
+func newLimiter(concurrency int) limiter {
+	if concurrency <= 1 {
+		return nil
+	}
+	// the calling goroutine is always used, also.
+	return make(limiter, concurrency-1)
+}
+
+// tryAcquire reserves a goroutine, if possible without waiting.
+func (l limiter) tryAcquire() bool {
+	if l == nil {
+		return false
+	}
+	select {
+	case l <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l limiter) release() {
+	<-l
+}
+
+// forEach calls f for n items. If the limiter provides free goroutines,
+// the items are processed in parallel, otherwise the item is
+// processed by the calling goroutine. Because the limiter is never
+// waited for, nested usage cannot deadlock.
+// With a limiter, the output of every item is buffered and forwarded
+// to the printer in item order, once all items are done.
+// If abort is set, no further items are started after the first error.
+// The result contains the error of every item.
+func forEach(printer common.Printer, lim limiter, n int, abort bool, f func(p common.Printer, i int) error) []error {
+	errs := make([]error, n)
+	if lim == nil || n <= 1 {
+		for i := 0; i < n; i++ {
+			errs[i] = f(printer, i)
+			if abort && errs[i] != nil {
+				break
+			}
+		}
+		return errs
+	}
+
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		failed bool
+	)
+	bufs := make([]*bytes.Buffer, n)
+	for i := 0; i < n; i++ {
+		lock.Lock()
+		stop := abort && failed
+		lock.Unlock()
+		if stop {
+			break
+		}
+		p, buf := common.NewBufferedPrinter()
+		bufs[i] = buf
+		run := func(i int) {
+			err := f(p, i)
+			errs[i] = err
+			if err != nil {
+				lock.Lock()
+				failed = true
+				lock.Unlock()
+			}
+		}
+		if lim.tryAcquire() {
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					lim.release()
+					wg.Done()
+				}()
+				run(i)
+			}(i)
+		} else {
+			run(i)
+		}
+	}
+	wg.Wait()
+	for _, b := range bufs {
+		if b != nil {
+			printer.Printf("%s", b.String())
+		}
+	}
+	return errs
+}
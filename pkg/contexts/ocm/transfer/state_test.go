@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/common"
+)
+
+var _ = Describe("transfer state", func() {
+	a := common.NewNameVersion("acme.org/a", "v1")
+	b := common.NewNameVersion("acme.org/b", "v1")
+	c := common.NewNameVersion("acme.org/c", "v1")
+	d := common.NewNameVersion("acme.org/d", "v1")
+
+	It("propagates error of pending version", func() {
+		state := newTransferState(TransportClosure{}, 1)
+
+		hist := common.History{a}
+		finish, err := state.Add(&hist, d)
+		Expect(err).To(Succeed())
+		Expect(finish).NotTo(BeNil())
+
+		result := make(chan error)
+		go func() {
+			hist := common.History{b}
+			f, err := state.Add(&hist, d)
+			Expect(f).To(BeNil())
+			result <- err
+		}()
+
+		Consistently(result, 100*time.Millisecond).ShouldNot(Receive())
+		finish(fmt.Errorf("failed"))
+		Eventually(result).Should(Receive(MatchError("failed")))
+
+		hist = common.History{c}
+		finish, err = state.Add(&hist, d)
+		Expect(finish).To(BeNil())
+		Expect(err).To(MatchError("failed"))
+	})
+
+	It("limits concurrency over all nesting levels", func() {
+		var (
+			lock    sync.Mutex
+			active  int
+			maximum int
+			count   int
+		)
+		work := func() {
+			lock.Lock()
+			active++
+			count++
+			if active > maximum {
+				maximum = active
+			}
+			lock.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			lock.Lock()
+			active--
+			lock.Unlock()
+		}
+
+		lim := newLimiter(3)
+		errs := forEach(common.NewPrinter(nil), lim, 4, false, func(p common.Printer, i int) error {
+			forEach(p, lim, 4, false, func(p common.Printer, i int) error {
+				work()
+				return nil
+			})
+			return nil
+		})
+		Expect(errs).To(HaveLen(4))
+		Expect(count).To(Equal(16))
+		Expect(maximum).To(BeNumerically("<=", 3))
+		Expect(maximum).To(BeNumerically(">", 1))
+	})
+})
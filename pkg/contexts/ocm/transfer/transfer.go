@@ -15,6 +15,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/none"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	ocmcpi "github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/signing"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/internal"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
 	"github.com/open-component-model/ocm/pkg/errors"
@@ -29,31 +30,50 @@ func TransferVersion(printer common.Printer, closure TransportClosure, src ocmcp
 	if closure == nil {
 		closure = TransportClosure{}
 	}
-	state := WalkingState{Closure: closure}
-	return transferVersion(common.AssurePrinter(printer), Logger(src), state, src, tgt, handler)
+	err := getJournal(handler).Bind(src.Repository().GetSpecification(), tgt.GetSpecification())
+	if err != nil {
+		return err
+	}
+	state := newTransferState(closure, getConcurrency(handler))
+	state.policies, err = policyOptions(src.GetContext())
+	if err != nil {
+		return err
+	}
+	return transferVersion(common.AssurePrinter(printer), Logger(src), state, nil, src, tgt, handler)
 }
 
-func transferVersion(printer common.Printer, log logging.Logger, state WalkingState, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler TransferHandler) error {
+func transferVersion(printer common.Printer, log logging.Logger, state *transferState, hist common.History, src ocmcpi.ComponentVersionAccess, tgt ocmcpi.Repository, handler TransferHandler) (rerr error) {
 	nv := common.VersionedElementKey(src)
-	log = log.WithValues("history", state.History.String(), "version", nv)
-	if ok, err := state.Add(ocm.KIND_COMPONENTVERSION, nv); !ok {
+	log = log.WithValues("history", hist.String(), "version", nv)
+	finish, err := state.Add(&hist, nv)
+	if finish == nil {
 		return err
 	}
+	defer func() { finish(rerr) }()
 	log.Info("transferring version")
 	printer.Printf("transferring version %q...\n", nv)
 	if handler == nil {
-		var err error
 		handler, err = standard.New(standard.Overwrite())
 		if err != nil {
 			return err
 		}
 	}
 
+	jrnl := getJournal(handler)
+	if jrnl.IsCompleted(nv) {
+		printer.Printf("  version %q already transferred according to journal -> skip transport\n", nv)
+		return nil
+	}
+
+	if err := verifyPolicies(printer, state.policies, src); err != nil {
+		return err
+	}
+
 	d := src.GetDescriptor()
 
 	comp, err := tgt.LookupComponent(src.GetName())
 	if err != nil {
-		return errors.Wrapf(err, "%s: lookup target component", state.History)
+		return errors.Wrapf(err, "%s: lookup target component", hist)
 	}
 	defer comp.Close()
 
@@ -141,22 +161,33 @@ func transferVersion(printer common.Printer, log logging.Logger, state WalkingSt
 		}
 	}
 	if err != nil {
-		return errors.Wrapf(err, "%s: creating target version", state.History)
+		return errors.Wrapf(err, "%s: creating target version", hist)
 	}
 
 	subp := printer.AddGap("  ")
 	list := errors.ErrListf("component references for %s", nv)
 	log.Info("  transferring references")
-	for _, r := range d.References {
-		cv, shdlr, err := handler.TransferVersion(src.Repository(), src, &r, tgt)
+	var refs []nestedVersion
+	for i := range d.References {
+		r := &d.References[i]
+		cv, shdlr, err := handler.TransferVersion(src.Repository(), src, r, tgt)
 		if err != nil {
-			return errors.Wrapf(err, "%s: nested component %s[%s:%s]", state.History, r.GetName(), r.ComponentName, r.GetVersion())
+			for _, n := range refs {
+				n.cv.Close()
+			}
+			return errors.Wrapf(err, "%s: nested component %s[%s:%s]", hist, r.GetName(), r.ComponentName, r.GetVersion())
 		}
 		if cv != nil {
-			list.Add(transferVersion(subp, log.WithValues("ref", r.Name), state, cv, tgt, shdlr))
-			cv.Close()
+			refs = append(refs, nestedVersion{cv: cv, handler: shdlr, name: r.Name})
 		}
 	}
+	// independent references may be transferred in parallel, but all
+	// of them are completely transferred before the actual version is added.
+	list.Add(forEach(subp, state.limiter, len(refs), false, func(p common.Printer, i int) error {
+		n := refs[i]
+		defer n.cv.Close()
+		return transferVersion(p, log.WithValues("ref", n.name), state, hist.Copy(), n.cv, tgt, n.handler)
+	})...)
 
 	if doTransport {
 		var n *compdesc.ComponentDescriptor
@@ -185,7 +216,7 @@ func transferVersion(printer common.Printer, log logging.Logger, state WalkingSt
 		// corrupted content in target.
 		// If no copy is done, merge must keep the access methods in target!!!
 		if !doMerge || doCopy {
-			err = copyVersion(printer, log, state.History, src, t, n, handler)
+			err = copyVersion(printer, log, state.limiter, hist, src, t, n, handler)
 			if err != nil {
 				return err
 			}
@@ -197,15 +228,33 @@ func transferVersion(printer common.Printer, log logging.Logger, state WalkingSt
 		log.Info("  adding component version")
 		list.Add(comp.AddVersion(t))
 	}
-	return list.Result()
+	if list.Len() == 0 {
+		// detached signatures may be added after a version has been
+		// transferred, therefore they are always considered.
+		list.Add(errors.Wrapf(signing.TransferDetachedSignatures(src, t), "%s: transferring detached signatures", hist))
+	}
+	if err := list.Result(); err != nil {
+		return err
+	}
+	return jrnl.Complete(nv)
+}
+
+type nestedVersion struct {
+	cv      ocmcpi.ComponentVersionAccess
+	handler TransferHandler
+	name    string
 }
 
 func CopyVersion(printer common.Printer, log logging.Logger, hist common.History, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, handler TransferHandler) (rerr error) {
-	return copyVersion(printer, log, hist, src, t, src.GetDescriptor().Copy(), handler)
+	if handler == nil {
+		handler = standard.NewDefaultHandler(nil)
+	}
+	return copyVersion(printer, log, newLimiter(getConcurrency(handler)), hist, src, t, src.GetDescriptor().Copy(), handler)
 }
 
 // copyVersion (purely internal) expects an already prepared target comp desc for t given as prep.
-func copyVersion(printer common.Printer, log logging.Logger, hist common.History, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, prep *compdesc.ComponentDescriptor, handler TransferHandler) (rerr error) {
+// The limiter is shared with the calling transfer to limit the overall concurrency.
+func copyVersion(printer common.Printer, log logging.Logger, lim limiter, hist common.History, src ocm.ComponentVersionAccess, t ocm.ComponentVersionAccess, prep *compdesc.ComponentDescriptor, handler TransferHandler) (rerr error) {
 	if handler == nil {
 		handler = standard.NewDefaultHandler(nil)
 	}
@@ -213,10 +262,22 @@ func copyVersion(printer common.Printer, log logging.Logger, hist common.History
 	srccd := src.GetDescriptor()
 	cur := *t.GetDescriptor()
 	*t.GetDescriptor() = *prep
+
+	var reusable *reusableArtifacts
+	if isDelta(handler) {
+		reusable = newReusableArtifacts(log, src, t, &cur)
+	}
+	jrnl := getJournal(handler)
+	nv := common.VersionedElementKey(t)
+
 	log.Info("  transferring resources")
-	for i, r := range src.GetResources() {
+	resources := src.GetResources()
+	transferred := make([]bool, len(resources))
+	copyResource := func(printer common.Printer, i int) error {
 		var m ocmcpi.AccessMethodView
 
+		r := resources[i]
+
 		a, err := r.Access()
 		if err == nil {
 			m, err = ocmcpi.AccessMethodViewForSpec(a, src)
@@ -251,8 +312,36 @@ func copyVersion(printer common.Printer, log logging.Logger, hist common.History
 							msgs = []interface{}{"overwrite"}
 						}
 					}
-					notifyArtifactInfo(printer, log, "resource", i, r.Meta(), hint, msgs...)
-					err = handler.HandleTransferResource(r, m, hint, t)
+					resumed := false
+					if jrnl != nil {
+						if acc := jrnl.GetResource(nv, r.Meta().GetIdentity(srccd.Resources), r.Meta().Digest); acc != nil {
+							err = resumeResource(t, r.Meta(), acc, hint)
+							if err == nil {
+								resumed = true
+								notifyArtifactInfo(printer, log, "resource", i, r.Meta(), hint, "resumed")
+							} else {
+								log.Info("cannot resume resource", "resource", r.Meta().Name, "index", i, "error", err)
+							}
+						}
+					}
+					if !resumed {
+						var reused *reusableArtifact
+						if changed && reusable != nil {
+							reused = reusable.Get(r.Meta())
+						}
+						if reused != nil {
+							msgs = []interface{}{"reused"}
+							if reused.version != "" {
+								msgs = []interface{}{"reused from version %s", reused.version}
+							}
+							notifyArtifactInfo(printer, log, "resource", i, r.Meta(), hint, msgs...)
+							err = t.SetResource(r.Meta(), reused.access, ocm.ModifyResource(), ocm.SkipVerify())
+						} else {
+							notifyArtifactInfo(printer, log, "resource", i, r.Meta(), hint, msgs...)
+							err = handler.HandleTransferResource(r, m, hint, t)
+						}
+						transferred[i] = err == nil
+					}
 				} else {
 					if err == nil { // old resource found -> keep current access method
 						t.SetResource(r.Meta(), old.Access, ocm.ModifyResource(), ocm.SkipVerify())
@@ -268,12 +357,23 @@ func copyVersion(printer common.Printer, log logging.Logger, hist common.History
 			}
 			printer.Printf("WARN: %s: transferring resource %d: %s (enforce transport by reference)\n", hist, i, err)
 		}
+		return nil
+	}
+	err := firstError(forEach(printer, lim, len(resources), true, copyResource))
+	// completed resources are recorded even if the transfer failed,
+	// to be able to resume it later on.
+	err = errors.Join(err, recordResources(jrnl, nv, t, srccd, transferred))
+	if err != nil {
+		return err
 	}
 
 	log.Info("  transferring sources")
-	for i, r := range src.GetSources() {
+	sources := src.GetSources()
+	copySource := func(printer common.Printer, i int) error {
 		var m ocmcpi.AccessMethodView
 
+		r := sources[i]
+
 		a, err := r.Access()
 		if err == nil {
 			m, err = ocmcpi.AccessMethodViewForSpec(a, src)
@@ -302,6 +402,16 @@ func copyVersion(printer common.Printer, log logging.Logger, hist common.History
 			}
 			printer.Printf("WARN: %s: transferring source %d: %s (enforce transport by reference)\n", hist, i, err)
 		}
+		return nil
+	}
+	return firstError(forEach(printer, lim, len(sources), true, copySource))
+}
+
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -30,6 +30,8 @@ type Config struct {
 	KeepGlobalAccess            *bool    `json:"keepGlobalAccess,omitempty"`
 	StopOnExisting              *bool    `json:"stopOnExistingVersion,omitempty"`
 	Overwrite                   *bool    `json:"overwrite,omitempty"`
+	Delta                       *bool    `json:"delta,omitempty"`
+	Concurrency                 *int     `json:"concurrency,omitempty"`
 	OmitAccessTypes             []string `json:"omitAccessTypes,omitempty"`
 }
 
@@ -80,6 +82,16 @@ func (c *Config) ApplyTo(ctx cfgcpi.Context, target interface{}) error {
 			opts.SetOverwrite(*c.Overwrite)
 		}
 	}
+	if c.Delta != nil {
+		if opts, ok := target.(standard.DeltaOption); ok {
+			opts.SetDelta(*c.Delta)
+		}
+	}
+	if c.Concurrency != nil {
+		if opts, ok := target.(standard.ConcurrencyOption); ok {
+			opts.SetConcurrency(*c.Concurrency)
+		}
+	}
 	if c.OmitAccessTypes != nil {
 		if opts, ok := target.(standard.OmitAccessTypesOption); ok {
 			opts.SetOmittedAccessTypes(c.OmitAccessTypes...)
@@ -95,6 +107,8 @@ The config type <code>` + ConfigType + `</code> can be used to define transfer s
     type: ` + ConfigType + `
     recursive: true
     overwrite: true
+    delta: false
+    concurrency: 4
     localResourcesByValue: false
     resourcesByValue: true
     sourcesByValue: false
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package standard_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const (
+	COMPONENT_A = "acme.org/a"
+	COMPONENT_B = "acme.org/b"
+	COMPONENT_C = "acme.org/c"
+	COMPONENT_D = "acme.org/d"
+)
+
+var _ = Describe("Concurrent transfer", func() {
+	var env *Builder
+
+	version := func(comp string, refs ...string) func() {
+		return func() {
+			env.Provider(PROVIDER)
+			for i := 0; i < 3; i++ {
+				name := fmt.Sprintf("res%d", i)
+				env.Resource(name, "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, name+" of "+comp)
+				})
+			}
+			for _, r := range refs {
+				env.Reference("ref-"+r[len("acme.org/"):], r, VERSION)
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		env = NewBuilder()
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("keeps ordered output", func() {
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENT_A, VERSION, version(COMPONENT_A, COMPONENT_B, COMPONENT_C))
+			env.ComponentVersion(COMPONENT_B, VERSION, version(COMPONENT_B))
+			env.ComponentVersion(COMPONENT_C, VERSION, version(COMPONENT_C))
+		})
+
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT_A, VERSION))
+		defer Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env))
+		defer Close(tgt, "target")
+
+		p, buf := common.NewBufferedPrinter()
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.Recursive(), standard.Concurrency(4), transfer.WithPrinter(p)))
+		Expect(string(buf.Bytes())).To(StringEqualTrimmedWithContext(`
+transferring version "acme.org/a:v1"...
+  transferring version "acme.org/b:v1"...
+  ...resource 0 res0[plainText]...
+  ...resource 1 res1[plainText]...
+  ...resource 2 res2[plainText]...
+  ...adding component version...
+  transferring version "acme.org/c:v1"...
+  ...resource 0 res0[plainText]...
+  ...resource 1 res1[plainText]...
+  ...resource 2 res2[plainText]...
+  ...adding component version...
+...resource 0 res0[plainText]...
+...resource 1 res1[plainText]...
+...resource 2 res2[plainText]...
+...adding component version...
+`))
+
+		for _, c := range []string{COMPONENT_A, COMPONENT_B, COMPONENT_C} {
+			tcv := Must(tgt.LookupComponentVersion(c, VERSION))
+			for i := 0; i < 3; i++ {
+				r := Must(tcv.GetResourceByIndex(i))
+				Expect(string(Must(Must(r.BlobAccess()).Get()))).To(Equal(fmt.Sprintf("res%d of %s", i, c)))
+			}
+			MustBeSuccessful(tcv.Close())
+		}
+	})
+
+	It("transfers shared references once", func() {
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENT_A, VERSION, version(COMPONENT_A, COMPONENT_B, COMPONENT_C))
+			env.ComponentVersion(COMPONENT_B, VERSION, version(COMPONENT_B, COMPONENT_D))
+			env.ComponentVersion(COMPONENT_C, VERSION, version(COMPONENT_C, COMPONENT_D))
+			env.ComponentVersion(COMPONENT_D, VERSION, version(COMPONENT_D))
+		})
+
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT_A, VERSION))
+		defer Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env))
+		defer Close(tgt, "target")
+
+		p, buf := common.NewBufferedPrinter()
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.Recursive(), standard.Concurrency(4), transfer.WithPrinter(p)))
+		Expect(string(buf.Bytes())).To(ContainSubstring(`transferring version "acme.org/d:v1"`))
+		Expect(string(buf.Bytes())).NotTo(MatchRegexp(`(?s)transferring version "acme.org/d:v1".*transferring version "acme.org/d:v1"`))
+
+		for _, c := range []string{COMPONENT_A, COMPONENT_B, COMPONENT_C, COMPONENT_D} {
+			tcv := Must(tgt.LookupComponentVersion(c, VERSION))
+			Expect(len(tcv.GetDescriptor().Resources)).To(Equal(3))
+			MustBeSuccessful(tcv.Close())
+		}
+	})
+})
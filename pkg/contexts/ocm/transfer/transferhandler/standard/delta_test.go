@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package standard_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/oci/testhelper"
+	. "github.com/open-component-model/ocm/pkg/contexts/ocm/testhelper"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessio/blobaccess"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartifact"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+var _ = Describe("Delta transfer", func() {
+	var env *Builder
+
+	BeforeEach(func() {
+		env = NewBuilder()
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			OCIManifest1(env)
+		})
+
+		FakeOCIRepo(env, OCIPATH, OCIHOST)
+		FakeOCIRepo(env, OCIPATH, "target")
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					TestDataResource(env)
+					env.Resource("artifact", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartifact.New(oci.StandardOCIRef(OCIHOST+".alias", OCINAMESPACE, OCIVERSION)),
+						)
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("reuses resources of the target version", func() {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env))
+		defer Close(tgt, "target")
+
+		p, buf := common.NewBufferedPrinter()
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.ResourcesByValue(), transfer.WithPrinter(p)))
+
+		MustBeSuccessful(cv.SetResourceBlob(compdesc.NewResourceMeta("copy", resourcetypes.PLAIN_TEXT, metav1.LocalRelation), blobaccess.ForString(mime.MIME_TEXT, S_TESTDATA), "", nil))
+
+		buf.Reset()
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.ResourcesByValue(), standard.Overwrite(), standard.Delta(), transfer.WithPrinter(p)))
+		Expect(string(buf.Bytes())).To(StringEqualTrimmedWithContext(`
+transferring version "github.com/mandelsoft/test:v1"...
+warning:   version "github.com/mandelsoft/test:v1" already present, but differs because some artifacts and signature relevant properties have been changed (transport enforced by overwrite option)
+...resource 0 testdata[PlainText] (already present)
+...resource 1 artifact[ociImage](ocm/value:v2.0) (already present)
+...resource 2 copy[plainText] (reused)
+...adding component version...
+`))
+		tcv := Must(tgt.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(tcv, "target cv")
+		tcd := tcv.GetDescriptor()
+		Expect(tcd.Resources[2].Access).To(DeepEqual(tcd.Resources[0].Access))
+
+		r := Must(tcv.GetResourceByIndex(2))
+		Expect(string(Must(Must(r.BlobAccess()).Get()))).To(Equal(S_TESTDATA))
+	})
+
+	It("reuses global accesses of other target versions", func() {
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(cv, "source cv")
+
+		digest := cv.GetDescriptor().Resources[1].Digest
+		Expect(digest).NotTo(BeNil())
+		global := ociartifact.New("target.alias/ocm/value:v2.0")
+		env.OCMCommonTransport(OUT, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version("v0", func() {
+					env.Provider(PROVIDER)
+					env.Resource("artifact", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(global)
+						env.Digest(digest.Value, digest.HashAlgorithm, digest.NormalisationAlgorithm)
+					})
+				})
+			})
+		})
+
+		tgt := Must(ctf.Open(env.OCMContext(), accessobj.ACC_WRITABLE, OUT, 0o700, env))
+		defer Close(tgt, "target")
+
+		p, buf := common.NewBufferedPrinter()
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.ResourcesByValue(), standard.Delta(), transfer.WithPrinter(p)))
+		Expect(string(buf.Bytes())).To(StringEqualTrimmedWithContext(`
+transferring version "github.com/mandelsoft/test:v1"...
+...resource 0 testdata[PlainText]...
+...resource 1 artifact[ociImage](ocm/value:v2.0) (reused from version v0)
+...adding component version...
+`))
+		tcv := Must(tgt.LookupComponentVersion(COMPONENT, VERSION))
+		defer Close(tcv, "target cv")
+		acc := Must(env.OCMContext().AccessSpecForSpec(tcv.GetDescriptor().Resources[1].Access))
+		Expect(acc).To(DeepEqual(global))
+	})
+})
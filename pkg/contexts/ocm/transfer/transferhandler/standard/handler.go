@@ -8,10 +8,13 @@ import (
 	"time"
 
 	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artifactset"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
 	"github.com/open-component-model/ocm/pkg/errors"
 )
@@ -44,6 +47,18 @@ func (h *Handler) OverwriteVersion(src ocm.ComponentVersionAccess, tgt ocm.Compo
 	return h.opts.IsOverwrite(), nil
 }
 
+func (h *Handler) GetJournal() *journal.Journal {
+	return h.opts.GetJournal()
+}
+
+func (h *Handler) GetConcurrency() int {
+	return h.opts.GetConcurrency()
+}
+
+func (h *Handler) IsDelta() bool {
+	return h.opts.IsDelta()
+}
+
 func (h *Handler) TransferVersion(repo ocm.Repository, src ocm.ComponentVersionAccess, meta *compdesc.ComponentReference, tgt ocm.Repository) (ocm.ComponentVersionAccess, transferhandler.TransferHandler, error) {
 	if src == nil || h.opts.IsRecursive() {
 		if h.opts.IsStopOnExistingVersion() && tgt != nil {
@@ -83,11 +98,64 @@ func (h *Handler) HandleTransferResource(r ocm.ResourceAccess, m cpi.AccessMetho
 		return err
 	}
 	defer blob.Close()
+
+	var data cpi.BlobAccess = blob
+	meta := r.Meta()
+	global := h.GlobalAccess(t.GetContext(), m)
+	if platforms := h.opts.GetPlatforms(); len(platforms) > 0 && artdesc.IsOCIMediaType(blob.MimeType()) {
+		filtered, err := artifactset.FilterPlatforms(blob, platforms...)
+		if err != nil {
+			return errors.Wrapf(err, "filtering platforms of resource %s", meta.GetName())
+		}
+		if filtered != nil {
+			defer filtered.Close()
+			data = filtered
+			meta, err = platformFilteredResource(meta, platforms)
+			if err != nil {
+				return err
+			}
+			// the global access describes the unfiltered artifact.
+			global = nil
+		}
+	}
 	return accessio.Retry(h.opts.GetRetries(), time.Second, func() error {
-		return t.SetResourceBlob(r.Meta(), blob, hint, h.GlobalAccess(t.GetContext(), m), ocm.SkipVerify())
+		return t.SetResourceBlob(meta, data, hint, global, ocm.SkipVerify())
 	})
 }
 
+// LABEL_PLATFORMS is the label recording that an OCI index resource has been
+// restricted to dedicated platforms during a transfer. Because the index is
+// rewritten, the digest of the resource changes. The original digest is kept
+// in the label value (see PlatformsLabel).
+const LABEL_PLATFORMS = "transfer.ocm.software/platforms"
+
+// PlatformsLabel is the value of the LABEL_PLATFORMS label.
+type PlatformsLabel struct {
+	Platforms      []string           `json:"platforms"`
+	OriginalDigest *metav1.DigestSpec `json:"originalDigest,omitempty"`
+}
+
+// platformFilteredResource provides the resource meta for a platform filtered
+// resource. The digest is reset to be recalculated for the new content.
+func platformFilteredResource(meta *cpi.ResourceMeta, platforms []artdesc.Platform) (*cpi.ResourceMeta, error) {
+	value := &PlatformsLabel{
+		Platforms:      artdesc.PlatformStrings(platforms...),
+		OriginalDigest: meta.Digest,
+	}
+	meta = meta.Copy()
+	if meta.Digest != nil {
+		meta.Digest = &metav1.DigestSpec{
+			HashAlgorithm:          meta.Digest.HashAlgorithm,
+			NormalisationAlgorithm: meta.Digest.NormalisationAlgorithm,
+		}
+	}
+	err := meta.Labels.Set(LABEL_PLATFORMS, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "setting label %s", LABEL_PLATFORMS)
+	}
+	return meta, nil
+}
+
 func (h *Handler) HandleTransferSource(r ocm.SourceAccess, m cpi.AccessMethodView, hint string, t ocm.ComponentVersionAccess) error {
 	blob, err := cpi.BlobAccessForAccessMethod(m)
 	if err != nil {
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package standard_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/mime"
+)
+
+const JOURNAL = "/tmp/journal"
+
+type failingHandler struct {
+	*standard.Handler
+	comp string
+	name string
+}
+
+func (h *failingHandler) HandleTransferResource(r ocm.ResourceAccess, m cpi.AccessMethodView, hint string, t ocm.ComponentVersionAccess) error {
+	if t.GetName() == h.comp && r.Meta().GetName() == h.name {
+		return fmt.Errorf("simulated failure")
+	}
+	return h.Handler.HandleTransferResource(r, m, hint, t)
+}
+
+var _ = Describe("Transfer journal", func() {
+	var env *Builder
+
+	BeforeEach(func() {
+		env = NewBuilder()
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.ComponentVersion(COMPONENT_A, VERSION, func() {
+				env.Provider(PROVIDER)
+				for i := 0; i < 3; i++ {
+					name := fmt.Sprintf("res%d", i)
+					env.Resource(name, "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+						env.BlobStringData(mime.MIME_TEXT, name)
+					})
+				}
+				env.Reference("ref", COMPONENT_B, VERSION)
+			})
+			env.ComponentVersion(COMPONENT_B, VERSION, func() {
+				env.Provider(PROVIDER)
+				env.Resource("res0", "", resourcetypes.PLAIN_TEXT, metav1.LocalRelation, func() {
+					env.BlobStringData(mime.MIME_TEXT, "res0")
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("resumes an interrupted transfer", func() {
+		MustBeSuccessful(env.MkdirAll("/tmp", 0o700))
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT_A, VERSION))
+		defer Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env))
+		defer Close(tgt, "target")
+
+		j := Must(journal.Open(env, JOURNAL))
+		h := Must(standard.New(standard.Recursive(), standard.Journal(j)))
+		fh := &failingHandler{Handler: h.(*standard.Handler), comp: COMPONENT_A, name: "res1"}
+
+		p, buf := common.NewBufferedPrinter()
+		Expect(transfer.TransferWithHandler(p, cv, tgt, fh)).To(MatchError(ContainSubstring("simulated failure")))
+		Expect(string(buf.Bytes())).To(StringEqualTrimmedWithContext(`
+transferring version "acme.org/a:v1"...
+  transferring version "acme.org/b:v1"...
+  ...resource 0 res0[plainText]...
+  ...adding component version...
+...resource 0 res0[plainText]...
+...resource 1 res1[plainText]...
+`))
+		Expect(vfs.FileExists(env, JOURNAL)).To(BeTrue())
+
+		j = Must(journal.Open(env, JOURNAL))
+		Expect(j.IsCompleted(common.NewNameVersion(COMPONENT_B, VERSION))).To(BeTrue())
+		Expect(j.IsCompleted(common.NewNameVersion(COMPONENT_A, VERSION))).To(BeFalse())
+
+		buf.Reset()
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.Recursive(), standard.Journal(j), transfer.WithPrinter(p)))
+		Expect(string(buf.Bytes())).To(StringEqualTrimmedWithContext(`
+transferring version "acme.org/a:v1"...
+  transferring version "acme.org/b:v1"...
+    version "acme.org/b:v1" already transferred according to journal -> skip transport
+...resource 0 res0[plainText] (resumed)
+...resource 1 res1[plainText]...
+...resource 2 res2[plainText]...
+...adding component version...
+`))
+		Expect(j.IsCompleted(common.NewNameVersion(COMPONENT_A, VERSION))).To(BeTrue())
+
+		tcv := Must(tgt.LookupComponentVersion(COMPONENT_A, VERSION))
+		defer Close(tcv, "target cv")
+		for i := 0; i < 3; i++ {
+			r := Must(tcv.GetResourceByIndex(i))
+			Expect(string(Must(Must(r.BlobAccess()).Get()))).To(Equal(fmt.Sprintf("res%d", i)))
+		}
+
+		MustBeSuccessful(j.Remove())
+		Expect(vfs.FileExists(env, JOURNAL)).To(BeFalse())
+	})
+
+	It("rejects journal of another transfer", func() {
+		MustBeSuccessful(env.MkdirAll("/tmp", 0o700))
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		defer Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT_A, VERSION))
+		defer Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0o700, accessio.FormatDirectory, env))
+		defer Close(tgt, "target")
+
+		j := Must(journal.Open(env, JOURNAL))
+		h := Must(standard.New(standard.Recursive(), standard.Journal(j)))
+		fh := &failingHandler{Handler: h.(*standard.Handler), comp: COMPONENT_A, name: "res1"}
+		Expect(transfer.TransferWithHandler(nil, cv, tgt, fh)).To(MatchError(ContainSubstring("simulated failure")))
+
+		other := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT+"2", 0o700, accessio.FormatDirectory, env))
+		defer Close(other, "other target")
+
+		j = Must(journal.Open(env, JOURNAL))
+		Expect(transfer.Transfer(cv, other, standard.Recursive(), standard.Journal(j))).To(MatchError(ContainSubstring("was created for another target repository")))
+
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.Recursive(), standard.Journal(j)))
+	})
+})
@@ -7,7 +7,9 @@ package standard
 import (
 	"golang.org/x/exp/slices"
 
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler"
 	"github.com/open-component-model/ocm/pkg/errors"
 	"github.com/open-component-model/ocm/pkg/generics"
@@ -21,6 +23,8 @@ func init() {
 
 type Options struct {
 	retries           *int
+	concurrency       *int
+	journal           *journal.Journal
 	recursive         *bool
 	resourcesByValue  *bool
 	localByValue      *bool
@@ -29,25 +33,31 @@ type Options struct {
 	stopOnExisting    *bool
 	overwrite         *bool
 	skipUpdate        *bool
+	delta             *bool
 	omitAccessTypes   utils.StringSet
 	omitArtifactTypes utils.StringSet
 	resolver          ocm.ComponentVersionResolver
+	platforms         []artdesc.Platform
 }
 
 var (
 	_ transferhandler.TransferOption = (*Options)(nil)
 
 	_ RetryOption                 = (*Options)(nil)
+	_ ConcurrencyOption           = (*Options)(nil)
+	_ JournalOption               = (*Options)(nil)
 	_ ResourcesByValueOption      = (*Options)(nil)
 	_ LocalResourcesByValueOption = (*Options)(nil)
 	_ OverwriteOption             = (*Options)(nil)
 	_ SkipUpdateOption            = (*Options)(nil)
+	_ DeltaOption                 = (*Options)(nil)
 	_ SourcesByValueOption        = (*Options)(nil)
 	_ RecursiveOption             = (*Options)(nil)
 	_ ResolverOption              = (*Options)(nil)
 	_ KeepGlobalAccessOption      = (*Options)(nil)
 	_ OmitAccessTypesOption       = (*Options)(nil)
 	_ OmitArtifactTypesOption     = (*Options)(nil)
+	_ PlatformsOption             = (*Options)(nil)
 )
 
 type TransferOptionsCreator = transferhandler.SpecializedOptionsCreator[*Options, Options]
@@ -66,6 +76,16 @@ func (o *Options) ApplyTransferOption(target transferhandler.TransferOptions) er
 			opts.SetRetries(*o.retries)
 		}
 	}
+	if o.concurrency != nil {
+		if opts, ok := target.(ConcurrencyOption); ok {
+			opts.SetConcurrency(*o.concurrency)
+		}
+	}
+	if o.journal != nil {
+		if opts, ok := target.(JournalOption); ok {
+			opts.SetJournal(o.journal)
+		}
+	}
 	if o.recursive != nil {
 		if opts, ok := target.(RecursiveOption); ok {
 			opts.SetRecursive(*o.recursive)
@@ -106,6 +126,11 @@ func (o *Options) ApplyTransferOption(target transferhandler.TransferOptions) er
 			opts.SetOverwrite(*o.overwrite)
 		}
 	}
+	if o.delta != nil {
+		if opts, ok := target.(DeltaOption); ok {
+			opts.SetDelta(*o.delta)
+		}
+	}
 	if o.omitAccessTypes != nil {
 		if opts, ok := target.(OmitAccessTypesOption); ok {
 			opts.SetOmittedAccessTypes(utils.StringMapKeys(o.omitAccessTypes)...)
@@ -121,6 +146,11 @@ func (o *Options) ApplyTransferOption(target transferhandler.TransferOptions) er
 			opts.SetResolver(o.resolver)
 		}
 	}
+	if o.platforms != nil {
+		if opts, ok := target.(PlatformsOption); ok {
+			opts.SetPlatforms(o.platforms...)
+		}
+	}
 	return nil
 }
 
@@ -144,6 +174,14 @@ func (o *Options) IsSkipUpdate() bool {
 	return utils.AsBool(o.skipUpdate)
 }
 
+func (o *Options) SetDelta(delta bool) {
+	o.delta = &delta
+}
+
+func (o *Options) IsDelta() bool {
+	return utils.AsBool(o.delta)
+}
+
 func (o *Options) SetRecursive(recursive bool) {
 	o.recursive = &recursive
 }
@@ -195,6 +233,25 @@ func (o *Options) GetRetries() int {
 	return *o.retries
 }
 
+func (o *Options) SetConcurrency(n int) {
+	o.concurrency = &n
+}
+
+func (o *Options) GetConcurrency() int {
+	if o.concurrency == nil {
+		return 1
+	}
+	return *o.concurrency
+}
+
+func (o *Options) SetJournal(j *journal.Journal) {
+	o.journal = j
+}
+
+func (o *Options) GetJournal() *journal.Journal {
+	return o.journal
+}
+
 func (o *Options) SetResolver(resolver ocm.ComponentVersionResolver) {
 	o.resolver = resolver
 }
@@ -278,6 +335,14 @@ func (o *Options) IsArtifactTypeOmitted(t string) bool {
 	return o.omitArtifactTypes.Contains(t)
 }
 
+func (o *Options) SetPlatforms(list ...artdesc.Platform) {
+	o.platforms = slices.Clone(list)
+}
+
+func (o *Options) GetPlatforms() []artdesc.Platform {
+	return o.platforms
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 
 type OverwriteOption interface {
@@ -331,6 +396,35 @@ func SkipUpdate(args ...bool) transferhandler.TransferOption {
 
 ///////////////////////////////////////////////////////////////////////////////
 
+type DeltaOption interface {
+	SetDelta(bool)
+	IsDelta() bool
+}
+
+type deltaOption struct {
+	TransferOptionsCreator
+	flag bool
+}
+
+func (o *deltaOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(DeltaOption); ok {
+		eff.SetDelta(o.flag)
+		return nil
+	} else {
+		return errors.ErrNotSupported(transferhandler.KIND_TRANSFEROPTION, "delta")
+	}
+}
+
+// Delta enables the delta transfer mode. Resources to be transported by value,
+// whose digest matches an artifact already stored in the target for the
+// component, are not transferred again. Instead, the access of the stored artifact
+// is reused.
+func Delta(args ...bool) transferhandler.TransferOption {
+	return &deltaOption{flag: utils.GetOptionFlag(args...)}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
 type RetryOption interface {
 	SetRetries(n int)
 	GetRetries() int
@@ -357,6 +451,63 @@ func Retries(retries int) transferhandler.TransferOption {
 
 ///////////////////////////////////////////////////////////////////////////////
 
+type ConcurrencyOption interface {
+	SetConcurrency(n int)
+	GetConcurrency() int
+}
+
+type concurrencyOption struct {
+	TransferOptionsCreator
+	concurrency int
+}
+
+func (o *concurrencyOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(ConcurrencyOption); ok {
+		eff.SetConcurrency(o.concurrency)
+		return nil
+	} else {
+		return errors.ErrNotSupported(transferhandler.KIND_TRANSFEROPTION, "concurrency")
+	}
+}
+
+// Concurrency sets the number of resources, sources and referenced
+// component versions transferred in parallel. Referenced component
+// versions are always completely transferred before the referencing
+// component version is stored.
+func Concurrency(n int) transferhandler.TransferOption {
+	return &concurrencyOption{concurrency: n}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
+type JournalOption interface {
+	SetJournal(j *journal.Journal)
+	GetJournal() *journal.Journal
+}
+
+type journalOption struct {
+	TransferOptionsCreator
+	journal *journal.Journal
+}
+
+func (o *journalOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(JournalOption); ok {
+		eff.SetJournal(o.journal)
+		return nil
+	} else {
+		return errors.ErrNotSupported(transferhandler.KIND_TRANSFEROPTION, "journal")
+	}
+}
+
+// Journal sets a journal used to record completed component versions
+// and resources. Work already recorded in the journal is skipped, which
+// can be used to resume an interrupted transfer.
+func Journal(j *journal.Journal) transferhandler.TransferOption {
+	return &journalOption{journal: j}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+
 type RecursiveOption interface {
 	SetRecursive(bool)
 	IsRecursive() bool
@@ -617,3 +768,32 @@ func AddOmittedArtifactTypes(list ...string) transferhandler.TransferOption {
 		list: slices.Clone(list),
 	}
 }
+
+///////////////////////////////////////////////////////////////////////////////
+
+type PlatformsOption interface {
+	SetPlatforms(...artdesc.Platform)
+	GetPlatforms() []artdesc.Platform
+}
+
+type platformsOption struct {
+	TransferOptionsCreator
+	list []artdesc.Platform
+}
+
+func (o *platformsOption) ApplyTransferOption(to transferhandler.TransferOptions) error {
+	if eff, ok := to.(PlatformsOption); ok {
+		eff.SetPlatforms(o.list...)
+		return nil
+	} else {
+		return errors.ErrNotSupported(transferhandler.KIND_TRANSFEROPTION, "platforms")
+	}
+}
+
+// Platforms restricts OCI index artifacts transported by value to
+// the manifests for the specified platforms.
+func Platforms(list ...artdesc.Platform) transferhandler.TransferOption {
+	return &platformsOption{
+		list: slices.Clone(list),
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package standard_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/contexts/oci/testhelper"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/oci"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/repositories/artifactset"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/accessmethods/ociartifact"
+	metav1 "github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc/meta/v1"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/resourcetypes"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/transferhandler/standard"
+	"github.com/open-component-model/ocm/pkg/finalizer"
+)
+
+const OCIMULTI = "ocm/multi"
+
+var _ = Describe("platform filter", func() {
+	var env *Builder
+	var arm64 *artdesc.Descriptor
+	var idesc *artdesc.Descriptor
+
+	BeforeEach(func() {
+		env = NewBuilder()
+
+		env.OCICommonTransport(OCIPATH, accessio.FormatDirectory, func() {
+			amd64, _ := OCIManifest1For(env, OCIMULTI, "")
+			arm64, _ = OCIManifest2For(env, OCIMULTI, "")
+			env.Namespace(OCIMULTI, func() {
+				idesc = env.Index(OCIVERSION, func() {
+					env.Artifact(withPlatform(amd64, "linux", "amd64"))
+					env.Artifact(withPlatform(arm64, "linux", "arm64"))
+				})
+			})
+		})
+
+		FakeOCIRepo(env, OCIPATH, OCIHOST)
+
+		env.OCMCommonTransport(ARCH, accessio.FormatDirectory, func() {
+			env.Component(COMPONENT, func() {
+				env.Version(VERSION, func() {
+					env.Provider(PROVIDER)
+					env.Resource("artifact", "", resourcetypes.OCI_IMAGE, metav1.LocalRelation, func() {
+						env.Access(
+							ociartifact.New(oci.StandardOCIRef(OCIHOST+".alias", OCIMULTI, OCIVERSION)),
+						)
+					})
+				})
+			})
+		})
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("rewrites index for platforms", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		finalize.Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT, VERSION))
+		finalize.Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+		finalize.Close(tgt, "target")
+
+		platforms := Must(artdesc.ParsePlatforms("linux/arm64"))
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.ResourcesByValue(), standard.Platforms(platforms...)))
+
+		tcv := Must(tgt.LookupComponentVersion(COMPONENT, VERSION))
+		finalize.Close(tcv, "target cv")
+
+		res := tcv.GetDescriptor().Resources[0]
+		label := res.Labels.GetDef(standard.LABEL_PLATFORMS)
+		Expect(label).NotTo(BeNil())
+		var value standard.PlatformsLabel
+		MustBeSuccessful(label.GetValue(&value))
+		Expect(value.Platforms).To(Equal([]string{"linux/arm64"}))
+		Expect(value.OriginalDigest).To(Equal(cv.GetDescriptor().Resources[0].Digest))
+		Expect(res.Digest).NotTo(BeNil())
+		Expect(res.Digest).NotTo(Equal(value.OriginalDigest))
+
+		r := Must(tcv.GetResourceByIndex(0))
+		meth := Must(r.AccessMethod())
+		finalize.Close(meth, "method")
+		reader := Must(meth.Reader())
+		finalize.Close(reader, "reader")
+		set := Must(artifactset.Open(accessobj.ACC_READONLY, "", 0, accessio.Reader(reader)))
+		finalize.Close(set, "set")
+
+		Expect(set.GetMain()).NotTo(Equal(idesc.Digest))
+		art := Must(set.GetArtifact(set.GetMain().String()))
+		finalize.Close(art, "artifact")
+		Expect(art.IsIndex()).To(BeTrue())
+		manifests := art.IndexAccess().GetDescriptor().Manifests
+		Expect(len(manifests)).To(Equal(1))
+		Expect(manifests[0].Digest).To(Equal(arm64.Digest))
+	})
+
+	It("keeps unfiltered index", func() {
+		var finalize finalizer.Finalizer
+		defer Defer(finalize.Finalize)
+
+		src := Must(ctf.Open(env.OCMContext(), accessobj.ACC_READONLY, ARCH, 0, env))
+		finalize.Close(src, "source")
+		cv := Must(src.LookupComponentVersion(COMPONENT, VERSION))
+		finalize.Close(cv, "source cv")
+		tgt := Must(ctf.Create(env.OCMContext(), accessobj.ACC_WRITABLE|accessobj.ACC_CREATE, OUT, 0700, accessio.FormatDirectory, env))
+		finalize.Close(tgt, "target")
+
+		platforms := Must(artdesc.ParsePlatforms("linux/arm64", "linux/amd64"))
+		MustBeSuccessful(transfer.Transfer(cv, tgt, standard.ResourcesByValue(), standard.Platforms(platforms...)))
+
+		tcv := Must(tgt.LookupComponentVersion(COMPONENT, VERSION))
+		finalize.Close(tcv, "target cv")
+
+		res := tcv.GetDescriptor().Resources[0]
+		Expect(res.Labels.GetDef(standard.LABEL_PLATFORMS)).To(BeNil())
+		Expect(res.Digest).To(Equal(cv.GetDescriptor().Resources[0].Digest))
+	})
+})
+
+func withPlatform(d *artdesc.Descriptor, os, arch string) *artdesc.Descriptor {
+	r := *d
+	r.Platform = &artdesc.Platform{OS: os, Architecture: arch}
+	return &r
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/compdesc"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/transfer/journal"
 	"github.com/open-component-model/ocm/pkg/errors"
 )
 
@@ -104,6 +105,27 @@ type TransferHandler interface {
 	HandleTransferSource(r ocm.SourceAccess, m cpi.AccessMethodView, hint string, t ocm.ComponentVersionAccess) error
 }
 
+// DeltaHandler is an optional interface for a TransferHandler.
+// If it reports the delta mode, resources to be transported by value are
+// not transferred again, if an artifact with the same digest is
+// already stored in the target for the transported component.
+type DeltaHandler interface {
+	IsDelta() bool
+}
+
+// ConcurrencyHandler is an optional interface for a TransferHandler.
+// It provides the number of artifacts and component versions,
+// which may be transferred in parallel.
+type ConcurrencyHandler interface {
+	GetConcurrency() int
+}
+
+// JournalHandler is an optional interface for a TransferHandler.
+// It provides a journal used to record and skip already completed work.
+type JournalHandler interface {
+	GetJournal() *journal.Journal
+}
+
 func ApplyOptions(set TransferOptions, opts ...TransferOption) error {
 	list := errors.ErrListf("transfer options")
 	for _, o := range opts {
@@ -28,6 +28,26 @@ const DEFAULT_OCM_CONFIG = ".ocmconfig"
 
 const DEFAULT_OCM_CONFIG_DIR = ".ocm"
 
+// DefaultConfigFile provides the path of the default ocm config file
+// in the home directory. The empty string is returned, if there is none.
+func DefaultConfigFile(fss ...vfs.FileSystem) string {
+	fs := utils.FileSystem(fss...)
+	h := os.Getenv("HOME")
+	if h == "" {
+		return ""
+	}
+	for _, cfg := range []string{
+		h + "/" + DEFAULT_OCM_CONFIG,
+		h + "/" + DEFAULT_OCM_CONFIG_DIR + "/ocmconfig",
+		h + "/" + DEFAULT_OCM_CONFIG_DIR + "/config",
+	} {
+		if ok, err := vfs.FileExists(fs, cfg); ok && err == nil {
+			return cfg
+		}
+	}
+	return ""
+}
+
 func Configure(ctx ocm.Context, path string, fss ...vfs.FileSystem) (ocm.Context, error) {
 	fs := utils.FileSystem(fss...)
 	if ctx == nil {
@@ -35,22 +55,7 @@ func Configure(ctx ocm.Context, path string, fss ...vfs.FileSystem) (ocm.Context
 	}
 	h := os.Getenv("HOME")
 	if path == "" {
-		if h != "" {
-			cfg := h + "/" + DEFAULT_OCM_CONFIG
-			if ok, err := vfs.FileExists(fs, cfg); ok && err == nil {
-				path = cfg
-			} else {
-				cfg := h + "/" + DEFAULT_OCM_CONFIG_DIR + "/ocmconfig"
-				if ok, err := vfs.FileExists(fs, cfg); ok && err == nil {
-					path = cfg
-				} else {
-					cfg := h + "/" + DEFAULT_OCM_CONFIG_DIR + "/config"
-					if ok, err := vfs.FileExists(fs, cfg); ok && err == nil {
-						path = cfg
-					}
-				}
-			}
-		}
+		path = DefaultConfigFile(fs)
 	}
 	if path != "" && path != "None" {
 		if strings.HasPrefix(path, "~"+string(os.PathSeparator)) {
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+
+	"github.com/open-component-model/ocm/pkg/docker/resolve"
+)
+
+type dockerDeleter struct {
+	dockerBase *dockerBase
+}
+
+func (r *dockerResolver) Deleter(ctx context.Context, ref string) (resolve.Deleter, error) {
+	base, err := r.resolveDockerBase(ref)
+	if err != nil {
+		return nil, err
+	}
+	if base.refspec.Object != "" {
+		return nil, ErrObjectNotRequired
+	}
+
+	return &dockerDeleter{
+		dockerBase: base,
+	}, nil
+}
+
+func (r *dockerDeleter) Delete(ctx context.Context, dgst digest.Digest) error {
+	return r.delete(ctx, dgst.String())
+}
+
+func (r *dockerDeleter) Untag(ctx context.Context, tag string) error {
+	if tag == "" || strings.Contains(tag, ":") {
+		return errors.Wrapf(errdefs.ErrInvalidArgument, "invalid tag %q", tag)
+	}
+	return r.delete(ctx, tag)
+}
+
+func (r *dockerDeleter) delete(ctx context.Context, ref string) error {
+	refspec := r.dockerBase.refspec
+	base := r.dockerBase
+
+	hosts := base.filterHosts(HostCapabilityPush)
+	if len(hosts) == 0 {
+		return errors.Wrap(errdefs.ErrNotFound, "no delete hosts")
+	}
+
+	ctx, err := ContextWithRepositoryScope(ctx, refspec, true)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, host := range hosts {
+		ctxWithLogger := log.WithLogger(ctx, log.G(ctx).WithField("host", host.Host))
+
+		req := base.request(host, http.MethodDelete, "manifests", ref)
+		if err := req.addNamespace(base.refspec.Hostname()); err != nil {
+			return err
+		}
+
+		log.G(ctxWithLogger).Debug("deleting")
+		resp, err := req.doWithRetries(ctxWithLogger, nil)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			log.G(ctxWithLogger).WithError(err).Info("trying next host")
+			continue // try another host
+		}
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			log.G(ctxWithLogger).Info("trying next host - response was http.StatusNotFound")
+			continue
+		case resp.StatusCode == http.StatusMethodNotAllowed:
+			return errors.Wrapf(errdefs.ErrNotImplemented, "deletion not supported by host %s", host.Host)
+		case resp.StatusCode == http.StatusBadRequest && !isDigest(ref):
+			// registries not supporting tag deletion respond with bad request
+			return errors.Wrapf(errdefs.ErrNotImplemented, "tag deletion not supported by host %s", host.Host)
+		case resp.StatusCode > 299:
+			if firstErr == nil {
+				firstErr = errors.Errorf("deleting %s from host %s failed with status code %v", ref, host.Host, resp.Status)
+			}
+			continue // try another host
+		}
+		return nil
+	}
+
+	if firstErr == nil {
+		if isDigest(ref) {
+			firstErr = errors.Wrapf(errdefs.ErrNotFound, "%s@%s", base.refspec.Locator, ref)
+		} else {
+			firstErr = errors.Wrapf(errdefs.ErrNotFound, "%s:%s", base.refspec.Locator, ref)
+		}
+	}
+	return firstErr
+}
+
+func isDigest(ref string) bool {
+	_, err := digest.Parse(ref)
+	return err == nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/open-component-model/ocm/pkg/docker"
+)
+
+var _ = Describe("deleter", func() {
+	var server *httptest.Server
+	var status int
+	var requests []string
+	var ref string
+
+	dgst := digest.FromString("manifest")
+
+	BeforeEach(func() {
+		status = http.StatusAccepted
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(status)
+		}))
+		ref = strings.TrimPrefix(server.URL, "http://") + "/test/repo"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	resolver := func() docker.ResolverOptions {
+		return docker.ResolverOptions{
+			Hosts: docker.ConfigureDefaultRegistries(docker.WithPlainHTTP(docker.MatchAllHosts)),
+		}
+	}
+
+	It("deletes manifest", func() {
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		MustBeSuccessful(d.Delete(context.Background(), dgst))
+		Expect(requests).To(Equal([]string{"DELETE /v2/test/repo/manifests/" + dgst.String()}))
+	})
+
+	It("reports unknown manifest", func() {
+		status = http.StatusNotFound
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		err := d.Delete(context.Background(), dgst)
+		Expect(errdefs.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("reports unsupported deletion", func() {
+		status = http.StatusMethodNotAllowed
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		err := d.Delete(context.Background(), dgst)
+		Expect(errdefs.IsNotImplemented(err)).To(BeTrue())
+	})
+
+	It("reports failed deletion", func() {
+		status = http.StatusForbidden
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		Expect(d.Delete(context.Background(), dgst)).To(MatchError(ContainSubstring("failed with status code 403")))
+	})
+
+	It("deletes tag", func() {
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		MustBeSuccessful(d.Untag(context.Background(), "v1"))
+		Expect(requests).To(Equal([]string{"DELETE /v2/test/repo/manifests/v1"}))
+	})
+
+	It("reports unsupported tag deletion", func() {
+		status = http.StatusBadRequest
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		err := d.Untag(context.Background(), "v1")
+		Expect(errdefs.IsNotImplemented(err)).To(BeTrue())
+	})
+
+	It("rejects invalid tag", func() {
+		d := Must(docker.NewResolver(resolver()).Deleter(context.Background(), ref))
+		err := d.Untag(context.Background(), "")
+		Expect(errdefs.IsInvalidArgument(err)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("rejects object reference", func() {
+		_, err := docker.NewResolver(resolver()).Deleter(context.Background(), ref+":v1")
+		Expect(err).To(MatchError(docker.ErrObjectNotRequired))
+	})
+})
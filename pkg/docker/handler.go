@@ -97,6 +97,18 @@ func distributionSourceLabelKey(source string) string {
 	return fmt.Sprintf("%s.%s", labelDistributionSource, source)
 }
 
+// DistributionSourceAnnotation provides the descriptor annotation used to
+// request a cross-repository mount of a blob from the repository given by
+// its locator (<host>[:<port>]/<repository>) instead of uploading it.
+// The source repository must be hosted by the same registry as the push target.
+func DistributionSourceAnnotation(locator string) (string, string, error) {
+	u, err := url.Parse("dummy://" + locator)
+	if err != nil {
+		return "", "", err
+	}
+	return distributionSourceLabelKey(u.Hostname()), strings.TrimPrefix(u.Path, "/"), nil
+}
+
 // selectRepositoryMountCandidate will select the repo which has longest
 // common prefix components as the candidate.
 func selectRepositoryMountCandidate(refspec reference.Spec, sources map[string]string) string {
@@ -159,16 +159,19 @@ func (p dockerPusher) push(ctx context.Context, desc ocispec.Descriptor, src res
 			// NOTE: the fromRepo might be private repo and
 			// auth service still can grant token without error.
 			// but the post request will fail because of 401.
+			// Other registries refuse a mount with other status
+			// codes (for example 403 or 404).
 			//
-			// for the private repo, we should remove mount-from
+			// in such cases, we should remove mount-from
 			// query and send the request again.
+			// A 202 response already starts a regular upload.
 			resp, err = preq.doWithRetries(pctx, nil)
 			if err != nil {
 				return nil, accessio.RetriableError(err)
 			}
 
-			if resp.StatusCode == http.StatusUnauthorized {
-				log.G(ctx).Debugf("failed to mount from repository %s", fromRepo)
+			if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+				log.G(ctx).WithField("status", resp.Status).Debugf("failed to mount from repository %s", fromRepo)
 
 				resp.Body.Close()
 				resp = nil
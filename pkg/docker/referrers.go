@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/log"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+
+	"github.com/open-component-model/ocm/pkg/docker/resolve"
+)
+
+// HeaderFiltersApplied is the response header used by registries
+// to indicate the filters applied to a referrers list.
+const HeaderFiltersApplied = "OCI-Filters-Applied"
+
+type dockerReferrers struct {
+	dockerBase *dockerBase
+}
+
+func (r *dockerResolver) Referrers(ctx context.Context, ref string) (resolve.Referrers, error) {
+	base, err := r.resolveDockerBase(ref)
+	if err != nil {
+		return nil, err
+	}
+	if base.refspec.Object != "" {
+		return nil, ErrObjectNotRequired
+	}
+
+	return &dockerReferrers{
+		dockerBase: base,
+	}, nil
+}
+
+func (r *dockerReferrers) List(ctx context.Context, dgst digest.Digest, artifactType string) ([]ocispec.Descriptor, error) {
+	refspec := r.dockerBase.refspec
+	base := r.dockerBase
+
+	hosts := base.filterHosts(HostCapabilityPull)
+	if len(hosts) == 0 {
+		return nil, errors.Wrap(errdefs.ErrNotFound, "no referrers hosts")
+	}
+
+	ctx, err := ContextWithRepositoryScope(ctx, refspec, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstErr error
+	for _, host := range hosts {
+		ctxWithLogger := log.WithLogger(ctx, log.G(ctx).WithField("host", host.Host))
+
+		req := base.request(host, http.MethodGet, "referrers", dgst.String())
+		if err := req.addNamespace(base.refspec.Hostname()); err != nil {
+			return nil, err
+		}
+		if artifactType != "" {
+			sep := "?"
+			if strings.Contains(req.path, "?") {
+				sep = "&"
+			}
+			req.path += sep + "artifactType=" + url.QueryEscape(artifactType)
+		}
+		req.header["Accept"] = []string{ocispec.MediaTypeImageIndex}
+
+		log.G(ctxWithLogger).Debug("listing referrers")
+		resp, err := req.doWithRetries(ctxWithLogger, nil)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			log.G(ctxWithLogger).WithError(err).Info("trying next host")
+			continue // try another host
+		}
+
+		if resp.StatusCode > 299 {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusNotFound {
+				// registries supporting the referrers API always respond
+				// with an (empty) index.
+				log.G(ctxWithLogger).Info("trying next host - response was http.StatusNotFound")
+				continue
+			}
+			if firstErr == nil {
+				firstErr = errors.Errorf("listing referrers from host %s failed with status code %v", host.Host, resp.Status)
+			}
+			continue // try another host
+		}
+
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		var index ocispec.Index
+		err = json.Unmarshal(data, &index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid referrers response from host %s", host.Host)
+		}
+		if artifactType == "" || resp.Header.Get(HeaderFiltersApplied) == "artifactType" {
+			return index.Manifests, nil
+		}
+		var result []ocispec.Descriptor
+		for _, d := range index.Manifests {
+			if d.ArtifactType == artifactType {
+				result = append(result, d)
+			}
+		}
+		return result, nil
+	}
+
+	if firstErr == nil {
+		firstErr = errors.Wrapf(errdefs.ErrNotImplemented, "referrers API not supported for %s", base.refspec.Locator)
+	}
+	return nil, firstErr
+}
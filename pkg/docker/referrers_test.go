@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/open-component-model/ocm/pkg/docker"
+)
+
+var _ = Describe("referrers", func() {
+	var server *httptest.Server
+	var status int
+	var filter bool
+	var requests []string
+	var ref string
+
+	subject := digest.FromString("subject")
+	sbom := ocispec.Descriptor{
+		MediaType:    ocispec.MediaTypeImageManifest,
+		Digest:       digest.FromString("sbom"),
+		Size:         100,
+		ArtifactType: "application/spdx+json",
+	}
+	sig := ocispec.Descriptor{
+		MediaType:    ocispec.MediaTypeImageManifest,
+		Digest:       digest.FromString("signature"),
+		Size:         200,
+		ArtifactType: "application/vnd.dev.cosign.artifact.sig.v1+json",
+	}
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		filter = false
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.RequestURI())
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+			index := ocispec.Index{MediaType: ocispec.MediaTypeImageIndex, Manifests: []ocispec.Descriptor{sbom, sig}}
+			if filter {
+				index.Manifests = index.Manifests[:1]
+				w.Header().Set(docker.HeaderFiltersApplied, "artifactType")
+			}
+			data, _ := json.Marshal(index)
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageIndex)
+			w.Write(data)
+		}))
+		ref = strings.TrimPrefix(server.URL, "http://") + "/test/repo"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	resolver := func() docker.ResolverOptions {
+		return docker.ResolverOptions{
+			Hosts: docker.ConfigureDefaultRegistries(docker.WithPlainHTTP(docker.MatchAllHosts)),
+		}
+	}
+
+	It("lists referrers", func() {
+		r := Must(docker.NewResolver(resolver()).Referrers(context.Background(), ref))
+		Expect(Must(r.List(context.Background(), subject, ""))).To(Equal([]ocispec.Descriptor{sbom, sig}))
+		Expect(requests).To(Equal([]string{"GET /v2/test/repo/referrers/" + subject.String()}))
+	})
+
+	It("filters referrers on client side", func() {
+		r := Must(docker.NewResolver(resolver()).Referrers(context.Background(), ref))
+		Expect(Must(r.List(context.Background(), subject, sig.ArtifactType))).To(Equal([]ocispec.Descriptor{sig}))
+		Expect(requests).To(Equal([]string{"GET /v2/test/repo/referrers/" + subject.String() + "?artifactType=application%2Fvnd.dev.cosign.artifact.sig.v1%2Bjson"}))
+	})
+
+	It("uses filter applied by registry", func() {
+		filter = true
+		r := Must(docker.NewResolver(resolver()).Referrers(context.Background(), ref))
+		Expect(Must(r.List(context.Background(), subject, sbom.ArtifactType))).To(Equal([]ocispec.Descriptor{sbom}))
+	})
+
+	It("reports unsupported referrers API", func() {
+		status = http.StatusNotFound
+		r := Must(docker.NewResolver(resolver()).Referrers(context.Background(), ref))
+		_, err := r.List(context.Background(), subject, "")
+		Expect(errdefs.IsNotImplemented(err)).To(BeTrue())
+	})
+})
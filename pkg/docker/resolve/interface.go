@@ -45,6 +45,12 @@ type Resolver interface {
 	Pusher(ctx context.Context, ref string) (Pusher, error)
 
 	Lister(ctx context.Context, ref string) (Lister, error)
+
+	// Deleter returns a new deleter for the provided namespace reference.
+	Deleter(ctx context.Context, ref string) (Deleter, error)
+
+	// Referrers returns a new referrers lister for the provided namespace reference.
+	Referrers(ctx context.Context, ref string) (Referrers, error)
 }
 
 // Fetcher fetches content.
@@ -65,6 +71,29 @@ type Lister interface {
 	List(context.Context) ([]string, error)
 }
 
+// Deleter deletes manifests and tags.
+type Deleter interface {
+	// Delete removes the manifest with the given digest
+	// together with all tags referring to it.
+	Delete(context.Context, digest.Digest) error
+	// Untag removes the given tag. The manifest
+	// is kept. This is an optional feature of the
+	// distribution API and might not be supported by
+	// the registry (ErrNotImplemented).
+	Untag(context.Context, string) error
+}
+
+// Referrers lists the manifests referring to a subject manifest
+// according to the referrers API of the OCI distribution specification 1.1.
+type Referrers interface {
+	// List returns the descriptors of the manifests referring to the
+	// manifest with the given digest. If an artifact type is given,
+	// only manifests with this artifact type are returned.
+	// If the registry does not support the referrers API
+	// ErrNotImplemented is returned.
+	List(ctx context.Context, dgst digest.Digest, artifactType string) ([]ocispec.Descriptor, error)
+}
+
 // PushRequest handles the result of a push request
 // replaces containerd content.Writer.
 type PushRequest interface {
@@ -13,8 +13,12 @@ const (
 	MIME_YAML     = "application/x-yaml"
 	MIME_YAML_ALT = "text/yaml" // no utf8
 
+	MIME_XML = "application/xml"
+	MIME_JAR = "application/java-archive"
+
 	MIME_GZIP    = "application/gzip"
 	MIME_TAR     = "application/x-tar"
 	MIME_TGZ     = "application/x-tgz"
 	MIME_TGZ_ALT = MIME_TAR + "+gzip"
+	MIME_ZIP     = "application/zip"
 )
@@ -107,11 +107,17 @@ func RootPool(pemfile string, useOS bool, fss ...vfs.FileSystem) (*x509.CertPool
 }
 
 func VerifyCert(intermediate, root *x509.CertPool, cn string, cert *x509.Certificate) error {
+	return VerifyCertAt(intermediate, root, cn, cert, time.Now())
+}
+
+// VerifyCertAt verifies a code signing certificate as of the given time,
+// for example the time attested by the timestamp of a signature.
+func VerifyCertAt(intermediate, root *x509.CertPool, cn string, cert *x509.Certificate, t time.Time) error {
 	opts := x509.VerifyOptions{
 		DNSName:       cn,
 		Intermediates: intermediate,
 		Roots:         root,
-		CurrentTime:   time.Now(),
+		CurrentTime:   t,
 		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
 	}
 	_, err := cert.Verify(opts)
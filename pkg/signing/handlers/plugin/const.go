@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+// ID_ISSUER is the consumer identity attribute used to
+// pass the issuer of a signature to the credential lookup.
+const ID_ISSUER = "issuer"
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/plugin"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+)
+
+// Handler is a signing.SignatureHandler delegating signing and verification
+// to a plugin based signing handler.
+type Handler struct {
+	plugin     plugin.Plugin
+	descriptor *plugin.SigningHandlerDescriptor
+}
+
+var _ signing.SignatureHandler = (*Handler)(nil)
+
+func New(p plugin.Plugin, name string) (*Handler, error) {
+	sd := p.GetSigningHandlerDescriptor(name)
+	if sd == nil {
+		return nil, errors.ErrUnknown(plugin.KIND_SIGNINGHANDLER, name, plugin.KIND_PLUGIN, p.Name())
+	}
+
+	return &Handler{
+		plugin:     p,
+		descriptor: sd,
+	}, nil
+}
+
+func (h *Handler) Algorithm() string {
+	return h.descriptor.Name
+}
+
+func (h *Handler) Sign(cctx credentials.Context, digest string, hash crypto.Hash, issuer string, key interface{}) (*signing.Signature, error) {
+	var creds json.RawMessage
+
+	if h.descriptor.ConsumerType != "" && cctx != nil {
+		id := credentials.ConsumerIdentity{
+			cpi.ID_TYPE: h.descriptor.ConsumerType,
+		}
+		if issuer != "" {
+			id[ID_ISSUER] = issuer
+		}
+		c, err := credentials.CredentialsForConsumer(cctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			creds, err = json.Marshal(c.Properties())
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot marshal credentials")
+			}
+		}
+	}
+
+	data, err := KeyData(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "private key")
+	}
+	sig, err := h.plugin.Sign(h.descriptor.Name, digest, hash, issuer, data, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &signing.Signature{
+		Value:     sig.Value,
+		MediaType: sig.MediaType,
+		Algorithm: sig.Algorithm,
+		Issuer:    sig.Issuer,
+	}, nil
+}
+
+func (h *Handler) Verify(digest string, hash crypto.Hash, sig *signing.Signature, key interface{}) error {
+	data, err := KeyData(key)
+	if err != nil {
+		return errors.Wrapf(err, "public key")
+	}
+	return h.plugin.Verify(h.descriptor.Name, digest, hash, &plugin.SignatureSpec{
+		Algorithm: sig.Algorithm,
+		Value:     sig.Value,
+		MediaType: sig.MediaType,
+		Issuer:    sig.Issuer,
+	}, data)
+}
+
+// KeyData provides the serialized form of a key passed to a plugin.
+// Raw key data is passed as it is, certificates and parsed keys are
+// PEM encoded.
+func KeyData(key interface{}) ([]byte, error) {
+	switch k := key.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return k, nil
+	case *x509.Certificate:
+		var buf bytes.Buffer
+		err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: k.Raw})
+		return buf.Bytes(), err
+	default:
+		return rsa.KeyData(key)
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build unix
+
+package plugin_test
+
+import (
+	"crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/plugindirattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/attrs/signingattr"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/registration"
+	"github.com/open-component-model/ocm/pkg/signing"
+)
+
+const ALGORITHM = "acme.org/hsm"
+
+var _ = Describe("plugin signing handler", func() {
+	var ctx ocm.Context
+	var env *Builder
+
+	BeforeEach(func() {
+		env = NewBuilder(nil)
+		ctx = env.OCMContext()
+		plugindirattr.Set(ctx, "testdata")
+	})
+
+	AfterEach(func() {
+		env.Cleanup()
+	})
+
+	It("registers signing handler", func() {
+		Expect(signingattr.Get(ctx).GetSigner(ALGORITHM)).To(BeNil())
+		MustBeSuccessful(registration.RegisterExtensions(ctx))
+		Expect(signingattr.Get(ctx).GetSigner(ALGORITHM)).NotTo(BeNil())
+		Expect(signingattr.Get(ctx).GetVerifier(ALGORITHM)).NotTo(BeNil())
+		Expect(signing.DefaultRegistry().GetSigner(ALGORITHM)).To(BeNil())
+	})
+
+	It("signs and verifies", func() {
+		MustBeSuccessful(registration.RegisterExtensions(ctx))
+		reg := signingattr.Get(ctx)
+
+		sig := Must(reg.GetSigner(ALGORITHM).Sign(ctx.CredentialsContext(), "0815", crypto.SHA256, "", []byte("key")))
+		Expect(sig).To(Equal(&signing.Signature{
+			Value:     "0815:key",
+			MediaType: "application/vnd.acme.signature",
+			Algorithm: ALGORITHM,
+			Issuer:    "acme.org",
+		}))
+		MustBeSuccessful(reg.GetVerifier(ALGORITHM).Verify("0815", crypto.SHA256, sig, []byte("key")))
+		Expect(reg.GetVerifier(ALGORITHM).Verify("0815", crypto.SHA256, sig, []byte("other"))).To(MatchError(ContainSubstring("signature mismatch")))
+	})
+})
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rsa_pss
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing"
+	rsahandler "github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+)
+
+// Algorithm defines the type for the RSA PSS signature algorithm.
+const Algorithm = "RSASSA-PSS"
+
+// MediaType defines the media type for a plain RSA PSS signature.
+const MediaType = "application/vnd.ocm.signature.rsa.pss"
+
+func init() {
+	signing.DefaultHandlerRegistry().RegisterSigner(Algorithm, Handler{})
+}
+
+// Handler is a signatures.Signer compatible struct to sign with RSASSA-PSS
+// and a signatures.Verifier compatible struct to verify RSASSA-PSS signatures.
+// It uses the same keys as the RSASSA-PKCS1-V1_5 handler. The salt length
+// is always the length of the used hash.
+type Handler struct{}
+
+var _ Handler = Handler{}
+
+func (h Handler) Algorithm() string {
+	return Algorithm
+}
+
+func pssOptions(hash crypto.Hash) *rsa.PSSOptions {
+	return &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash}
+}
+
+func (h Handler) Sign(cctx credentials.Context, digest string, hash crypto.Hash, issuer string, key interface{}) (signature *signing.Signature, err error) {
+	signer, err := rsahandler.GetSigner(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid rsa private key")
+	}
+	decodedHash, err := hex.DecodeString(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding hash to bytes")
+	}
+	sig, err := signer.Sign(rand.Reader, decodedHash, pssOptions(hash))
+	if err != nil {
+		return nil, fmt.Errorf("failed signing hash, %w", err)
+	}
+	return &signing.Signature{
+		Value:     hex.EncodeToString(sig),
+		MediaType: MediaType,
+		Algorithm: Algorithm,
+		Issuer:    issuer,
+	}, nil
+}
+
+// Verify checks the signature, returns an error on verification failure.
+func (h Handler) Verify(digest string, hash crypto.Hash, signature *signing.Signature, key interface{}) (err error) {
+	publicKey, names, err := rsahandler.GetPublicKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to get public key: %w", err)
+	}
+	if signature.MediaType != MediaType {
+		return fmt.Errorf("invalid signature mediaType %s", signature.MediaType)
+	}
+	signatureBytes, err := hex.DecodeString(signature.Value)
+	if err != nil {
+		return fmt.Errorf("unable to get signature value: failed decoding hash %s: %w", digest, err)
+	}
+	decodedHash, err := hex.DecodeString(digest)
+	if err != nil {
+		return fmt.Errorf("failed decoding hash %s: %w", digest, err)
+	}
+
+	if names != nil && signature.Issuer != "" {
+		found := false
+		for _, n := range names {
+			if n == signature.Issuer {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("issuer %q does not match %v", signature.Issuer, names)
+		}
+	}
+	if err := rsa.VerifyPSS(publicKey, hash, decodedHash, signatureBytes, pssOptions(hash)); err != nil {
+		return fmt.Errorf("signature verification failed, %w", err)
+	}
+	return nil
+}
+
+func (_ Handler) CreateKeyPair() (priv interface{}, pub interface{}, err error) {
+	return rsahandler.Handler{}.CreateKeyPair()
+}
@@ -6,6 +6,7 @@ package rsa
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -57,6 +58,23 @@ func GetPrivateKey(key interface{}) (*rsa.PrivateKey, error) {
 	}
 }
 
+// GetSigner provides a crypto.Signer for an RSA private key.
+// Besides the key formats accepted by GetPrivateKey, any crypto.Signer
+// using an RSA key is accepted, for example a key held by a PKCS#11 token.
+func GetSigner(key interface{}) (crypto.Signer, error) {
+	if s, ok := key.(crypto.Signer); ok {
+		if _, ok := s.Public().(*rsa.PublicKey); !ok {
+			return nil, fmt.Errorf("unknown public key %T of signer", s.Public())
+		}
+		return s, nil
+	}
+	k, err := GetPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
 func WriteKeyData(key interface{}, w io.Writer) error {
 	block, err := PemBlockForKey(key)
 	if err != nil {
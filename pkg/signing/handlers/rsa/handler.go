@@ -52,7 +52,7 @@ func (h Handler) Algorithm() string {
 }
 
 func (h Handler) Sign(cctx credentials.Context, digest string, hash crypto.Hash, issuer string, key interface{}) (signature *signing.Signature, err error) {
-	privateKey, err := GetPrivateKey(key)
+	signer, err := GetSigner(key)
 	if err != nil {
 		return nil, errors.Wrapf(err, "invalid rsa private key")
 	}
@@ -60,7 +60,7 @@ func (h Handler) Sign(cctx credentials.Context, digest string, hash crypto.Hash,
 	if err != nil {
 		return nil, fmt.Errorf("failed decoding hash to bytes")
 	}
-	sig, err := rsa.SignPKCS1v15(rand.Reader, privateKey, hash, decodedHash)
+	sig, err := signer.Sign(rand.Reader, decodedHash, hash)
 	if err != nil {
 		return nil, fmt.Errorf("failed signing hash, %w", err)
 	}
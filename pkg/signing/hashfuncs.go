@@ -49,3 +49,9 @@ func LegacyHashAlgorithm(algo string) string {
 func IsLegacyHashAlgorithm(algo string) bool {
 	return legacy[algo] != 0
 }
+
+// HashFunction returns the crypto hash function for a (legacy or normalized)
+// hash algorithm name. If the name is unknown 0 is returned.
+func HashFunction(algo string) crypto.Hash {
+	return hashfuncs[algo]
+}
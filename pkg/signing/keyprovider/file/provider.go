@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package file
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"golang.org/x/crypto/pkcs12"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+const TYPE = "file"
+
+// PEM headers used to determine the alias of a PEM block.
+// The friendlyName and localKeyId headers are generated for
+// PKCS#12 bags.
+const (
+	HEADER_ALIAS         = "alias"
+	HEADER_FRIENDLY_NAME = "friendlyName"
+	HEADER_LOCAL_KEY_ID  = "localKeyId"
+)
+
+func init() {
+	keyprovider.RegisterProviderType(TYPE, New, usage)
+}
+
+const usage = `
+a PKCS#12 file or a PEM bundle given by <code>path</code>.
+The alias of a key or certificate is taken from the PEM header
+<code>alias</code> or the PKCS#12 friendly name.
+`
+
+type entry struct {
+	key  crypto.Signer
+	cert *x509.Certificate
+	pub  crypto.PublicKey
+}
+
+// Provider provides keys from a PKCS#12 file or a PEM bundle.
+// The passphrase of the file is taken from the credentials
+// configured for the key store.
+type Provider struct {
+	lock    sync.Mutex
+	fs      vfs.FileSystem
+	name    string
+	path    string
+	entries map[string]*entry
+}
+
+var _ keyprovider.KeyProvider = (*Provider)(nil)
+
+func New(fs vfs.FileSystem, name string, spec *keyprovider.StoreSpec) (keyprovider.KeyProvider, error) {
+	if spec.Path == "" {
+		return nil, errors.Newf("path required for key store type %q", TYPE)
+	}
+	return &Provider{
+		fs:   utils.FileSystem(fs),
+		name: name,
+		path: spec.Path,
+	}, nil
+}
+
+func (p *Provider) GetPrivateKey(cctx credentials.Context, alias string) (interface{}, error) {
+	e, err := p.lookup(cctx, alias)
+	if err != nil {
+		return nil, err
+	}
+	if e.key == nil {
+		return nil, errors.ErrNotFound("private key", alias, p.name)
+	}
+	return e.key, nil
+}
+
+func (p *Provider) GetPublicKey(cctx credentials.Context, alias string) (interface{}, error) {
+	e, err := p.lookup(cctx, alias)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case e.key != nil:
+		return e.key.Public(), nil
+	case e.cert != nil:
+		return e.cert.PublicKey, nil
+	default:
+		return e.pub, nil
+	}
+}
+
+func (p *Provider) lookup(cctx credentials.Context, alias string) (*entry, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.entries == nil {
+		entries, err := p.load(cctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %q", keyprovider.KIND_KEYSTORE, p.name)
+		}
+		p.entries = entries
+	}
+	e := p.entries[alias]
+	if e == nil {
+		return nil, errors.ErrNotFound(keyprovider.KIND_KEY, alias, p.name)
+	}
+	return e, nil
+}
+
+func (p *Provider) load(cctx credentials.Context) (map[string]*entry, error) {
+	data, err := vfs.ReadFile(p.fs, p.path)
+	if err != nil {
+		return nil, err
+	}
+	var password string
+	if cctx != nil {
+		password, err = keyprovider.GetPassword(cctx, p.name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var blocks []*pem.Block
+	if block, rest := pem.Decode(data); block != nil {
+		for block != nil {
+			blocks = append(blocks, block)
+			block, rest = pem.Decode(rest)
+		}
+	} else {
+		blocks, err = pkcs12.ToPEM(data, password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid PKCS#12 file")
+		}
+	}
+	return parseBlocks(blocks, password)
+}
+
+func parseBlocks(blocks []*pem.Block, password string) (map[string]*entry, error) {
+	entries := map[string]*entry{}
+	ids := map[string]string{}
+
+	get := func(block *pem.Block) *entry {
+		alias := block.Headers[HEADER_ALIAS]
+		if alias == "" {
+			alias = block.Headers[HEADER_FRIENDLY_NAME]
+		}
+		if alias == "" {
+			alias = ids[block.Headers[HEADER_LOCAL_KEY_ID]]
+		}
+		if alias == "" {
+			return nil
+		}
+		if id := block.Headers[HEADER_LOCAL_KEY_ID]; id != "" {
+			ids[id] = alias
+		}
+		e := entries[alias]
+		if e == nil {
+			e = &entry{}
+			entries[alias] = e
+		}
+		return e
+	}
+
+	// certificates first to pair keys without friendly name by key id
+	for _, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid certificate")
+		}
+		if e := get(block); e != nil {
+			e.cert = cert
+		}
+	}
+	for _, block := range blocks {
+		if block.Type == "CERTIFICATE" {
+			continue
+		}
+		e := get(block)
+		if e == nil {
+			continue
+		}
+		if block.Type == "PUBLIC KEY" {
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid public key")
+			}
+			e.pub = pub
+			continue
+		}
+		key, err := parsePrivateKey(block, password)
+		if err != nil {
+			return nil, err
+		}
+		e.key = key
+	}
+	return entries, nil
+}
+
+func parsePrivateKey(block *pem.Block, password string) (crypto.Signer, error) {
+	data := block.Bytes
+	//nolint: staticcheck // legacy PEM encryption is still used for key bundles
+	if x509.IsEncryptedPEMBlock(block) {
+		var err error
+		//nolint: staticcheck // see above
+		data, err = x509.DecryptPEMBlock(block, []byte(password))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot decrypt private key")
+		}
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(data)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(data)
+	case "PRIVATE KEY":
+		// PKCS#12 bags provide PKCS#1 or SEC 1 data for RSA or EC keys
+		key, err = x509.ParsePKCS8PrivateKey(data)
+		if err != nil {
+			if k, err2 := x509.ParsePKCS1PrivateKey(data); err2 == nil {
+				key, err = k, nil
+			} else if k, err2 := x509.ParseECPrivateKey(data); err2 == nil {
+				key, err = k, nil
+			}
+		}
+	default:
+		return nil, errors.ErrNotSupported("PEM block type", block.Type)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid private key")
+	}
+	if s, ok := key.(crypto.Signer); ok {
+		return s, nil
+	}
+	return nil, errors.Newf("unsupported private key type %T", key)
+}
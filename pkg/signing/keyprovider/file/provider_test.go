@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package file_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider/file"
+)
+
+const STORE = "release"
+
+var _ = Describe("file key store", func() {
+	var cctx credentials.Context
+
+	BeforeEach(func() {
+		cctx = credentials.New()
+	})
+
+	setPassword := func(pw string) {
+		cctx.SetCredentialsForConsumer(keyprovider.GetConsumerId(STORE), credentials.NewCredentials(common.Properties{keyprovider.ATTR_PASSWORD: pw}))
+	}
+
+	Context("PKCS#12", func() {
+		var p keyprovider.KeyProvider
+
+		BeforeEach(func() {
+			p = Must(keyprovider.Create(osfs.New(), STORE, &keyprovider.StoreSpec{Type: file.TYPE, Path: "testdata/keystore.p12"}))
+		})
+
+		It("provides keys", func() {
+			setPassword("test")
+
+			priv := Must(p.GetPrivateKey(cctx, "release"))
+			Expect(priv).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+			pub := Must(p.GetPublicKey(cctx, "release"))
+			Expect(pub).To(Equal(priv.(crypto.Signer).Public()))
+		})
+
+		It("fails for unknown alias", func() {
+			setPassword("test")
+
+			_, err := p.GetPrivateKey(cctx, "other")
+			Expect(err).To(MatchError(`key "other" not found in release`))
+		})
+
+		It("fails for wrong passphrase", func() {
+			setPassword("wrong")
+
+			_, err := p.GetPrivateKey(cctx, "release")
+			Expect(err).To(MatchError(ContainSubstring(`key store "release": invalid PKCS#12 file`)))
+		})
+	})
+
+	Context("PEM bundle", func() {
+		var fs vfs.FileSystem
+		var key *rsa.PrivateKey
+
+		BeforeEach(func() {
+			fs = memoryfs.New()
+			key = Must(rsa.GenerateKey(rand.Reader, 2048))
+
+			//nolint: staticcheck // legacy PEM encryption is supported for key bundles
+			block := Must(x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), []byte("test"), x509.PEMCipherAES256))
+			block.Headers[file.HEADER_ALIAS] = "signing"
+			pubblock := &pem.Block{
+				Type:    "PUBLIC KEY",
+				Headers: map[string]string{file.HEADER_ALIAS: "trust"},
+				Bytes:   Must(x509.MarshalPKIXPublicKey(&key.PublicKey)),
+			}
+			data := append(pem.EncodeToMemory(block), pem.EncodeToMemory(pubblock)...)
+			MustBeSuccessful(vfs.WriteFile(fs, "keys.pem", data, 0o600))
+		})
+
+		It("provides encrypted keys", func() {
+			setPassword("test")
+			p := Must(keyprovider.Create(fs, STORE, &keyprovider.StoreSpec{Type: file.TYPE, Path: "keys.pem"}))
+
+			Expect(p.GetPrivateKey(cctx, "signing")).To(Equal(key))
+			Expect(p.GetPublicKey(cctx, "signing")).To(Equal(&key.PublicKey))
+		})
+
+		It("provides public keys", func() {
+			setPassword("test")
+			p := Must(keyprovider.Create(fs, STORE, &keyprovider.StoreSpec{Type: file.TYPE, Path: "keys.pem"}))
+
+			Expect(p.GetPublicKey(cctx, "trust")).To(Equal(&key.PublicKey))
+			_, err := p.GetPrivateKey(cctx, "trust")
+			Expect(err).To(MatchError(`private key "trust" not found in release`))
+		})
+
+		It("fails without passphrase", func() {
+			p := Must(keyprovider.Create(fs, STORE, &keyprovider.StoreSpec{Type: file.TYPE, Path: "keys.pem"}))
+
+			_, err := p.GetPrivateKey(cctx, "signing")
+			Expect(err).To(MatchError(ContainSubstring("cannot decrypt private key")))
+		})
+	})
+})
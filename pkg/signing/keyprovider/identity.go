@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keyprovider
+
+import (
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials/cpi"
+	"github.com/open-component-model/ocm/pkg/listformat"
+)
+
+const CONSUMER_TYPE = "KeyStore" + common.OCM_TYPE_GROUP_SUFFIX
+
+// identity properties.
+const (
+	ID_NAME = "name"
+)
+
+// credential properties.
+const (
+	ATTR_PASSWORD = cpi.ATTR_PASSWORD
+)
+
+func init() {
+	attrs := listformat.FormatListElements("", listformat.StringElementDescriptionList{
+		ATTR_PASSWORD, "passphrase or PIN of the key store",
+	})
+	cpi.RegisterStandardIdentity(CONSUMER_TYPE, cpi.CompleteMatch,
+		`Key store credential matcher
+
+It matches the <code>`+CONSUMER_TYPE+`</code> consumer type and the
+name of the key store given by the identity attribute <code>`+ID_NAME+`</code>.`,
+		attrs)
+}
+
+func GetConsumerId(name string) cpi.ConsumerIdentity {
+	return cpi.ConsumerIdentity{
+		cpi.ID_TYPE: CONSUMER_TYPE,
+		ID_NAME:     name,
+	}
+}
+
+func GetCredentials(ctx cpi.ContextProvider, name string) (cpi.Credentials, error) {
+	return cpi.CredentialsForConsumer(ctx.CredentialsContext(), GetConsumerId(name), cpi.CompleteMatch)
+}
+
+// GetPassword provides the password configured for a key store.
+// If no credentials are configured, an empty string is returned.
+func GetPassword(ctx cpi.ContextProvider, name string) (string, error) {
+	if ctx == nil {
+		return "", nil
+	}
+	creds, err := GetCredentials(ctx, name)
+	if err != nil || creds == nil {
+		return "", err
+	}
+	return creds.GetProperty(ATTR_PASSWORD), nil
+}
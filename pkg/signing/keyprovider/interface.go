@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keyprovider
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+const (
+	KIND_KEYSTORE      = "key store"
+	KIND_KEYSTORE_TYPE = "key store type"
+	KIND_KEY           = "key"
+)
+
+// KeyProvider provides access to keys held by a key store.
+// Keys are addressed by an alias, whose interpretation depends
+// on the type of the store.
+type KeyProvider interface {
+	// GetPrivateKey provides the private key for an alias.
+	// The result is either a private key object accepted by the signing
+	// handlers or a crypto.Signer, if the key cannot be exported from
+	// the store.
+	GetPrivateKey(cctx credentials.Context, alias string) (interface{}, error)
+	// GetPublicKey provides the public key for an alias.
+	GetPublicKey(cctx credentials.Context, alias string) (interface{}, error)
+}
+
+// StoreSpec describes a key store.
+type StoreSpec struct {
+	// Type is the type of the key store.
+	Type string `json:"type"`
+	// Path is the path of the store file or the PKCS#11 module.
+	Path string `json:"path,omitempty"`
+	// Token is the label of the PKCS#11 token.
+	Token string `json:"token,omitempty"`
+	// Slot is the slot number of the PKCS#11 token.
+	Slot *int `json:"slot,omitempty"`
+}
+
+// ProviderType creates a key provider for a store with the given name
+// according to a store specification.
+type ProviderType func(fs vfs.FileSystem, name string, spec *StoreSpec) (KeyProvider, error)
+
+type providerType struct {
+	create ProviderType
+	usage  string
+}
+
+var (
+	lock        sync.RWMutex
+	types       = map[string]*providerType{}
+	unsupported = map[string]string{}
+)
+
+// RegisterProviderType registers a key store type together with a
+// description of its specification attributes.
+func RegisterProviderType(typ string, t ProviderType, usage string) {
+	lock.Lock()
+	defer lock.Unlock()
+	types[typ] = &providerType{create: t, usage: usage}
+	delete(unsupported, typ)
+}
+
+// RegisterUnsupportedType registers a key store type, which is
+// known, but not available in the actual build. Using it is reported
+// with the given reason.
+func RegisterUnsupportedType(typ string, reason string) {
+	lock.Lock()
+	defer lock.Unlock()
+	if types[typ] == nil {
+		unsupported[typ] = reason
+	}
+}
+
+// ProviderTypes provides the names of the registered key store types.
+// Unsupported types are not included.
+func ProviderTypes() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+	return utils.StringMapKeys(types)
+}
+
+// Usage provides a description of the registered key store types.
+func Usage() string {
+	lock.RLock()
+	defer lock.RUnlock()
+	s := ""
+	for _, n := range utils.StringMapKeys(types) {
+		s += "- <code>" + n + "</code>: " + utils.IndentLines(strings.TrimSpace(types[n].usage), "  ", true) + "\n"
+	}
+	return s
+}
+
+// Create creates a key provider for a store specification.
+func Create(fs vfs.FileSystem, name string, spec *StoreSpec) (KeyProvider, error) {
+	lock.RLock()
+	t := types[spec.Type]
+	reason, ok := unsupported[spec.Type]
+	lock.RUnlock()
+	if t == nil {
+		if ok {
+			return nil, errors.ErrNotSupported(KIND_KEYSTORE_TYPE, spec.Type, reason)
+		}
+		return nil, errors.ErrUnknown(KIND_KEYSTORE_TYPE, spec.Type)
+	}
+	p, err := t.create(fs, name, spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %q", KIND_KEYSTORE, name)
+	}
+	return p, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// KeyRef is a reference to a key held by a key store.
+type KeyRef struct {
+	Store string
+	Alias string
+}
+
+// ParseKeyRef parses a key reference of the form <code>store:alias</code>.
+func ParseKeyRef(ref string) (KeyRef, error) {
+	idx := strings.Index(ref, ":")
+	if idx <= 0 || idx == len(ref)-1 {
+		return KeyRef{}, errors.ErrInvalid("key reference", ref)
+	}
+	return KeyRef{Store: ref[:idx], Alias: ref[idx+1:]}, nil
+}
+
+func (r KeyRef) String() string {
+	return r.Store + ":" + r.Alias
+}
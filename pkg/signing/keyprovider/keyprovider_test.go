@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keyprovider_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+)
+
+var _ = Describe("key references", func() {
+	It("parses key reference", func() {
+		Expect(keyprovider.ParseKeyRef("hsm:release")).To(Equal(keyprovider.KeyRef{Store: "hsm", Alias: "release"}))
+		Expect(keyprovider.ParseKeyRef("hsm:release:v1")).To(Equal(keyprovider.KeyRef{Store: "hsm", Alias: "release:v1"}))
+		Expect(Must(keyprovider.ParseKeyRef("hsm:release")).String()).To(Equal("hsm:release"))
+	})
+
+	It("rejects invalid key reference", func() {
+		for _, r := range []string{"release", ":release", "hsm:"} {
+			_, err := keyprovider.ParseKeyRef(r)
+			Expect(err).To(MatchError(ContainSubstring("key reference")))
+		}
+	})
+
+	It("rejects unknown store type", func() {
+		_, err := keyprovider.Create(nil, "test", &keyprovider.StoreSpec{Type: "vault"})
+		Expect(err).To(MatchError(`key store type "vault" is unknown`))
+	})
+	It("reports unsupported store type", func() {
+		keyprovider.RegisterUnsupportedType("hsm", "this build")
+		Expect(keyprovider.ProviderTypes()).NotTo(ContainElement("hsm"))
+		Expect(keyprovider.Usage()).NotTo(ContainSubstring("hsm"))
+		_, err := keyprovider.Create(nil, "test", &keyprovider.StoreSpec{Type: "hsm"})
+		Expect(errors.IsErrNotSupportedKind(err, keyprovider.KIND_KEYSTORE_TYPE)).To(BeTrue())
+		Expect(err).To(MatchError(`key store type "hsm" not supported by this build`))
+	})
+})
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build cgo
+
+package pkcs11
+
+import (
+	"sync"
+
+	"github.com/ThalesIgnite/crypto11"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+)
+
+func init() {
+	keyprovider.RegisterProviderType(TYPE, New, usage)
+}
+
+const usage = `
+a PKCS#11 token, described by the module <code>path</code> and the
+<code>token</code> label or <code>slot</code> number. The alias is the
+label of a key pair on the token.
+`
+
+// Provider provides keys held by a PKCS#11 token. The keys are
+// addressed by their label. Private keys cannot be exported, they are
+// provided as crypto.Signer performing the signing operation on the token.
+// The PIN of the token is taken from the credentials configured for the
+// key store.
+type Provider struct {
+	lock   sync.Mutex
+	name   string
+	config crypto11.Config
+	ctx    *crypto11.Context
+}
+
+var _ keyprovider.KeyProvider = (*Provider)(nil)
+
+func New(fs vfs.FileSystem, name string, spec *keyprovider.StoreSpec) (keyprovider.KeyProvider, error) {
+	if spec.Path == "" {
+		return nil, errors.Newf("module path required for key store type %q", TYPE)
+	}
+	if spec.Token == "" && spec.Slot == nil {
+		return nil, errors.Newf("token label or slot required for key store type %q", TYPE)
+	}
+	return &Provider{
+		name: name,
+		config: crypto11.Config{
+			Path:       spec.Path,
+			TokenLabel: spec.Token,
+			SlotNumber: spec.Slot,
+		},
+	}, nil
+}
+
+func (p *Provider) GetPrivateKey(cctx credentials.Context, alias string) (interface{}, error) {
+	ctx, err := p.context(cctx)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ctx.FindKeyPair(nil, []byte(alias))
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %q", keyprovider.KIND_KEYSTORE, p.name)
+	}
+	if key == nil {
+		return nil, errors.ErrNotFound(keyprovider.KIND_KEY, alias, p.name)
+	}
+	return key, nil
+}
+
+func (p *Provider) GetPublicKey(cctx credentials.Context, alias string) (interface{}, error) {
+	key, err := p.GetPrivateKey(cctx, alias)
+	if err != nil {
+		return nil, err
+	}
+	return key.(crypto11.Signer).Public(), nil
+}
+
+// context provides the session context for the token. It is kept open
+// as long as the provider is used, because the provided signers
+// require access to the token.
+func (p *Provider) context(cctx credentials.Context) (*crypto11.Context, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.ctx != nil {
+		return p.ctx, nil
+	}
+	cfg := p.config
+	pin, err := keyprovider.GetPassword(cctx, p.name)
+	if err != nil {
+		return nil, err
+	}
+	if pin == "" {
+		return nil, errors.Newf("no PIN configured for %s %q", keyprovider.KIND_KEYSTORE, p.name)
+	}
+	cfg.Pin = pin
+	ctx, err := crypto11.Configure(&cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s %q", keyprovider.KIND_KEYSTORE, p.name)
+	}
+	p.ctx = ctx
+	return ctx, nil
+}
+
+// Close releases the session to the token.
+func (p *Provider) Close() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.ctx == nil {
+		return nil
+	}
+	err := p.ctx.Close()
+	p.ctx = nil
+	return err
+}
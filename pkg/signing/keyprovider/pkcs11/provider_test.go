@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build cgo
+
+package pkcs11_test
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ThalesIgnite/crypto11"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider/pkcs11"
+)
+
+const STORE = "hsm"
+
+// The tests require an initialized token, for example provided by SoftHSM:
+//
+//	softhsm2-util --init-token --free --label ocm --pin 1234 --so-pin 1234
+//	PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so PKCS11_TOKEN=ocm PKCS11_PIN=1234 go test
+var (
+	module = os.Getenv("PKCS11_MODULE")
+	token  = os.Getenv("PKCS11_TOKEN")
+	pin    = os.Getenv("PKCS11_PIN")
+)
+
+var _ = Describe("PKCS#11 key store", func() {
+	var cctx credentials.Context
+	var spec *keyprovider.StoreSpec
+
+	BeforeEach(func() {
+		if module == "" || token == "" {
+			Skip("no PKCS#11 token configured")
+		}
+		cctx = credentials.New()
+		spec = &keyprovider.StoreSpec{Type: pkcs11.TYPE, Path: module, Token: token}
+	})
+
+	It("requires a PIN", func() {
+		p := Must(keyprovider.Create(nil, STORE, spec))
+		_, err := p.GetPrivateKey(cctx, "any")
+		Expect(err).To(MatchError(`no PIN configured for key store "hsm"`))
+	})
+
+	It("signs with key held by the token", func() {
+		alias := fmt.Sprintf("ocm-test-%d", time.Now().UnixNano())
+		ctx := Must(crypto11.Configure(&crypto11.Config{Path: module, TokenLabel: token, Pin: pin}))
+		defer Close(ctx, "pkcs11 context")
+		key := Must(ctx.GenerateRSAKeyPairWithLabel([]byte(alias), []byte(alias), 2048))
+		defer func() { _ = key.Delete() }()
+
+		cctx.SetCredentialsForConsumer(keyprovider.GetConsumerId(STORE), credentials.NewCredentials(common.Properties{keyprovider.ATTR_PASSWORD: pin}))
+		p := Must(keyprovider.Create(nil, STORE, spec))
+		defer Close(p.(io.Closer), "provider")
+
+		priv := Must(p.GetPrivateKey(cctx, alias))
+		pub := Must(p.GetPublicKey(cctx, alias))
+
+		hash := sha256.Sum256([]byte("test data"))
+		digest := hex.EncodeToString(hash[:])
+		sig := Must(rsa.Handler{}.Sign(cctx, digest, crypto.SHA256, "", priv))
+		MustBeSuccessful(rsa.Handler{}.Verify(digest, crypto.SHA256, sig, pub))
+
+		_, err := p.GetPrivateKey(cctx, "unknown")
+		Expect(err).To(MatchError(`key "unknown" not found in hsm`))
+	})
+})
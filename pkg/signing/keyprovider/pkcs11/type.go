@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pkcs11
+
+// TYPE is the key store type for PKCS#11 tokens.
+// Access to PKCS#11 modules requires a build with cgo support,
+// otherwise the type is reported as not supported.
+const TYPE = "pkcs11"
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !cgo
+
+package pkcs11
+
+import (
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+)
+
+func init() {
+	keyprovider.RegisterUnsupportedType(TYPE, "binaries built without cgo")
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !cgo
+
+package pkcs11_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider"
+	"github.com/open-component-model/ocm/pkg/signing/keyprovider/pkcs11"
+)
+
+var _ = Describe("pkcs11 key store without cgo", func() {
+	It("reports type as not supported", func() {
+		Expect(keyprovider.ProviderTypes()).NotTo(ContainElement(pkcs11.TYPE))
+		_, err := keyprovider.Create(nil, "hsm", &keyprovider.StoreSpec{Type: pkcs11.TYPE, Path: "/usr/lib/softhsm/libsofthsm2.so", Token: "signing"})
+		Expect(errors.IsErrNotSupportedKind(err, keyprovider.KIND_KEYSTORE_TYPE)).To(BeTrue())
+		Expect(err).To(MatchError(`key store type "pkcs11" not supported by binaries built without cgo`))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/open-component-model/ocm/pkg/contexts/credentials"
 	"github.com/open-component-model/ocm/pkg/signing"
 	"github.com/open-component-model/ocm/pkg/signing/handlers/rsa"
+	rsa_pss "github.com/open-component-model/ocm/pkg/signing/handlers/rsa-pss"
 	"github.com/open-component-model/ocm/pkg/signing/hasher/sha256"
 )
 
@@ -45,4 +46,21 @@ var _ = Describe("normalization", func() {
 		hash = "A" + hash[1:]
 		Expect(registry.GetVerifier(rsa.Algorithm).Verify(hash, hasher.Crypto(), sig, registry.GetPublicKey(NAME))).To(HaveOccurred())
 	})
+	It("signs with RSASSA-PSS", func() {
+		hasher := registry.GetHasher(sha256.Algorithm)
+		hash, _ := signing.Hash(hasher.Create(), []byte("test"))
+
+		priv, pub, err := rsa_pss.Handler{}.CreateKeyPair()
+		Expect(err).To(Succeed())
+
+		sig, err := registry.GetSigner(rsa_pss.Algorithm).Sign(defaultContext, hash, hasher.Crypto(), "mandelsoft", priv)
+		Expect(err).To(Succeed())
+		Expect(sig.MediaType).To(Equal(rsa_pss.MediaType))
+		Expect(sig.Algorithm).To(Equal(rsa_pss.Algorithm))
+
+		Expect(registry.GetVerifier(rsa_pss.Algorithm).Verify(hash, hasher.Crypto(), sig, pub)).To(Succeed())
+		Expect(registry.GetVerifier(rsa.Algorithm).Verify(hash, hasher.Crypto(), sig, pub)).To(HaveOccurred())
+		hash = "A" + hash[1:]
+		Expect(registry.GetVerifier(rsa_pss.Algorithm).Verify(hash, hasher.Crypto(), sig, pub)).To(HaveOccurred())
+	})
 })
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testtsa
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+)
+
+// Server is a local RFC 3161 time stamping authority for tests.
+// Its certificate is issued by a dedicated root certificate, which
+// must be trusted to verify the provided timestamps.
+type Server struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	time     time.Time
+	user     string
+	password string
+	requests int
+
+	Root     *x509.Certificate
+	RootPool *x509.CertPool
+	Cert     *x509.Certificate
+	key      *rsa.PrivateKey
+}
+
+// New starts a time stamping authority with certificates valid
+// for the given period starting at the given time.
+func New(validFrom time.Time, validity time.Duration) (*Server, error) {
+	cakey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test TSA root"},
+		NotBefore:             validFrom,
+		NotAfter:              validFrom.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	data, err := x509.CreateCertificate(rand.Reader, ca, ca, &cakey.PublicKey, cakey)
+	if err != nil {
+		return nil, err
+	}
+	ca, err = x509.ParseCertificate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test TSA"},
+		NotBefore:    validFrom,
+		NotAfter:     validFrom.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}
+	data, err = x509.CreateCertificate(rand.Reader, cert, ca, &key.PublicKey, cakey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err = x509.ParseCertificate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		Root:     ca,
+		RootPool: x509.NewCertPool(),
+		Cert:     cert,
+		key:      key,
+	}
+	s.RootPool.AddCert(ca)
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s, nil
+}
+
+// SetTime sets the time attested by the authority.
+// A zero time means the actual time.
+func (s *Server) SetTime(t time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.time = t
+}
+
+// RequireBasicAuth requires the given basic auth credentials.
+func (s *Server) RequireBasicAuth(user, password string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.user = user
+	s.password = password
+}
+
+// Requests provides the number of handled timestamp requests.
+func (s *Server) Requests() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.user != "" {
+		if u, p, ok := r.BasicAuth(); !ok || u != s.user || p != s.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != tsa.MIME_QUERY {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	resp, err := s.respond(data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	s.requests++
+	w.Header().Set("Content-Type", tsa.MIME_REPLY)
+	w.Write(resp)
+}
+
+func (s *Server) respond(data []byte) ([]byte, error) {
+	req, err := timestamp.ParseRequest(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid timestamp request")
+	}
+	t := s.time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	ts := timestamp.Timestamp{
+		HashAlgorithm:     req.HashAlgorithm,
+		HashedMessage:     req.HashedMessage,
+		Time:              t.UTC(),
+		Nonce:             req.Nonce,
+		Policy:            []int{1, 2, 3, 4, 1},
+		AddTSACertificate: req.Certificates,
+	}
+	return ts.CreateResponse(s.Cert, s.key)
+}
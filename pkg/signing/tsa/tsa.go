@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/digitorus/timestamp"
+
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/errors"
+)
+
+const (
+	KIND_TIMESTAMP = "timestamp"
+
+	// MIME_QUERY is the content type of RFC 3161 timestamp requests.
+	MIME_QUERY = "application/timestamp-query"
+	// MIME_REPLY is the content type of RFC 3161 timestamp responses.
+	MIME_REPLY = "application/timestamp-reply"
+)
+
+// DefaultHash is the hash function used for the message imprint
+// of timestamp requests.
+const DefaultHash = crypto.SHA256
+
+// DefaultClient is the client used to access time stamping authorities.
+var DefaultClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 60 * time.Second,
+	},
+	Timeout: 2 * time.Minute,
+}
+
+// Timestamp is a verified RFC 3161 timestamp token.
+type Timestamp struct {
+	// Token is the DER encoded timestamp token.
+	Token []byte
+	// Time is the time attested by the time stamping authority.
+	Time time.Time
+	// Certificates are the certificates of the time stamping authority
+	// included in the token, starting with the signing certificate.
+	Certificates []*x509.Certificate
+}
+
+// Request requests a timestamp token for the given message from the
+// time stamping authority with the given URL. The message is hashed with
+// DefaultHash. Credentials for the authority are taken from the given
+// credentials context (consumer type CONSUMER_TYPE), if given.
+func Request(cctx credentials.Context, url string, message []byte) (*Timestamp, error) {
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot generate nonce")
+	}
+	query, err := timestamp.CreateRequest(bytes.NewReader(message), &timestamp.RequestOptions{
+		Hash:         DefaultHash,
+		Certificates: true,
+		Nonce:        nonce,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot create timestamp request")
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, bytes.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", MIME_QUERY)
+	req.Header.Set("Accept", MIME_REPLY)
+	if cctx != nil {
+		creds, err := GetCredentials(cctx, url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get credentials for time stamping authority %q", url)
+		}
+		if creds != nil {
+			if t := creds.GetProperty(ATTR_TOKEN); t != "" {
+				req.Header.Set("Authorization", "Bearer "+t)
+			} else if u := creds.GetProperty(ATTR_USERNAME); u != "" {
+				req.SetBasicAuth(u, creds.GetProperty(ATTR_PASSWORD))
+			}
+		}
+	}
+
+	resp, err := DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "timestamp request to %q failed", url)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read timestamp response of %q", url)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("timestamp request to %q provides %s", url, resp.Status)
+	}
+
+	ts, err := timestamp.ParseResponse(body)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, "timestamp response", url)
+	}
+	if ts.Nonce == nil || ts.Nonce.Cmp(nonce) != 0 {
+		return nil, errors.Newf("timestamp response of %q does not match nonce", url)
+	}
+	return check(ts, message)
+}
+
+// Verify verifies a DER encoded timestamp token for the given message.
+// The certificate of the time stamping authority is validated as of the
+// attested time against the given root certificates. If no roots are
+// given, the system roots are used.
+func Verify(token []byte, message []byte, roots *x509.CertPool) (*Timestamp, error) {
+	ts, err := timestamp.Parse(token)
+	if err != nil {
+		return nil, errors.ErrInvalidWrap(err, KIND_TIMESTAMP)
+	}
+	result, err := check(ts, message)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, c := range result.Certificates[1:] {
+		intermediates.AddCert(c)
+	}
+	_, err = result.Certificates[0].Verify(x509.VerifyOptions{
+		Intermediates: intermediates,
+		Roots:         roots,
+		CurrentTime:   result.Time,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "untrusted time stamping authority")
+	}
+	return result, nil
+}
+
+// check validates the message imprint of a timestamp and determines
+// the certificates of the authority.
+// The signature of the token is already checked by the parser, if
+// it contains certificates.
+func check(ts *timestamp.Timestamp, message []byte) (*Timestamp, error) {
+	if !ts.HashAlgorithm.Available() {
+		return nil, errors.ErrNotSupported("timestamp hash algorithm", ts.HashAlgorithm.String())
+	}
+	h := ts.HashAlgorithm.New()
+	h.Write(message)
+	if !bytes.Equal(h.Sum(nil), ts.HashedMessage) {
+		return nil, errors.Newf("message imprint of timestamp does not match")
+	}
+	if len(ts.Certificates) == 0 {
+		return nil, errors.Newf("timestamp does not contain certificate of time stamping authority")
+	}
+
+	// order certificates to start with the signing certificate
+	certs := append([]*x509.Certificate{}, ts.Certificates...)
+	for i, c := range certs {
+		for _, u := range c.ExtKeyUsage {
+			if u == x509.ExtKeyUsageTimeStamping {
+				certs[0], certs[i] = certs[i], certs[0]
+				return &Timestamp{Token: ts.RawToken, Time: ts.Time, Certificates: certs}, nil
+			}
+		}
+	}
+	return nil, errors.Newf("timestamp does not contain time stamping certificate")
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tsa_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/open-component-model/ocm/pkg/testutils"
+
+	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/credentials"
+	"github.com/open-component-model/ocm/pkg/signing/tsa"
+	"github.com/open-component-model/ocm/pkg/signing/tsa/testtsa"
+)
+
+var _ = Describe("time stamping authority", func() {
+	var server *testtsa.Server
+	var start time.Time
+
+	message := []byte("signature value")
+
+	BeforeEach(func() {
+		start = time.Now().Add(-time.Hour)
+		server = Must(testtsa.New(start, 2*time.Hour))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("requests and verifies timestamp", func() {
+		ts := Must(tsa.Request(nil, server.URL, message))
+		Expect(ts.Time).To(BeTemporally("~", time.Now(), time.Minute))
+		Expect(ts.Certificates[0].Subject.CommonName).To(Equal("test TSA"))
+
+		v := Must(tsa.Verify(ts.Token, message, server.RootPool))
+		Expect(v.Time).To(Equal(ts.Time))
+	})
+
+	It("rejects timestamp for other message", func() {
+		ts := Must(tsa.Request(nil, server.URL, message))
+		_, err := tsa.Verify(ts.Token, []byte("other"), server.RootPool)
+		Expect(err).To(MatchError("message imprint of timestamp does not match"))
+	})
+
+	It("rejects untrusted authority", func() {
+		ts := Must(tsa.Request(nil, server.URL, message))
+		other := Must(testtsa.New(start, 2*time.Hour))
+		defer other.Close()
+		_, err := tsa.Verify(ts.Token, message, other.RootPool)
+		Expect(err).To(MatchError(ContainSubstring("untrusted time stamping authority")))
+	})
+
+	It("validates authority as of the timestamped time", func() {
+		server.SetTime(start.Add(-time.Minute))
+		ts := Must(tsa.Request(nil, server.URL, message))
+		_, err := tsa.Verify(ts.Token, message, server.RootPool)
+		Expect(err).To(MatchError(ContainSubstring("certificate has expired or is not yet valid")))
+	})
+
+	It("uses credentials", func() {
+		server.RequireBasicAuth("tsa", "secret")
+
+		cctx := credentials.New()
+		_, err := tsa.Request(cctx, server.URL, message)
+		Expect(err).To(MatchError(ContainSubstring("401 Unauthorized")))
+
+		cctx.SetCredentialsForConsumer(tsa.GetConsumerId(server.URL),
+			credentials.NewCredentials(common.Properties{tsa.ATTR_USERNAME: "tsa", tsa.ATTR_PASSWORD: "secret"}))
+		Must(tsa.Request(cctx, server.URL, message))
+		Expect(server.Requests()).To(Equal(1))
+	})
+})
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package subst
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/drone/envsubst"
+	"github.com/drone/envsubst/parse"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/open-component-model/ocm/pkg/utils"
+)
+
+// Mapping provides the value for a variable name.
+// The second result indicates whether the variable is defined.
+type Mapping func(name string) (string, bool)
+
+// Values is a simple Mapping based on a string map.
+type Values map[string]string
+
+func (v Values) Lookup(name string) (string, bool) {
+	s, ok := v[name]
+	return s, ok
+}
+
+// EnvMapping provides the environment variables as Mapping.
+func EnvMapping() Mapping {
+	return os.LookupEnv
+}
+
+// ChainedMapping provides a Mapping for a sequence of mappings.
+// The first mapping defining a variable wins.
+func ChainedMapping(mappings ...Mapping) Mapping {
+	return func(name string) (string, bool) {
+		for _, m := range mappings {
+			if m == nil {
+				continue
+			}
+			if v, ok := m(name); ok {
+				return v, true
+			}
+		}
+		return "", false
+	}
+}
+
+// ParseValues parses a values document (YAML or JSON). It must be a map
+// with string keys. Non-string values are JSON encoded.
+func ParseValues(data []byte) (Values, error) {
+	var values map[string]interface{}
+	if err := runtime.DefaultYAMLEncoding.Unmarshal(data, &values); err != nil {
+		return nil, errors.Wrapf(err, "invalid values document")
+	}
+	result := Values{}
+	for k, v := range values {
+		switch s := v.(type) {
+		case string:
+			result[k] = s
+		case nil:
+			result[k] = ""
+		default:
+			d, err := runtime.DefaultJSONEncoding.Marshal(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "value %q", k)
+			}
+			result[k] = string(d)
+		}
+	}
+	return result, nil
+}
+
+// ParseValuesFile reads a values document from a file.
+func ParseValuesFile(file string, fss ...vfs.FileSystem) (Values, error) {
+	data, err := utils.ReadFile(file, utils.FileSystem(fss...))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read values file %q", file)
+	}
+	values, err := ParseValues(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "values file %q", file)
+	}
+	return values, nil
+}
+
+// SubstituteVariables replaces variable references in all string values
+// of a YAML or JSON document using the drone/envsubst syntax
+// (for example ${VAR} or ${VAR:-default}). Keys are not substituted.
+// A reference to an undefined variable without a default is an error.
+// The result is JSON.
+func SubstituteVariables(data []byte, mapping Mapping) ([]byte, error) {
+	var content interface{}
+	if err := runtime.DefaultYAMLEncoding.Unmarshal(data, &content); err != nil {
+		return nil, errors.Wrapf(err, "no yaml or json data")
+	}
+	content, err := substitute(content, mapping, "")
+	if err != nil {
+		return nil, err
+	}
+	return runtime.DefaultJSONEncoding.Marshal(content)
+}
+
+// SubstituteString replaces variable references in a string.
+// A reference to an undefined variable without a default is an error.
+func SubstituteString(s string, mapping Mapping) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil
+	}
+	tree, err := parse.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	var undefined []string
+	checkDefined(tree.Root, mapping, &undefined)
+	if len(undefined) > 0 {
+		return "", errors.Newf("undefined variable(s) %s", strings.Join(undefined, ", "))
+	}
+	return envsubst.Eval(s, func(name string) string {
+		v, _ := mapping(name)
+		return v
+	})
+}
+
+func checkDefined(n parse.Node, mapping Mapping, undefined *[]string) {
+	switch node := n.(type) {
+	case *parse.ListNode:
+		for _, e := range node.Nodes {
+			checkDefined(e, mapping, undefined)
+		}
+	case *parse.FuncNode:
+		for _, e := range node.Args {
+			checkDefined(e, mapping, undefined)
+		}
+		if node.Name == "" {
+			if _, ok := mapping(node.Param); !ok {
+				*undefined = append(*undefined, node.Param)
+			}
+		}
+	}
+}
+
+func substitute(v interface{}, mapping Mapping, path string) (interface{}, error) {
+	switch e := v.(type) {
+	case string:
+		s, err := SubstituteString(e, mapping)
+		if err != nil && path != "" {
+			return nil, errors.Wrapf(err, "%s", path)
+		}
+		return s, err
+	case map[string]interface{}:
+		for _, k := range utils.StringMapKeys(e) {
+			r, err := substitute(e[k], mapping, join(path, k))
+			if err != nil {
+				return nil, err
+			}
+			e[k] = r
+		}
+	case []interface{}:
+		for i, f := range e {
+			r, err := substitute(f, mapping, join(path, fmt.Sprintf("%d", i)))
+			if err != nil {
+				return nil, err
+			}
+			e[i] = r
+		}
+	}
+	return v, nil
+}
+
+func join(path, elem string) string {
+	if path == "" {
+		return elem
+	}
+	return path + "." + elem
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Open Component Model contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package subst
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("variable substitution", func() {
+	values := Values{
+		"HOST": "ghcr.io",
+		"PORT": "443",
+	}
+
+	It("substitutes strings", func() {
+		Expect(SubstituteString("${HOST}:${PORT}", values.Lookup)).To(Equal("ghcr.io:443"))
+		Expect(SubstituteString("${USER:-anonymous}@${HOST}", values.Lookup)).To(Equal("anonymous@ghcr.io"))
+		Expect(SubstituteString("plain", nil)).To(Equal("plain"))
+	})
+
+	It("rejects undefined variables", func() {
+		_, err := SubstituteString("${USER}@${HOST}/${REPO}", values.Lookup)
+		Expect(err).To(MatchError("undefined variable(s) USER, REPO"))
+	})
+
+	It("chains mappings", func() {
+		m := ChainedMapping(Values{"HOST": "localhost"}.Lookup, nil, values.Lookup)
+		Expect(SubstituteString("${HOST}:${PORT}", m)).To(Equal("localhost:443"))
+	})
+
+	It("substitutes documents", func() {
+		data := `
+repository:
+  host: ${HOST}
+  port: ${PORT}
+  ${HOST}: key
+  list:
+    - ${PORT}
+    - 42
+`
+		result, err := SubstituteVariables([]byte(data), values.Lookup)
+		Expect(err).To(Succeed())
+		Expect(string(result)).To(Equal(`{"repository":{"${HOST}":"key","host":"ghcr.io","list":["443",42],"port":"443"}}`))
+	})
+
+	It("reports the path of undefined variables", func() {
+		_, err := SubstituteVariables([]byte(`{"a":[{"b":"${C}"}]}`), values.Lookup)
+		Expect(err).To(MatchError("a.0.b: undefined variable(s) C"))
+	})
+
+	It("parses values", func() {
+		Expect(ParseValues([]byte("A: a\nB: 1\nC:\n  d: e\n"))).To(Equal(Values{"A": "a", "B": "1", "C": `{"d":"e"}`}))
+	})
+})